@@ -4,6 +4,7 @@ package optio
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,15 +15,610 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*Distribution
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Distribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Distribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(Distribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(Distribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*AddressStatus
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(AddressStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(AddressStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*DistributorStats
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributorStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributorStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(DistributorStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(DistributorStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*FeeAllowanceGrant
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeAllowanceGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeAllowanceGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(FeeAllowanceGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(FeeAllowanceGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*TransferPacket
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(TransferPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(TransferPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*RateLimit
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(RateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*RateLimitPendingPacket
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitPendingPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitPendingPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimitPendingPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(RateLimitPendingPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*RemoteDistribution
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(RemoteDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(RemoteDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*PacketOutcome
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketOutcome)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PacketOutcome)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(PacketOutcome)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(PacketOutcome)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*FeeExemptUsage
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeExemptUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeExemptUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(FeeExemptUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(FeeExemptUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*FeeSponsor
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSponsor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSponsor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(FeeSponsor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_distributionList           protoreflect.FieldDescriptor
+	fd_GenesisState_distributionCount          protoreflect.FieldDescriptor
+	fd_GenesisState_addressStatusList          protoreflect.FieldDescriptor
+	fd_GenesisState_distributorStatsList       protoreflect.FieldDescriptor
+	fd_GenesisState_feeAllowanceUsage          protoreflect.FieldDescriptor
+	fd_GenesisState_feeAllowanceGrantList      protoreflect.FieldDescriptor
+	fd_GenesisState_supplyRecord               protoreflect.FieldDescriptor
+	fd_GenesisState_transferPacketList         protoreflect.FieldDescriptor
+	fd_GenesisState_portId                     protoreflect.FieldDescriptor
+	fd_GenesisState_rateLimitList              protoreflect.FieldDescriptor
+	fd_GenesisState_rateLimitPendingPacketList protoreflect.FieldDescriptor
+	fd_GenesisState_remoteDistributionList     protoreflect.FieldDescriptor
+	fd_GenesisState_packetOutcomeList          protoreflect.FieldDescriptor
+	fd_GenesisState_feeExemptUsageList         protoreflect.FieldDescriptor
+	fd_GenesisState_baseFee                    protoreflect.FieldDescriptor
+	fd_GenesisState_feeSponsorList             protoreflect.FieldDescriptor
+	fd_GenesisState_feeExemptBlockGas          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_optio_genesis_proto_init()
 	md_GenesisState = File_optio_optio_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_distributionList = md_GenesisState.Fields().ByName("distributionList")
+	fd_GenesisState_distributionCount = md_GenesisState.Fields().ByName("distributionCount")
+	fd_GenesisState_addressStatusList = md_GenesisState.Fields().ByName("addressStatusList")
+	fd_GenesisState_distributorStatsList = md_GenesisState.Fields().ByName("distributorStatsList")
+	fd_GenesisState_feeAllowanceUsage = md_GenesisState.Fields().ByName("feeAllowanceUsage")
+	fd_GenesisState_feeAllowanceGrantList = md_GenesisState.Fields().ByName("feeAllowanceGrantList")
+	fd_GenesisState_supplyRecord = md_GenesisState.Fields().ByName("supplyRecord")
+	fd_GenesisState_transferPacketList = md_GenesisState.Fields().ByName("transferPacketList")
+	fd_GenesisState_portId = md_GenesisState.Fields().ByName("portId")
+	fd_GenesisState_rateLimitList = md_GenesisState.Fields().ByName("rateLimitList")
+	fd_GenesisState_rateLimitPendingPacketList = md_GenesisState.Fields().ByName("rateLimitPendingPacketList")
+	fd_GenesisState_remoteDistributionList = md_GenesisState.Fields().ByName("remoteDistributionList")
+	fd_GenesisState_packetOutcomeList = md_GenesisState.Fields().ByName("packetOutcomeList")
+	fd_GenesisState_feeExemptUsageList = md_GenesisState.Fields().ByName("feeExemptUsageList")
+	fd_GenesisState_baseFee = md_GenesisState.Fields().ByName("baseFee")
+	fd_GenesisState_feeSponsorList = md_GenesisState.Fields().ByName("feeSponsorList")
+	fd_GenesisState_feeExemptBlockGas = md_GenesisState.Fields().ByName("feeExemptBlockGas")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,32 +692,168 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "optio.optio.GenesisState.params":
-		return x.Params != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
+	if len(x.DistributionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.DistributionList})
+		if !f(fd_GenesisState_distributionList, value) {
+			return
 		}
-		panic(fmt.Errorf("message optio.optio.GenesisState does not contain field %s", fd.FullName()))
 	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
+	if x.DistributionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DistributionCount)
+		if !f(fd_GenesisState_distributionCount, value) {
+			return
+		}
+	}
+	if len(x.AddressStatusList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.AddressStatusList})
+		if !f(fd_GenesisState_addressStatusList, value) {
+			return
+		}
+	}
+	if len(x.DistributorStatsList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.DistributorStatsList})
+		if !f(fd_GenesisState_distributorStatsList, value) {
+			return
+		}
+	}
+	if x.FeeAllowanceUsage != nil {
+		value := protoreflect.ValueOfMessage(x.FeeAllowanceUsage.ProtoReflect())
+		if !f(fd_GenesisState_feeAllowanceUsage, value) {
+			return
+		}
+	}
+	if len(x.FeeAllowanceGrantList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.FeeAllowanceGrantList})
+		if !f(fd_GenesisState_feeAllowanceGrantList, value) {
+			return
+		}
+	}
+	if x.SupplyRecord != nil {
+		value := protoreflect.ValueOfMessage(x.SupplyRecord.ProtoReflect())
+		if !f(fd_GenesisState_supplyRecord, value) {
+			return
+		}
+	}
+	if len(x.TransferPacketList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.TransferPacketList})
+		if !f(fd_GenesisState_transferPacketList, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_GenesisState_portId, value) {
+			return
+		}
+	}
+	if len(x.RateLimitList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.RateLimitList})
+		if !f(fd_GenesisState_rateLimitList, value) {
+			return
+		}
+	}
+	if len(x.RateLimitPendingPacketList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.RateLimitPendingPacketList})
+		if !f(fd_GenesisState_rateLimitPendingPacketList, value) {
+			return
+		}
+	}
+	if len(x.RemoteDistributionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.RemoteDistributionList})
+		if !f(fd_GenesisState_remoteDistributionList, value) {
+			return
+		}
+	}
+	if len(x.PacketOutcomeList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.PacketOutcomeList})
+		if !f(fd_GenesisState_packetOutcomeList, value) {
+			return
+		}
+	}
+	if len(x.FeeExemptUsageList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.FeeExemptUsageList})
+		if !f(fd_GenesisState_feeExemptUsageList, value) {
+			return
+		}
+	}
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_GenesisState_baseFee, value) {
+			return
+		}
+	}
+	if len(x.FeeSponsorList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.FeeSponsorList})
+		if !f(fd_GenesisState_feeSponsorList, value) {
+			return
+		}
+	}
+	if x.FeeExemptBlockGas != nil {
+		value := protoreflect.ValueOfMessage(x.FeeExemptBlockGas.ProtoReflect())
+		if !f(fd_GenesisState_feeExemptBlockGas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.optio.GenesisState.params":
+		return x.Params != nil
+	case "optio.optio.GenesisState.distributionList":
+		return len(x.DistributionList) != 0
+	case "optio.optio.GenesisState.distributionCount":
+		return x.DistributionCount != uint64(0)
+	case "optio.optio.GenesisState.addressStatusList":
+		return len(x.AddressStatusList) != 0
+	case "optio.optio.GenesisState.distributorStatsList":
+		return len(x.DistributorStatsList) != 0
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		return x.FeeAllowanceUsage != nil
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		return len(x.FeeAllowanceGrantList) != 0
+	case "optio.optio.GenesisState.supplyRecord":
+		return x.SupplyRecord != nil
+	case "optio.optio.GenesisState.transferPacketList":
+		return len(x.TransferPacketList) != 0
+	case "optio.optio.GenesisState.portId":
+		return x.PortId != ""
+	case "optio.optio.GenesisState.rateLimitList":
+		return len(x.RateLimitList) != 0
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		return len(x.RateLimitPendingPacketList) != 0
+	case "optio.optio.GenesisState.remoteDistributionList":
+		return len(x.RemoteDistributionList) != 0
+	case "optio.optio.GenesisState.packetOutcomeList":
+		return len(x.PacketOutcomeList) != 0
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		return len(x.FeeExemptUsageList) != 0
+	case "optio.optio.GenesisState.baseFee":
+		return x.BaseFee != ""
+	case "optio.optio.GenesisState.feeSponsorList":
+		return len(x.FeeSponsorList) != 0
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		return x.FeeExemptBlockGas != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
+		}
+		panic(fmt.Errorf("message optio.optio.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
 //
 // Clearing an extension field clears both the extension type and value
 // associated with the given field number.
@@ -131,6 +863,40 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "optio.optio.GenesisState.params":
 		x.Params = nil
+	case "optio.optio.GenesisState.distributionList":
+		x.DistributionList = nil
+	case "optio.optio.GenesisState.distributionCount":
+		x.DistributionCount = uint64(0)
+	case "optio.optio.GenesisState.addressStatusList":
+		x.AddressStatusList = nil
+	case "optio.optio.GenesisState.distributorStatsList":
+		x.DistributorStatsList = nil
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		x.FeeAllowanceUsage = nil
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		x.FeeAllowanceGrantList = nil
+	case "optio.optio.GenesisState.supplyRecord":
+		x.SupplyRecord = nil
+	case "optio.optio.GenesisState.transferPacketList":
+		x.TransferPacketList = nil
+	case "optio.optio.GenesisState.portId":
+		x.PortId = ""
+	case "optio.optio.GenesisState.rateLimitList":
+		x.RateLimitList = nil
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		x.RateLimitPendingPacketList = nil
+	case "optio.optio.GenesisState.remoteDistributionList":
+		x.RemoteDistributionList = nil
+	case "optio.optio.GenesisState.packetOutcomeList":
+		x.PacketOutcomeList = nil
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		x.FeeExemptUsageList = nil
+	case "optio.optio.GenesisState.baseFee":
+		x.BaseFee = ""
+	case "optio.optio.GenesisState.feeSponsorList":
+		x.FeeSponsorList = nil
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		x.FeeExemptBlockGas = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
@@ -150,6 +916,90 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "optio.optio.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.GenesisState.distributionList":
+		if len(x.DistributionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.DistributionList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.distributionCount":
+		value := x.DistributionCount
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.GenesisState.addressStatusList":
+		if len(x.AddressStatusList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.AddressStatusList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.distributorStatsList":
+		if len(x.DistributorStatsList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.DistributorStatsList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		value := x.FeeAllowanceUsage
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		if len(x.FeeAllowanceGrantList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.FeeAllowanceGrantList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.supplyRecord":
+		value := x.SupplyRecord
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.GenesisState.transferPacketList":
+		if len(x.TransferPacketList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.TransferPacketList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.portId":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.GenesisState.rateLimitList":
+		if len(x.RateLimitList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.RateLimitList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		if len(x.RateLimitPendingPacketList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.RateLimitPendingPacketList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.remoteDistributionList":
+		if len(x.RemoteDistributionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.RemoteDistributionList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.packetOutcomeList":
+		if len(x.PacketOutcomeList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.PacketOutcomeList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		if len(x.FeeExemptUsageList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.FeeExemptUsageList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.baseFee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.GenesisState.feeSponsorList":
+		if len(x.FeeSponsorList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.FeeSponsorList}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		value := x.FeeExemptBlockGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
@@ -172,6 +1022,62 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "optio.optio.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "optio.optio.GenesisState.distributionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.DistributionList = *clv.list
+	case "optio.optio.GenesisState.distributionCount":
+		x.DistributionCount = value.Uint()
+	case "optio.optio.GenesisState.addressStatusList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.AddressStatusList = *clv.list
+	case "optio.optio.GenesisState.distributorStatsList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.DistributorStatsList = *clv.list
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		x.FeeAllowanceUsage = value.Message().Interface().(*FeeAllowanceUsage)
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.FeeAllowanceGrantList = *clv.list
+	case "optio.optio.GenesisState.supplyRecord":
+		x.SupplyRecord = value.Message().Interface().(*SupplyRecord)
+	case "optio.optio.GenesisState.transferPacketList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.TransferPacketList = *clv.list
+	case "optio.optio.GenesisState.portId":
+		x.PortId = value.Interface().(string)
+	case "optio.optio.GenesisState.rateLimitList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.RateLimitList = *clv.list
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.RateLimitPendingPacketList = *clv.list
+	case "optio.optio.GenesisState.remoteDistributionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.RemoteDistributionList = *clv.list
+	case "optio.optio.GenesisState.packetOutcomeList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.PacketOutcomeList = *clv.list
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.FeeExemptUsageList = *clv.list
+	case "optio.optio.GenesisState.baseFee":
+		x.BaseFee = value.Interface().(string)
+	case "optio.optio.GenesisState.feeSponsorList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.FeeSponsorList = *clv.list
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		x.FeeExemptBlockGas = value.Message().Interface().(*FeeExemptBlockGas)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
@@ -197,6 +1103,93 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "optio.optio.GenesisState.distributionList":
+		if x.DistributionList == nil {
+			x.DistributionList = []*Distribution{}
+		}
+		value := &_GenesisState_2_list{list: &x.DistributionList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.addressStatusList":
+		if x.AddressStatusList == nil {
+			x.AddressStatusList = []*AddressStatus{}
+		}
+		value := &_GenesisState_4_list{list: &x.AddressStatusList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.distributorStatsList":
+		if x.DistributorStatsList == nil {
+			x.DistributorStatsList = []*DistributorStats{}
+		}
+		value := &_GenesisState_5_list{list: &x.DistributorStatsList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		if x.FeeAllowanceUsage == nil {
+			x.FeeAllowanceUsage = new(FeeAllowanceUsage)
+		}
+		return protoreflect.ValueOfMessage(x.FeeAllowanceUsage.ProtoReflect())
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		if x.FeeAllowanceGrantList == nil {
+			x.FeeAllowanceGrantList = []*FeeAllowanceGrant{}
+		}
+		value := &_GenesisState_7_list{list: &x.FeeAllowanceGrantList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.supplyRecord":
+		if x.SupplyRecord == nil {
+			x.SupplyRecord = new(SupplyRecord)
+		}
+		return protoreflect.ValueOfMessage(x.SupplyRecord.ProtoReflect())
+	case "optio.optio.GenesisState.transferPacketList":
+		if x.TransferPacketList == nil {
+			x.TransferPacketList = []*TransferPacket{}
+		}
+		value := &_GenesisState_9_list{list: &x.TransferPacketList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.rateLimitList":
+		if x.RateLimitList == nil {
+			x.RateLimitList = []*RateLimit{}
+		}
+		value := &_GenesisState_11_list{list: &x.RateLimitList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		if x.RateLimitPendingPacketList == nil {
+			x.RateLimitPendingPacketList = []*RateLimitPendingPacket{}
+		}
+		value := &_GenesisState_12_list{list: &x.RateLimitPendingPacketList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.remoteDistributionList":
+		if x.RemoteDistributionList == nil {
+			x.RemoteDistributionList = []*RemoteDistribution{}
+		}
+		value := &_GenesisState_13_list{list: &x.RemoteDistributionList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.packetOutcomeList":
+		if x.PacketOutcomeList == nil {
+			x.PacketOutcomeList = []*PacketOutcome{}
+		}
+		value := &_GenesisState_14_list{list: &x.PacketOutcomeList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		if x.FeeExemptUsageList == nil {
+			x.FeeExemptUsageList = []*FeeExemptUsage{}
+		}
+		value := &_GenesisState_15_list{list: &x.FeeExemptUsageList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.feeSponsorList":
+		if x.FeeSponsorList == nil {
+			x.FeeSponsorList = []*FeeSponsor{}
+		}
+		value := &_GenesisState_17_list{list: &x.FeeSponsorList}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		if x.FeeExemptBlockGas == nil {
+			x.FeeExemptBlockGas = new(FeeExemptBlockGas)
+		}
+		return protoreflect.ValueOfMessage(x.FeeExemptBlockGas.ProtoReflect())
+	case "optio.optio.GenesisState.distributionCount":
+		panic(fmt.Errorf("field distributionCount of message optio.optio.GenesisState is not mutable"))
+	case "optio.optio.GenesisState.portId":
+		panic(fmt.Errorf("field portId of message optio.optio.GenesisState is not mutable"))
+	case "optio.optio.GenesisState.baseFee":
+		panic(fmt.Errorf("field baseFee of message optio.optio.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
@@ -213,6 +1206,54 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "optio.optio.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.GenesisState.distributionList":
+		list := []*Distribution{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "optio.optio.GenesisState.distributionCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.GenesisState.addressStatusList":
+		list := []*AddressStatus{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "optio.optio.GenesisState.distributorStatsList":
+		list := []*DistributorStats{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "optio.optio.GenesisState.feeAllowanceUsage":
+		m := new(FeeAllowanceUsage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.GenesisState.feeAllowanceGrantList":
+		list := []*FeeAllowanceGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "optio.optio.GenesisState.supplyRecord":
+		m := new(SupplyRecord)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.GenesisState.transferPacketList":
+		list := []*TransferPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "optio.optio.GenesisState.portId":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.GenesisState.rateLimitList":
+		list := []*RateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "optio.optio.GenesisState.rateLimitPendingPacketList":
+		list := []*RateLimitPendingPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "optio.optio.GenesisState.remoteDistributionList":
+		list := []*RemoteDistribution{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "optio.optio.GenesisState.packetOutcomeList":
+		list := []*PacketOutcome{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "optio.optio.GenesisState.feeExemptUsageList":
+		list := []*FeeExemptUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "optio.optio.GenesisState.baseFee":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.GenesisState.feeSponsorList":
+		list := []*FeeSponsor{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "optio.optio.GenesisState.feeExemptBlockGas":
+		m := new(FeeExemptBlockGas)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.GenesisState"))
@@ -286,6 +1327,95 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.DistributionList) > 0 {
+			for _, e := range x.DistributionList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DistributionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DistributionCount))
+		}
+		if len(x.AddressStatusList) > 0 {
+			for _, e := range x.AddressStatusList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DistributorStatsList) > 0 {
+			for _, e := range x.DistributorStatsList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeAllowanceUsage != nil {
+			l = options.Size(x.FeeAllowanceUsage)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeAllowanceGrantList) > 0 {
+			for _, e := range x.FeeAllowanceGrantList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SupplyRecord != nil {
+			l = options.Size(x.SupplyRecord)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TransferPacketList) > 0 {
+			for _, e := range x.TransferPacketList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RateLimitList) > 0 {
+			for _, e := range x.RateLimitList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RateLimitPendingPacketList) > 0 {
+			for _, e := range x.RateLimitPendingPacketList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemoteDistributionList) > 0 {
+			for _, e := range x.RemoteDistributionList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PacketOutcomeList) > 0 {
+			for _, e := range x.PacketOutcomeList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeExemptUsageList) > 0 {
+			for _, e := range x.FeeExemptUsageList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeSponsorList) > 0 {
+			for _, e := range x.FeeSponsorList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeExemptBlockGas != nil {
+			l = options.Size(x.FeeExemptBlockGas)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +1445,249 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FeeExemptBlockGas != nil {
+			encoded, err := options.Marshal(x.FeeExemptBlockGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.FeeSponsorList) > 0 {
+			for iNdEx := len(x.FeeSponsorList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSponsorList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.FeeExemptUsageList) > 0 {
+			for iNdEx := len(x.FeeExemptUsageList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeExemptUsageList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.PacketOutcomeList) > 0 {
+			for iNdEx := len(x.PacketOutcomeList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PacketOutcomeList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.RemoteDistributionList) > 0 {
+			for iNdEx := len(x.RemoteDistributionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemoteDistributionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.RateLimitPendingPacketList) > 0 {
+			for iNdEx := len(x.RateLimitPendingPacketList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimitPendingPacketList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.RateLimitList) > 0 {
+			for iNdEx := len(x.RateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimitList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.TransferPacketList) > 0 {
+			for iNdEx := len(x.TransferPacketList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferPacketList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.SupplyRecord != nil {
+			encoded, err := options.Marshal(x.SupplyRecord)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.FeeAllowanceGrantList) > 0 {
+			for iNdEx := len(x.FeeAllowanceGrantList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeAllowanceGrantList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.FeeAllowanceUsage != nil {
+			encoded, err := options.Marshal(x.FeeAllowanceUsage)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.DistributorStatsList) > 0 {
+			for iNdEx := len(x.DistributorStatsList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributorStatsList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AddressStatusList) > 0 {
+			for iNdEx := len(x.AddressStatusList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AddressStatusList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.DistributionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DistributionCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DistributionList) > 0 {
+			for iNdEx := len(x.DistributionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -359,28 +1732,425 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DistributionList = append(x.DistributionList, &Distribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributionList[len(x.DistributionList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+				}
+				x.DistributionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DistributionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressStatusList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressStatusList = append(x.AddressStatusList, &AddressStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AddressStatusList[len(x.AddressStatusList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributorStatsList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DistributorStatsList = append(x.DistributorStatsList, &DistributorStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributorStatsList[len(x.DistributorStatsList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceUsage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeAllowanceUsage == nil {
+					x.FeeAllowanceUsage = &FeeAllowanceUsage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAllowanceUsage); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceGrantList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeAllowanceGrantList = append(x.FeeAllowanceGrantList, &FeeAllowanceGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAllowanceGrantList[len(x.FeeAllowanceGrantList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyRecord", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SupplyRecord == nil {
+					x.SupplyRecord = &SupplyRecord{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SupplyRecord); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferPacketList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferPacketList = append(x.TransferPacketList, &TransferPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferPacketList[len(x.TransferPacketList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateLimitList = append(x.RateLimitList, &RateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimitList[len(x.RateLimitList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitPendingPacketList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.RateLimitPendingPacketList = append(x.RateLimitPendingPacketList, &RateLimitPendingPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimitPendingPacketList[len(x.RateLimitPendingPacketList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteDistributionList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -407,10 +2177,178 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.RemoteDistributionList = append(x.RemoteDistributionList, &RemoteDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemoteDistributionList[len(x.RemoteDistributionList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketOutcomeList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PacketOutcomeList = append(x.PacketOutcomeList, &PacketOutcome{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PacketOutcomeList[len(x.PacketOutcomeList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptUsageList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeExemptUsageList = append(x.FeeExemptUsageList, &FeeExemptUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeExemptUsageList[len(x.FeeExemptUsageList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSponsorList = append(x.FeeSponsorList, &FeeSponsor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSponsorList[len(x.FeeSponsorList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptBlockGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeExemptBlockGas == nil {
+					x.FeeExemptBlockGas = &FeeExemptBlockGas{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeExemptBlockGas); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -469,7 +2407,31 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params                *Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	DistributionList      []*Distribution      `protobuf:"bytes,2,rep,name=distributionList,proto3" json:"distributionList,omitempty"`
+	DistributionCount     uint64               `protobuf:"varint,3,opt,name=distributionCount,proto3" json:"distributionCount,omitempty"`
+	AddressStatusList     []*AddressStatus     `protobuf:"bytes,4,rep,name=addressStatusList,proto3" json:"addressStatusList,omitempty"`
+	DistributorStatsList  []*DistributorStats  `protobuf:"bytes,5,rep,name=distributorStatsList,proto3" json:"distributorStatsList,omitempty"`
+	FeeAllowanceUsage     *FeeAllowanceUsage   `protobuf:"bytes,6,opt,name=feeAllowanceUsage,proto3" json:"feeAllowanceUsage,omitempty"`
+	FeeAllowanceGrantList []*FeeAllowanceGrant `protobuf:"bytes,7,rep,name=feeAllowanceGrantList,proto3" json:"feeAllowanceGrantList,omitempty"`
+	SupplyRecord          *SupplyRecord        `protobuf:"bytes,8,opt,name=supplyRecord,proto3" json:"supplyRecord,omitempty"`
+	TransferPacketList    []*TransferPacket    `protobuf:"bytes,9,rep,name=transferPacketList,proto3" json:"transferPacketList,omitempty"`
+	// portId is the port of the optio IBC application.
+	PortId                     string                    `protobuf:"bytes,10,opt,name=portId,proto3" json:"portId,omitempty"`
+	RateLimitList              []*RateLimit              `protobuf:"bytes,11,rep,name=rateLimitList,proto3" json:"rateLimitList,omitempty"`
+	RateLimitPendingPacketList []*RateLimitPendingPacket `protobuf:"bytes,12,rep,name=rateLimitPendingPacketList,proto3" json:"rateLimitPendingPacketList,omitempty"`
+	RemoteDistributionList     []*RemoteDistribution     `protobuf:"bytes,13,rep,name=remoteDistributionList,proto3" json:"remoteDistributionList,omitempty"`
+	PacketOutcomeList          []*PacketOutcome          `protobuf:"bytes,14,rep,name=packetOutcomeList,proto3" json:"packetOutcomeList,omitempty"`
+	// feeExemptUsageList holds the fee exempt tx windows of authorized
+	// accounts.
+	FeeExemptUsageList []*FeeExemptUsage `protobuf:"bytes,15,rep,name=feeExemptUsageList,proto3" json:"feeExemptUsageList,omitempty"`
+	// baseFee is the stored base fee per unit of gas of the next block. It is
+	// zero until the base fee first moves, and the min base fee applies then.
+	BaseFee        string        `protobuf:"bytes,16,opt,name=baseFee,proto3" json:"baseFee,omitempty"`
+	FeeSponsorList []*FeeSponsor `protobuf:"bytes,17,rep,name=feeSponsorList,proto3" json:"feeSponsorList,omitempty"`
+	// feeExemptBlockGas is the gas of the fee exempt txs in the last block. It
+	// only counts for its height, so it is ignored once imported at another one.
+	FeeExemptBlockGas *FeeExemptBlockGas `protobuf:"bytes,18,opt,name=feeExemptBlockGas,proto3" json:"feeExemptBlockGas,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,31 +2461,255 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetDistributionList() []*Distribution {
+	if x != nil {
+		return x.DistributionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDistributionCount() uint64 {
+	if x != nil {
+		return x.DistributionCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAddressStatusList() []*AddressStatus {
+	if x != nil {
+		return x.AddressStatusList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDistributorStatsList() []*DistributorStats {
+	if x != nil {
+		return x.DistributorStatsList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeAllowanceUsage() *FeeAllowanceUsage {
+	if x != nil {
+		return x.FeeAllowanceUsage
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeAllowanceGrantList() []*FeeAllowanceGrant {
+	if x != nil {
+		return x.FeeAllowanceGrantList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSupplyRecord() *SupplyRecord {
+	if x != nil {
+		return x.SupplyRecord
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferPacketList() []*TransferPacket {
+	if x != nil {
+		return x.TransferPacketList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *GenesisState) GetRateLimitList() []*RateLimit {
+	if x != nil {
+		return x.RateLimitList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRateLimitPendingPacketList() []*RateLimitPendingPacket {
+	if x != nil {
+		return x.RateLimitPendingPacketList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRemoteDistributionList() []*RemoteDistribution {
+	if x != nil {
+		return x.RemoteDistributionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPacketOutcomeList() []*PacketOutcome {
+	if x != nil {
+		return x.PacketOutcomeList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeExemptUsageList() []*FeeExemptUsage {
+	if x != nil {
+		return x.FeeExemptUsageList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *GenesisState) GetFeeSponsorList() []*FeeSponsor {
+	if x != nil {
+		return x.FeeSponsorList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeExemptBlockGas() *FeeExemptBlockGas {
+	if x != nil {
+		return x.FeeExemptBlockGas
+	}
+	return nil
+}
+
 var File_optio_optio_genesis_proto protoreflect.FileDescriptor
 
 var file_optio_optio_genesis_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x67, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x74,
 	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
-	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
-	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x46, 0x0a, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0xca, 0x02, 0x0b, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0xe2, 0x02, 0x17, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x2f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe8, 0x0a, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x11,
+	0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x66,
+	0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x5a, 0x0a, 0x15, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x46, 0x65,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0c,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x51, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0d,
+	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x69, 0x0a, 0x1a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x1a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x16, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x66, 0x65,
+	0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x66, 0x65, 0x65, 0x45, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50, 0x0a,
+	0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12,
+	0x45, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x46, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0xa2, 0x02,
+	0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0xca, 0x02, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0xe2, 0x02, 0x17, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x3a, 0x3a, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -540,16 +2726,44 @@ func file_optio_optio_genesis_proto_rawDescGZIP() []byte {
 
 var file_optio_optio_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_optio_optio_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: optio.optio.GenesisState
-	(*Params)(nil),       // 1: optio.optio.Params
+	(*GenesisState)(nil),           // 0: optio.optio.GenesisState
+	(*Params)(nil),                 // 1: optio.optio.Params
+	(*Distribution)(nil),           // 2: optio.optio.Distribution
+	(*AddressStatus)(nil),          // 3: optio.optio.AddressStatus
+	(*DistributorStats)(nil),       // 4: optio.optio.DistributorStats
+	(*FeeAllowanceUsage)(nil),      // 5: optio.optio.FeeAllowanceUsage
+	(*FeeAllowanceGrant)(nil),      // 6: optio.optio.FeeAllowanceGrant
+	(*SupplyRecord)(nil),           // 7: optio.optio.SupplyRecord
+	(*TransferPacket)(nil),         // 8: optio.optio.TransferPacket
+	(*RateLimit)(nil),              // 9: optio.optio.RateLimit
+	(*RateLimitPendingPacket)(nil), // 10: optio.optio.RateLimitPendingPacket
+	(*RemoteDistribution)(nil),     // 11: optio.optio.RemoteDistribution
+	(*PacketOutcome)(nil),          // 12: optio.optio.PacketOutcome
+	(*FeeExemptUsage)(nil),         // 13: optio.optio.FeeExemptUsage
+	(*FeeSponsor)(nil),             // 14: optio.optio.FeeSponsor
+	(*FeeExemptBlockGas)(nil),      // 15: optio.optio.FeeExemptBlockGas
 }
 var file_optio_optio_genesis_proto_depIdxs = []int32{
-	1, // 0: optio.optio.GenesisState.params:type_name -> optio.optio.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: optio.optio.GenesisState.params:type_name -> optio.optio.Params
+	2,  // 1: optio.optio.GenesisState.distributionList:type_name -> optio.optio.Distribution
+	3,  // 2: optio.optio.GenesisState.addressStatusList:type_name -> optio.optio.AddressStatus
+	4,  // 3: optio.optio.GenesisState.distributorStatsList:type_name -> optio.optio.DistributorStats
+	5,  // 4: optio.optio.GenesisState.feeAllowanceUsage:type_name -> optio.optio.FeeAllowanceUsage
+	6,  // 5: optio.optio.GenesisState.feeAllowanceGrantList:type_name -> optio.optio.FeeAllowanceGrant
+	7,  // 6: optio.optio.GenesisState.supplyRecord:type_name -> optio.optio.SupplyRecord
+	8,  // 7: optio.optio.GenesisState.transferPacketList:type_name -> optio.optio.TransferPacket
+	9,  // 8: optio.optio.GenesisState.rateLimitList:type_name -> optio.optio.RateLimit
+	10, // 9: optio.optio.GenesisState.rateLimitPendingPacketList:type_name -> optio.optio.RateLimitPendingPacket
+	11, // 10: optio.optio.GenesisState.remoteDistributionList:type_name -> optio.optio.RemoteDistribution
+	12, // 11: optio.optio.GenesisState.packetOutcomeList:type_name -> optio.optio.PacketOutcome
+	13, // 12: optio.optio.GenesisState.feeExemptUsageList:type_name -> optio.optio.FeeExemptUsage
+	14, // 13: optio.optio.GenesisState.feeSponsorList:type_name -> optio.optio.FeeSponsor
+	15, // 14: optio.optio.GenesisState.feeExemptBlockGas:type_name -> optio.optio.FeeExemptBlockGas
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_optio_optio_genesis_proto_init() }
@@ -557,7 +2771,17 @@ func file_optio_optio_genesis_proto_init() {
 	if File_optio_optio_genesis_proto != nil {
 		return
 	}
+	file_optio_optio_address_status_proto_init()
+	file_optio_optio_distribution_proto_init()
+	file_optio_optio_distributor_stats_proto_init()
+	file_optio_optio_fee_allowance_proto_init()
+	file_optio_optio_fee_exemption_proto_init()
 	file_optio_optio_params_proto_init()
+	file_optio_optio_packet_outcome_proto_init()
+	file_optio_optio_rate_limit_proto_init()
+	file_optio_optio_remote_treasury_proto_init()
+	file_optio_optio_supply_proto_init()
+	file_optio_optio_transfer_packet_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_optio_optio_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
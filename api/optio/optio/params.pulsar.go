@@ -3,12 +3,15 @@ package optio
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -60,11 +63,283 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]string
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field ComplianceAccounts as it is not of Message kind"))
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_14_list)(nil)
+
+type _Params_14_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_14_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_14_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]*RemoteController
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteController)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteController)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	v := new(RemoteController)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := new(RemoteController)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_20_list)(nil)
+
+type _Params_20_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_20_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_20_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_authorizedAccounts protoreflect.FieldDescriptor
-	fd_Params_denom              protoreflect.FieldDescriptor
-	fd_Params_maxSupply          protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_authorizedAccounts     protoreflect.FieldDescriptor
+	fd_Params_denom                  protoreflect.FieldDescriptor
+	fd_Params_maxSupply              protoreflect.FieldDescriptor
+	fd_Params_maxReferenceLength     protoreflect.FieldDescriptor
+	fd_Params_maxCategoryLength      protoreflect.FieldDescriptor
+	fd_Params_maxMemoLength          protoreflect.FieldDescriptor
+	fd_Params_complianceAccounts     protoreflect.FieldDescriptor
+	fd_Params_allowlistEnabled       protoreflect.FieldDescriptor
+	fd_Params_maxRecipientsPerMsg    protoreflect.FieldDescriptor
+	fd_Params_gasPerRecipient        protoreflect.FieldDescriptor
+	fd_Params_feeAllowanceSpendLimit protoreflect.FieldDescriptor
+	fd_Params_feeAllowanceDuration   protoreflect.FieldDescriptor
+	fd_Params_feeAllowanceSponsor    protoreflect.FieldDescriptor
+	fd_Params_feeAllowanceBudget     protoreflect.FieldDescriptor
+	fd_Params_transferTimeout        protoreflect.FieldDescriptor
+	fd_Params_remoteControllers      protoreflect.FieldDescriptor
+	fd_Params_feeExemptBlockGas      protoreflect.FieldDescriptor
+	fd_Params_feeExemptTxsPerWindow  protoreflect.FieldDescriptor
+	fd_Params_feeExemptWindow        protoreflect.FieldDescriptor
+	fd_Params_minimumGasPrices       protoreflect.FieldDescriptor
+	fd_Params_baseFeeTargetGas       protoreflect.FieldDescriptor
+	fd_Params_baseFeeMaxChangeRate   protoreflect.FieldDescriptor
+	fd_Params_minBaseFee             protoreflect.FieldDescriptor
+	fd_Params_maxBaseFee             protoreflect.FieldDescriptor
+	fd_Params_baseFeeBurnRate        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -73,6 +348,28 @@ func init() {
 	fd_Params_authorizedAccounts = md_Params.Fields().ByName("authorizedAccounts")
 	fd_Params_denom = md_Params.Fields().ByName("denom")
 	fd_Params_maxSupply = md_Params.Fields().ByName("maxSupply")
+	fd_Params_maxReferenceLength = md_Params.Fields().ByName("maxReferenceLength")
+	fd_Params_maxCategoryLength = md_Params.Fields().ByName("maxCategoryLength")
+	fd_Params_maxMemoLength = md_Params.Fields().ByName("maxMemoLength")
+	fd_Params_complianceAccounts = md_Params.Fields().ByName("complianceAccounts")
+	fd_Params_allowlistEnabled = md_Params.Fields().ByName("allowlistEnabled")
+	fd_Params_maxRecipientsPerMsg = md_Params.Fields().ByName("maxRecipientsPerMsg")
+	fd_Params_gasPerRecipient = md_Params.Fields().ByName("gasPerRecipient")
+	fd_Params_feeAllowanceSpendLimit = md_Params.Fields().ByName("feeAllowanceSpendLimit")
+	fd_Params_feeAllowanceDuration = md_Params.Fields().ByName("feeAllowanceDuration")
+	fd_Params_feeAllowanceSponsor = md_Params.Fields().ByName("feeAllowanceSponsor")
+	fd_Params_feeAllowanceBudget = md_Params.Fields().ByName("feeAllowanceBudget")
+	fd_Params_transferTimeout = md_Params.Fields().ByName("transferTimeout")
+	fd_Params_remoteControllers = md_Params.Fields().ByName("remoteControllers")
+	fd_Params_feeExemptBlockGas = md_Params.Fields().ByName("feeExemptBlockGas")
+	fd_Params_feeExemptTxsPerWindow = md_Params.Fields().ByName("feeExemptTxsPerWindow")
+	fd_Params_feeExemptWindow = md_Params.Fields().ByName("feeExemptWindow")
+	fd_Params_minimumGasPrices = md_Params.Fields().ByName("minimumGasPrices")
+	fd_Params_baseFeeTargetGas = md_Params.Fields().ByName("baseFeeTargetGas")
+	fd_Params_baseFeeMaxChangeRate = md_Params.Fields().ByName("baseFeeMaxChangeRate")
+	fd_Params_minBaseFee = md_Params.Fields().ByName("minBaseFee")
+	fd_Params_maxBaseFee = md_Params.Fields().ByName("maxBaseFee")
+	fd_Params_baseFeeBurnRate = md_Params.Fields().ByName("baseFeeBurnRate")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -152,12 +449,144 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-	if x.MaxSupply != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MaxSupply)
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
 		if !f(fd_Params_maxSupply, value) {
 			return
 		}
 	}
+	if x.MaxReferenceLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxReferenceLength)
+		if !f(fd_Params_maxReferenceLength, value) {
+			return
+		}
+	}
+	if x.MaxCategoryLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCategoryLength)
+		if !f(fd_Params_maxCategoryLength, value) {
+			return
+		}
+	}
+	if x.MaxMemoLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMemoLength)
+		if !f(fd_Params_maxMemoLength, value) {
+			return
+		}
+	}
+	if len(x.ComplianceAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.ComplianceAccounts})
+		if !f(fd_Params_complianceAccounts, value) {
+			return
+		}
+	}
+	if x.AllowlistEnabled != false {
+		value := protoreflect.ValueOfBool(x.AllowlistEnabled)
+		if !f(fd_Params_allowlistEnabled, value) {
+			return
+		}
+	}
+	if x.MaxRecipientsPerMsg != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecipientsPerMsg)
+		if !f(fd_Params_maxRecipientsPerMsg, value) {
+			return
+		}
+	}
+	if x.GasPerRecipient != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerRecipient)
+		if !f(fd_Params_gasPerRecipient, value) {
+			return
+		}
+	}
+	if len(x.FeeAllowanceSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.FeeAllowanceSpendLimit})
+		if !f(fd_Params_feeAllowanceSpendLimit, value) {
+			return
+		}
+	}
+	if x.FeeAllowanceDuration != nil {
+		value := protoreflect.ValueOfMessage(x.FeeAllowanceDuration.ProtoReflect())
+		if !f(fd_Params_feeAllowanceDuration, value) {
+			return
+		}
+	}
+	if x.FeeAllowanceSponsor != "" {
+		value := protoreflect.ValueOfString(x.FeeAllowanceSponsor)
+		if !f(fd_Params_feeAllowanceSponsor, value) {
+			return
+		}
+	}
+	if len(x.FeeAllowanceBudget) != 0 {
+		value := protoreflect.ValueOfList(&_Params_14_list{list: &x.FeeAllowanceBudget})
+		if !f(fd_Params_feeAllowanceBudget, value) {
+			return
+		}
+	}
+	if x.TransferTimeout != nil {
+		value := protoreflect.ValueOfMessage(x.TransferTimeout.ProtoReflect())
+		if !f(fd_Params_transferTimeout, value) {
+			return
+		}
+	}
+	if len(x.RemoteControllers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.RemoteControllers})
+		if !f(fd_Params_remoteControllers, value) {
+			return
+		}
+	}
+	if x.FeeExemptBlockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeExemptBlockGas)
+		if !f(fd_Params_feeExemptBlockGas, value) {
+			return
+		}
+	}
+	if x.FeeExemptTxsPerWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeExemptTxsPerWindow)
+		if !f(fd_Params_feeExemptTxsPerWindow, value) {
+			return
+		}
+	}
+	if x.FeeExemptWindow != nil {
+		value := protoreflect.ValueOfMessage(x.FeeExemptWindow.ProtoReflect())
+		if !f(fd_Params_feeExemptWindow, value) {
+			return
+		}
+	}
+	if len(x.MinimumGasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_Params_20_list{list: &x.MinimumGasPrices})
+		if !f(fd_Params_minimumGasPrices, value) {
+			return
+		}
+	}
+	if x.BaseFeeTargetGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeTargetGas)
+		if !f(fd_Params_baseFeeTargetGas, value) {
+			return
+		}
+	}
+	if x.BaseFeeMaxChangeRate != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeMaxChangeRate)
+		if !f(fd_Params_baseFeeMaxChangeRate, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_Params_minBaseFee, value) {
+			return
+		}
+	}
+	if x.MaxBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFee)
+		if !f(fd_Params_maxBaseFee, value) {
+			return
+		}
+	}
+	if x.BaseFeeBurnRate != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeBurnRate)
+		if !f(fd_Params_baseFeeBurnRate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -178,7 +607,51 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	case "optio.optio.Params.denom":
 		return x.Denom != ""
 	case "optio.optio.Params.maxSupply":
-		return x.MaxSupply != uint64(0)
+		return x.MaxSupply != ""
+	case "optio.optio.Params.maxReferenceLength":
+		return x.MaxReferenceLength != uint64(0)
+	case "optio.optio.Params.maxCategoryLength":
+		return x.MaxCategoryLength != uint64(0)
+	case "optio.optio.Params.maxMemoLength":
+		return x.MaxMemoLength != uint64(0)
+	case "optio.optio.Params.complianceAccounts":
+		return len(x.ComplianceAccounts) != 0
+	case "optio.optio.Params.allowlistEnabled":
+		return x.AllowlistEnabled != false
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		return x.MaxRecipientsPerMsg != uint64(0)
+	case "optio.optio.Params.gasPerRecipient":
+		return x.GasPerRecipient != uint64(0)
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		return len(x.FeeAllowanceSpendLimit) != 0
+	case "optio.optio.Params.feeAllowanceDuration":
+		return x.FeeAllowanceDuration != nil
+	case "optio.optio.Params.feeAllowanceSponsor":
+		return x.FeeAllowanceSponsor != ""
+	case "optio.optio.Params.feeAllowanceBudget":
+		return len(x.FeeAllowanceBudget) != 0
+	case "optio.optio.Params.transferTimeout":
+		return x.TransferTimeout != nil
+	case "optio.optio.Params.remoteControllers":
+		return len(x.RemoteControllers) != 0
+	case "optio.optio.Params.feeExemptBlockGas":
+		return x.FeeExemptBlockGas != uint64(0)
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		return x.FeeExemptTxsPerWindow != uint64(0)
+	case "optio.optio.Params.feeExemptWindow":
+		return x.FeeExemptWindow != nil
+	case "optio.optio.Params.minimumGasPrices":
+		return len(x.MinimumGasPrices) != 0
+	case "optio.optio.Params.baseFeeTargetGas":
+		return x.BaseFeeTargetGas != uint64(0)
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		return x.BaseFeeMaxChangeRate != ""
+	case "optio.optio.Params.minBaseFee":
+		return x.MinBaseFee != ""
+	case "optio.optio.Params.maxBaseFee":
+		return x.MaxBaseFee != ""
+	case "optio.optio.Params.baseFeeBurnRate":
+		return x.BaseFeeBurnRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
@@ -200,12 +673,1783 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	case "optio.optio.Params.denom":
 		x.Denom = ""
 	case "optio.optio.Params.maxSupply":
-		x.MaxSupply = uint64(0)
+		x.MaxSupply = ""
+	case "optio.optio.Params.maxReferenceLength":
+		x.MaxReferenceLength = uint64(0)
+	case "optio.optio.Params.maxCategoryLength":
+		x.MaxCategoryLength = uint64(0)
+	case "optio.optio.Params.maxMemoLength":
+		x.MaxMemoLength = uint64(0)
+	case "optio.optio.Params.complianceAccounts":
+		x.ComplianceAccounts = nil
+	case "optio.optio.Params.allowlistEnabled":
+		x.AllowlistEnabled = false
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		x.MaxRecipientsPerMsg = uint64(0)
+	case "optio.optio.Params.gasPerRecipient":
+		x.GasPerRecipient = uint64(0)
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		x.FeeAllowanceSpendLimit = nil
+	case "optio.optio.Params.feeAllowanceDuration":
+		x.FeeAllowanceDuration = nil
+	case "optio.optio.Params.feeAllowanceSponsor":
+		x.FeeAllowanceSponsor = ""
+	case "optio.optio.Params.feeAllowanceBudget":
+		x.FeeAllowanceBudget = nil
+	case "optio.optio.Params.transferTimeout":
+		x.TransferTimeout = nil
+	case "optio.optio.Params.remoteControllers":
+		x.RemoteControllers = nil
+	case "optio.optio.Params.feeExemptBlockGas":
+		x.FeeExemptBlockGas = uint64(0)
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		x.FeeExemptTxsPerWindow = uint64(0)
+	case "optio.optio.Params.feeExemptWindow":
+		x.FeeExemptWindow = nil
+	case "optio.optio.Params.minimumGasPrices":
+		x.MinimumGasPrices = nil
+	case "optio.optio.Params.baseFeeTargetGas":
+		x.BaseFeeTargetGas = uint64(0)
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		x.BaseFeeMaxChangeRate = ""
+	case "optio.optio.Params.minBaseFee":
+		x.MinBaseFee = ""
+	case "optio.optio.Params.maxBaseFee":
+		x.MaxBaseFee = ""
+	case "optio.optio.Params.baseFeeBurnRate":
+		x.BaseFeeBurnRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+		}
+		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.optio.Params.authorizedAccounts":
+		if len(x.AuthorizedAccounts) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.AuthorizedAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.maxSupply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.maxReferenceLength":
+		value := x.MaxReferenceLength
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.maxCategoryLength":
+		value := x.MaxCategoryLength
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.maxMemoLength":
+		value := x.MaxMemoLength
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.complianceAccounts":
+		if len(x.ComplianceAccounts) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.ComplianceAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.allowlistEnabled":
+		value := x.AllowlistEnabled
+		return protoreflect.ValueOfBool(value)
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		value := x.MaxRecipientsPerMsg
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.gasPerRecipient":
+		value := x.GasPerRecipient
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		if len(x.FeeAllowanceSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.FeeAllowanceSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.feeAllowanceDuration":
+		value := x.FeeAllowanceDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.Params.feeAllowanceSponsor":
+		value := x.FeeAllowanceSponsor
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.feeAllowanceBudget":
+		if len(x.FeeAllowanceBudget) == 0 {
+			return protoreflect.ValueOfList(&_Params_14_list{})
+		}
+		listValue := &_Params_14_list{list: &x.FeeAllowanceBudget}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.transferTimeout":
+		value := x.TransferTimeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.Params.remoteControllers":
+		if len(x.RemoteControllers) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.RemoteControllers}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.feeExemptBlockGas":
+		value := x.FeeExemptBlockGas
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		value := x.FeeExemptTxsPerWindow
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.feeExemptWindow":
+		value := x.FeeExemptWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.optio.Params.minimumGasPrices":
+		if len(x.MinimumGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_20_list{})
+		}
+		listValue := &_Params_20_list{list: &x.MinimumGasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.optio.Params.baseFeeTargetGas":
+		value := x.BaseFeeTargetGas
+		return protoreflect.ValueOfUint64(value)
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		value := x.BaseFeeMaxChangeRate
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.minBaseFee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.maxBaseFee":
+		value := x.MaxBaseFee
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.Params.baseFeeBurnRate":
+		value := x.BaseFeeBurnRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+		}
+		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.optio.Params.authorizedAccounts":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.AuthorizedAccounts = *clv.list
+	case "optio.optio.Params.denom":
+		x.Denom = value.Interface().(string)
+	case "optio.optio.Params.maxSupply":
+		x.MaxSupply = value.Interface().(string)
+	case "optio.optio.Params.maxReferenceLength":
+		x.MaxReferenceLength = value.Uint()
+	case "optio.optio.Params.maxCategoryLength":
+		x.MaxCategoryLength = value.Uint()
+	case "optio.optio.Params.maxMemoLength":
+		x.MaxMemoLength = value.Uint()
+	case "optio.optio.Params.complianceAccounts":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.ComplianceAccounts = *clv.list
+	case "optio.optio.Params.allowlistEnabled":
+		x.AllowlistEnabled = value.Bool()
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		x.MaxRecipientsPerMsg = value.Uint()
+	case "optio.optio.Params.gasPerRecipient":
+		x.GasPerRecipient = value.Uint()
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.FeeAllowanceSpendLimit = *clv.list
+	case "optio.optio.Params.feeAllowanceDuration":
+		x.FeeAllowanceDuration = value.Message().Interface().(*durationpb.Duration)
+	case "optio.optio.Params.feeAllowanceSponsor":
+		x.FeeAllowanceSponsor = value.Interface().(string)
+	case "optio.optio.Params.feeAllowanceBudget":
+		lv := value.List()
+		clv := lv.(*_Params_14_list)
+		x.FeeAllowanceBudget = *clv.list
+	case "optio.optio.Params.transferTimeout":
+		x.TransferTimeout = value.Message().Interface().(*durationpb.Duration)
+	case "optio.optio.Params.remoteControllers":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.RemoteControllers = *clv.list
+	case "optio.optio.Params.feeExemptBlockGas":
+		x.FeeExemptBlockGas = value.Uint()
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		x.FeeExemptTxsPerWindow = value.Uint()
+	case "optio.optio.Params.feeExemptWindow":
+		x.FeeExemptWindow = value.Message().Interface().(*durationpb.Duration)
+	case "optio.optio.Params.minimumGasPrices":
+		lv := value.List()
+		clv := lv.(*_Params_20_list)
+		x.MinimumGasPrices = *clv.list
+	case "optio.optio.Params.baseFeeTargetGas":
+		x.BaseFeeTargetGas = value.Uint()
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		x.BaseFeeMaxChangeRate = value.Interface().(string)
+	case "optio.optio.Params.minBaseFee":
+		x.MinBaseFee = value.Interface().(string)
+	case "optio.optio.Params.maxBaseFee":
+		x.MaxBaseFee = value.Interface().(string)
+	case "optio.optio.Params.baseFeeBurnRate":
+		x.BaseFeeBurnRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+		}
+		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.optio.Params.authorizedAccounts":
+		if x.AuthorizedAccounts == nil {
+			x.AuthorizedAccounts = []string{}
+		}
+		value := &_Params_1_list{list: &x.AuthorizedAccounts}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.complianceAccounts":
+		if x.ComplianceAccounts == nil {
+			x.ComplianceAccounts = []string{}
+		}
+		value := &_Params_7_list{list: &x.ComplianceAccounts}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		if x.FeeAllowanceSpendLimit == nil {
+			x.FeeAllowanceSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_Params_11_list{list: &x.FeeAllowanceSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.feeAllowanceDuration":
+		if x.FeeAllowanceDuration == nil {
+			x.FeeAllowanceDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.FeeAllowanceDuration.ProtoReflect())
+	case "optio.optio.Params.feeAllowanceBudget":
+		if x.FeeAllowanceBudget == nil {
+			x.FeeAllowanceBudget = []*v1beta1.Coin{}
+		}
+		value := &_Params_14_list{list: &x.FeeAllowanceBudget}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.transferTimeout":
+		if x.TransferTimeout == nil {
+			x.TransferTimeout = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TransferTimeout.ProtoReflect())
+	case "optio.optio.Params.remoteControllers":
+		if x.RemoteControllers == nil {
+			x.RemoteControllers = []*RemoteController{}
+		}
+		value := &_Params_16_list{list: &x.RemoteControllers}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.feeExemptWindow":
+		if x.FeeExemptWindow == nil {
+			x.FeeExemptWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.FeeExemptWindow.ProtoReflect())
+	case "optio.optio.Params.minimumGasPrices":
+		if x.MinimumGasPrices == nil {
+			x.MinimumGasPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_20_list{list: &x.MinimumGasPrices}
+		return protoreflect.ValueOfList(value)
+	case "optio.optio.Params.denom":
+		panic(fmt.Errorf("field denom of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxSupply":
+		panic(fmt.Errorf("field maxSupply of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxReferenceLength":
+		panic(fmt.Errorf("field maxReferenceLength of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxCategoryLength":
+		panic(fmt.Errorf("field maxCategoryLength of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxMemoLength":
+		panic(fmt.Errorf("field maxMemoLength of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.allowlistEnabled":
+		panic(fmt.Errorf("field allowlistEnabled of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		panic(fmt.Errorf("field maxRecipientsPerMsg of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.gasPerRecipient":
+		panic(fmt.Errorf("field gasPerRecipient of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.feeAllowanceSponsor":
+		panic(fmt.Errorf("field feeAllowanceSponsor of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.feeExemptBlockGas":
+		panic(fmt.Errorf("field feeExemptBlockGas of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		panic(fmt.Errorf("field feeExemptTxsPerWindow of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.baseFeeTargetGas":
+		panic(fmt.Errorf("field baseFeeTargetGas of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		panic(fmt.Errorf("field baseFeeMaxChangeRate of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.minBaseFee":
+		panic(fmt.Errorf("field minBaseFee of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.maxBaseFee":
+		panic(fmt.Errorf("field maxBaseFee of message optio.optio.Params is not mutable"))
+	case "optio.optio.Params.baseFeeBurnRate":
+		panic(fmt.Errorf("field baseFeeBurnRate of message optio.optio.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+		}
+		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.optio.Params.authorizedAccounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "optio.optio.Params.denom":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.maxSupply":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.maxReferenceLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.maxCategoryLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.maxMemoLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.complianceAccounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "optio.optio.Params.allowlistEnabled":
+		return protoreflect.ValueOfBool(false)
+	case "optio.optio.Params.maxRecipientsPerMsg":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.gasPerRecipient":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.feeAllowanceSpendLimit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "optio.optio.Params.feeAllowanceDuration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.Params.feeAllowanceSponsor":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.feeAllowanceBudget":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_14_list{list: &list})
+	case "optio.optio.Params.transferTimeout":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.Params.remoteControllers":
+		list := []*RemoteController{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "optio.optio.Params.feeExemptBlockGas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.feeExemptTxsPerWindow":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.feeExemptWindow":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.optio.Params.minimumGasPrices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_20_list{list: &list})
+	case "optio.optio.Params.baseFeeTargetGas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.optio.Params.baseFeeMaxChangeRate":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.minBaseFee":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.maxBaseFee":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.Params.baseFeeBurnRate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+		}
+		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.optio.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AuthorizedAccounts) > 0 {
+			for _, s := range x.AuthorizedAccounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxReferenceLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxReferenceLength))
+		}
+		if x.MaxCategoryLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCategoryLength))
+		}
+		if x.MaxMemoLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMemoLength))
+		}
+		if len(x.ComplianceAccounts) > 0 {
+			for _, s := range x.ComplianceAccounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AllowlistEnabled {
+			n += 2
+		}
+		if x.MaxRecipientsPerMsg != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRecipientsPerMsg))
+		}
+		if x.GasPerRecipient != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerRecipient))
+		}
+		if len(x.FeeAllowanceSpendLimit) > 0 {
+			for _, e := range x.FeeAllowanceSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeAllowanceDuration != nil {
+			l = options.Size(x.FeeAllowanceDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeAllowanceSponsor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeAllowanceBudget) > 0 {
+			for _, e := range x.FeeAllowanceBudget {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TransferTimeout != nil {
+			l = options.Size(x.TransferTimeout)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RemoteControllers) > 0 {
+			for _, e := range x.RemoteControllers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeExemptBlockGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.FeeExemptBlockGas))
+		}
+		if x.FeeExemptTxsPerWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.FeeExemptTxsPerWindow))
+		}
+		if x.FeeExemptWindow != nil {
+			l = options.Size(x.FeeExemptWindow)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinimumGasPrices) > 0 {
+			for _, e := range x.MinimumGasPrices {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BaseFeeTargetGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.BaseFeeTargetGas))
+		}
+		l = len(x.BaseFeeMaxChangeRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseFeeBurnRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BaseFeeBurnRate) > 0 {
+			i -= len(x.BaseFeeBurnRate)
+			copy(dAtA[i:], x.BaseFeeBurnRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeBurnRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.MaxBaseFee) > 0 {
+			i -= len(x.MaxBaseFee)
+			copy(dAtA[i:], x.MaxBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFee)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.BaseFeeMaxChangeRate) > 0 {
+			i -= len(x.BaseFeeMaxChangeRate)
+			copy(dAtA[i:], x.BaseFeeMaxChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeMaxChangeRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.BaseFeeTargetGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeTargetGas))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.MinimumGasPrices) > 0 {
+			for iNdEx := len(x.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinimumGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.FeeExemptWindow != nil {
+			encoded, err := options.Marshal(x.FeeExemptWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.FeeExemptTxsPerWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeExemptTxsPerWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.FeeExemptBlockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeExemptBlockGas))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.RemoteControllers) > 0 {
+			for iNdEx := len(x.RemoteControllers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemoteControllers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.TransferTimeout != nil {
+			encoded, err := options.Marshal(x.TransferTimeout)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.FeeAllowanceBudget) > 0 {
+			for iNdEx := len(x.FeeAllowanceBudget) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeAllowanceBudget[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.FeeAllowanceSponsor) > 0 {
+			i -= len(x.FeeAllowanceSponsor)
+			copy(dAtA[i:], x.FeeAllowanceSponsor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeAllowanceSponsor)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.FeeAllowanceDuration != nil {
+			encoded, err := options.Marshal(x.FeeAllowanceDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.FeeAllowanceSpendLimit) > 0 {
+			for iNdEx := len(x.FeeAllowanceSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeAllowanceSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.GasPerRecipient != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerRecipient))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxRecipientsPerMsg != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecipientsPerMsg))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.AllowlistEnabled {
+			i--
+			if x.AllowlistEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ComplianceAccounts) > 0 {
+			for iNdEx := len(x.ComplianceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ComplianceAccounts[iNdEx])
+				copy(dAtA[i:], x.ComplianceAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ComplianceAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.MaxMemoLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMemoLength))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxCategoryLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCategoryLength))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxReferenceLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxReferenceLength))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AuthorizedAccounts) > 0 {
+			for iNdEx := len(x.AuthorizedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AuthorizedAccounts[iNdEx])
+				copy(dAtA[i:], x.AuthorizedAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthorizedAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthorizedAccounts = append(x.AuthorizedAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxReferenceLength", wireType)
+				}
+				x.MaxReferenceLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxReferenceLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCategoryLength", wireType)
+				}
+				x.MaxCategoryLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCategoryLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+				}
+				x.MaxMemoLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMemoLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComplianceAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ComplianceAccounts = append(x.ComplianceAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AllowlistEnabled = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecipientsPerMsg", wireType)
+				}
+				x.MaxRecipientsPerMsg = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecipientsPerMsg |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerRecipient", wireType)
+				}
+				x.GasPerRecipient = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerRecipient |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeAllowanceSpendLimit = append(x.FeeAllowanceSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAllowanceSpendLimit[len(x.FeeAllowanceSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeAllowanceDuration == nil {
+					x.FeeAllowanceDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAllowanceDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceSponsor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeAllowanceSponsor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceBudget", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeAllowanceBudget = append(x.FeeAllowanceBudget, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAllowanceBudget[len(x.FeeAllowanceBudget)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferTimeout", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TransferTimeout == nil {
+					x.TransferTimeout = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferTimeout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteControllers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemoteControllers = append(x.RemoteControllers, &RemoteController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemoteControllers[len(x.RemoteControllers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptBlockGas", wireType)
+				}
+				x.FeeExemptBlockGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeExemptBlockGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptTxsPerWindow", wireType)
+				}
+				x.FeeExemptTxsPerWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeExemptTxsPerWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeExemptWindow == nil {
+					x.FeeExemptWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeExemptWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumGasPrices = append(x.MinimumGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinimumGasPrices[len(x.MinimumGasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetGas", wireType)
+				}
+				x.BaseFeeTargetGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeTargetGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFeeMaxChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFeeBurnRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RemoteController            protoreflect.MessageDescriptor
+	fd_RemoteController_channel_id protoreflect.FieldDescriptor
+	fd_RemoteController_quota      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_optio_params_proto_init()
+	md_RemoteController = File_optio_optio_params_proto.Messages().ByName("RemoteController")
+	fd_RemoteController_channel_id = md_RemoteController.Fields().ByName("channel_id")
+	fd_RemoteController_quota = md_RemoteController.Fields().ByName("quota")
+}
+
+var _ protoreflect.Message = (*fastReflection_RemoteController)(nil)
+
+type fastReflection_RemoteController RemoteController
+
+func (x *RemoteController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RemoteController)(x)
+}
+
+func (x *RemoteController) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_optio_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RemoteController_messageType fastReflection_RemoteController_messageType
+var _ protoreflect.MessageType = fastReflection_RemoteController_messageType{}
+
+type fastReflection_RemoteController_messageType struct{}
+
+func (x fastReflection_RemoteController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RemoteController)(nil)
+}
+func (x fastReflection_RemoteController_messageType) New() protoreflect.Message {
+	return new(fastReflection_RemoteController)
+}
+func (x fastReflection_RemoteController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RemoteController) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RemoteController) Type() protoreflect.MessageType {
+	return _fastReflection_RemoteController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RemoteController) New() protoreflect.Message {
+	return new(fastReflection_RemoteController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RemoteController) Interface() protoreflect.ProtoMessage {
+	return (*RemoteController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RemoteController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_RemoteController_channel_id, value) {
+			return
+		}
+	}
+	if x.Quota != "" {
+		value := protoreflect.ValueOfString(x.Quota)
+		if !f(fd_RemoteController_quota, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RemoteController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.optio.RemoteController.channel_id":
+		return x.ChannelId != ""
+	case "optio.optio.RemoteController.quota":
+		return x.Quota != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
+		}
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.optio.RemoteController.channel_id":
+		x.ChannelId = ""
+	case "optio.optio.RemoteController.quota":
+		x.Quota = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
 		}
-		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -215,25 +2459,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RemoteController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.optio.Params.authorizedAccounts":
-		if len(x.AuthorizedAccounts) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
-		}
-		listValue := &_Params_1_list{list: &x.AuthorizedAccounts}
-		return protoreflect.ValueOfList(listValue)
-	case "optio.optio.Params.denom":
-		value := x.Denom
+	case "optio.optio.RemoteController.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "optio.optio.RemoteController.quota":
+		value := x.Quota
 		return protoreflect.ValueOfString(value)
-	case "optio.optio.Params.maxSupply":
-		value := x.MaxSupply
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
 		}
-		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -247,21 +2485,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RemoteController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.optio.Params.authorizedAccounts":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.AuthorizedAccounts = *clv.list
-	case "optio.optio.Params.denom":
-		x.Denom = value.Interface().(string)
-	case "optio.optio.Params.maxSupply":
-		x.MaxSupply = value.Uint()
+	case "optio.optio.RemoteController.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "optio.optio.RemoteController.quota":
+		x.Quota = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
 		}
-		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -275,53 +2509,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RemoteController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.optio.Params.authorizedAccounts":
-		if x.AuthorizedAccounts == nil {
-			x.AuthorizedAccounts = []string{}
-		}
-		value := &_Params_1_list{list: &x.AuthorizedAccounts}
-		return protoreflect.ValueOfList(value)
-	case "optio.optio.Params.denom":
-		panic(fmt.Errorf("field denom of message optio.optio.Params is not mutable"))
-	case "optio.optio.Params.maxSupply":
-		panic(fmt.Errorf("field maxSupply of message optio.optio.Params is not mutable"))
+	case "optio.optio.RemoteController.channel_id":
+		panic(fmt.Errorf("field channel_id of message optio.optio.RemoteController is not mutable"))
+	case "optio.optio.RemoteController.quota":
+		panic(fmt.Errorf("field quota of message optio.optio.RemoteController is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
 		}
-		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RemoteController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.optio.Params.authorizedAccounts":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
-	case "optio.optio.Params.denom":
+	case "optio.optio.RemoteController.channel_id":
+		return protoreflect.ValueOfString("")
+	case "optio.optio.RemoteController.quota":
 		return protoreflect.ValueOfString("")
-	case "optio.optio.Params.maxSupply":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.optio.RemoteController"))
 		}
-		panic(fmt.Errorf("message optio.optio.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.optio.RemoteController does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RemoteController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.optio.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.optio.RemoteController", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -329,7 +2554,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RemoteController) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -340,7 +2565,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RemoteController) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -352,7 +2577,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_RemoteController) IsValid() bool {
 	return x != nil
 }
 
@@ -362,9 +2587,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RemoteController) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*RemoteController)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -376,18 +2601,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.AuthorizedAccounts) > 0 {
-			for _, s := range x.AuthorizedAccounts {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Denom)
+		l = len(x.ChannelId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxSupply != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxSupply))
+		l = len(x.Quota)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -399,7 +2619,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*RemoteController)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -418,26 +2638,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxSupply != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSupply))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.Quota) > 0 {
+			i -= len(x.Quota)
+			copy(dAtA[i:], x.Quota)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quota)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.AuthorizedAccounts) > 0 {
-			for iNdEx := len(x.AuthorizedAccounts) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AuthorizedAccounts[iNdEx])
-				copy(dAtA[i:], x.AuthorizedAccounts[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthorizedAccounts[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -450,7 +2663,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*RemoteController)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -482,15 +2695,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteController: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteController: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAccounts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -518,11 +2731,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AuthorizedAccounts = append(x.AuthorizedAccounts, string(dAtA[iNdEx:postIndex]))
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -550,27 +2763,8 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Quota = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
-				}
-				x.MaxSupply = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxSupply |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -627,7 +2821,67 @@ type Params struct {
 
 	AuthorizedAccounts []string `protobuf:"bytes,1,rep,name=authorizedAccounts,proto3" json:"authorizedAccounts,omitempty"`
 	Denom              string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	MaxSupply          uint64   `protobuf:"varint,3,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	MaxSupply          string   `protobuf:"bytes,3,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	MaxReferenceLength uint64   `protobuf:"varint,4,opt,name=maxReferenceLength,proto3" json:"maxReferenceLength,omitempty"`
+	MaxCategoryLength  uint64   `protobuf:"varint,5,opt,name=maxCategoryLength,proto3" json:"maxCategoryLength,omitempty"`
+	MaxMemoLength      uint64   `protobuf:"varint,6,opt,name=maxMemoLength,proto3" json:"maxMemoLength,omitempty"`
+	// complianceAccounts may update address statuses via MsgSetAddressStatus.
+	ComplianceAccounts []string `protobuf:"bytes,7,rep,name=complianceAccounts,proto3" json:"complianceAccounts,omitempty"`
+	// allowlistEnabled restricts recipients to addresses marked as allowed.
+	AllowlistEnabled bool `protobuf:"varint,8,opt,name=allowlistEnabled,proto3" json:"allowlistEnabled,omitempty"`
+	// maxRecipientsPerMsg caps the recipients of a single MsgDistribute, zero disables the cap.
+	MaxRecipientsPerMsg uint64 `protobuf:"varint,9,opt,name=maxRecipientsPerMsg,proto3" json:"maxRecipientsPerMsg,omitempty"`
+	// gasPerRecipient is charged for every recipient of a MsgDistribute.
+	GasPerRecipient uint64 `protobuf:"varint,10,opt,name=gasPerRecipient,proto3" json:"gasPerRecipient,omitempty"`
+	// feeAllowanceSpendLimit is the spend limit of the basic fee allowance
+	// granted to first-time recipients, empty disables the allowances.
+	FeeAllowanceSpendLimit []*v1beta1.Coin `protobuf:"bytes,11,rep,name=feeAllowanceSpendLimit,proto3" json:"feeAllowanceSpendLimit,omitempty"`
+	// feeAllowanceDuration is how long a granted fee allowance is valid.
+	FeeAllowanceDuration *durationpb.Duration `protobuf:"bytes,12,opt,name=feeAllowanceDuration,proto3" json:"feeAllowanceDuration,omitempty"`
+	// feeAllowanceSponsor pays for the fee allowances and must have registered
+	// as a fee sponsor, empty means the fee allowance account of the module,
+	// which anyone can fund.
+	FeeAllowanceSponsor string `protobuf:"bytes,13,opt,name=feeAllowanceSponsor,proto3" json:"feeAllowanceSponsor,omitempty"`
+	// feeAllowanceBudget caps the sum of all spend limits granted.
+	FeeAllowanceBudget []*v1beta1.Coin `protobuf:"bytes,14,rep,name=feeAllowanceBudget,proto3" json:"feeAllowanceBudget,omitempty"`
+	// transferTimeout is the relative timeout of the ICS-20 transfers sent to
+	// cross-chain recipients and of the distribution packets sent to host
+	// chains, zero disables both.
+	TransferTimeout *durationpb.Duration `protobuf:"bytes,15,opt,name=transferTimeout,proto3" json:"transferTimeout,omitempty"`
+	// remoteControllers lists the channels of the optio port over which a
+	// controller chain may send distribution packets.
+	RemoteControllers []*RemoteController `protobuf:"bytes,16,rep,name=remoteControllers,proto3" json:"remoteControllers,omitempty"`
+	// feeExemptBlockGas caps the summed gas limit of the fee exempt txs in a
+	// block, zero disables fee exemptions. Txs containing only optio messages
+	// signed by authorized accounts are exempt from minimum gas prices.
+	FeeExemptBlockGas uint64 `protobuf:"varint,17,opt,name=feeExemptBlockGas,proto3" json:"feeExemptBlockGas,omitempty"`
+	// feeExemptTxsPerWindow caps the fee exempt txs of an authorized account
+	// within feeExemptWindow.
+	FeeExemptTxsPerWindow uint64 `protobuf:"varint,18,opt,name=feeExemptTxsPerWindow,proto3" json:"feeExemptTxsPerWindow,omitempty"`
+	// feeExemptWindow is the duration after which the fee exempt txs of an
+	// account are reset.
+	FeeExemptWindow *durationpb.Duration `protobuf:"bytes,19,opt,name=feeExemptWindow,proto3" json:"feeExemptWindow,omitempty"`
+	// minimumGasPrices are the chain-wide minimum gas prices. Nodes only
+	// accept txs paying one of these denoms into their mempool, at no less
+	// than these prices, and blocks reject txs paying less. Empty leaves the
+	// fees to the nodes.
+	MinimumGasPrices []*v1beta1.DecCoin `protobuf:"bytes,20,rep,name=minimumGasPrices,proto3" json:"minimumGasPrices,omitempty"`
+	// baseFeeTargetGas is the gas per block the base fee steers towards, zero
+	// disables the base fee. Every tx must pay the base fee in the module
+	// denom for its gas limit.
+	BaseFeeTargetGas uint64 `protobuf:"varint,21,opt,name=baseFeeTargetGas,proto3" json:"baseFeeTargetGas,omitempty"`
+	// baseFeeMaxChangeRate bounds the relative change of the base fee from one
+	// block to the next. The full rate applies to blocks using no gas or twice
+	// the target gas.
+	BaseFeeMaxChangeRate string `protobuf:"bytes,22,opt,name=baseFeeMaxChangeRate,proto3" json:"baseFeeMaxChangeRate,omitempty"`
+	// minBaseFee is the lowest base fee per unit of gas.
+	MinBaseFee string `protobuf:"bytes,23,opt,name=minBaseFee,proto3" json:"minBaseFee,omitempty"`
+	// maxBaseFee is the highest base fee per unit of gas, zero disables the
+	// cap.
+	MaxBaseFee string `protobuf:"bytes,24,opt,name=maxBaseFee,proto3" json:"maxBaseFee,omitempty"`
+	// baseFeeBurnRate is the share of the base fee that is burned, the rest
+	// of the fee goes to the fee collector.
+	BaseFeeBurnRate string `protobuf:"bytes,25,opt,name=baseFeeBurnRate,proto3" json:"baseFeeBurnRate,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -664,45 +2918,406 @@ func (x *Params) GetDenom() string {
 	return ""
 }
 
-func (x *Params) GetMaxSupply() uint64 {
+func (x *Params) GetMaxSupply() string {
 	if x != nil {
 		return x.MaxSupply
 	}
+	return ""
+}
+
+func (x *Params) GetMaxReferenceLength() uint64 {
+	if x != nil {
+		return x.MaxReferenceLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxCategoryLength() uint64 {
+	if x != nil {
+		return x.MaxCategoryLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMemoLength() uint64 {
+	if x != nil {
+		return x.MaxMemoLength
+	}
+	return 0
+}
+
+func (x *Params) GetComplianceAccounts() []string {
+	if x != nil {
+		return x.ComplianceAccounts
+	}
+	return nil
+}
+
+func (x *Params) GetAllowlistEnabled() bool {
+	if x != nil {
+		return x.AllowlistEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxRecipientsPerMsg() uint64 {
+	if x != nil {
+		return x.MaxRecipientsPerMsg
+	}
+	return 0
+}
+
+func (x *Params) GetGasPerRecipient() uint64 {
+	if x != nil {
+		return x.GasPerRecipient
+	}
+	return 0
+}
+
+func (x *Params) GetFeeAllowanceSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeAllowanceSpendLimit
+	}
+	return nil
+}
+
+func (x *Params) GetFeeAllowanceDuration() *durationpb.Duration {
+	if x != nil {
+		return x.FeeAllowanceDuration
+	}
+	return nil
+}
+
+func (x *Params) GetFeeAllowanceSponsor() string {
+	if x != nil {
+		return x.FeeAllowanceSponsor
+	}
+	return ""
+}
+
+func (x *Params) GetFeeAllowanceBudget() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeAllowanceBudget
+	}
+	return nil
+}
+
+func (x *Params) GetTransferTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.TransferTimeout
+	}
+	return nil
+}
+
+func (x *Params) GetRemoteControllers() []*RemoteController {
+	if x != nil {
+		return x.RemoteControllers
+	}
+	return nil
+}
+
+func (x *Params) GetFeeExemptBlockGas() uint64 {
+	if x != nil {
+		return x.FeeExemptBlockGas
+	}
+	return 0
+}
+
+func (x *Params) GetFeeExemptTxsPerWindow() uint64 {
+	if x != nil {
+		return x.FeeExemptTxsPerWindow
+	}
+	return 0
+}
+
+func (x *Params) GetFeeExemptWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FeeExemptWindow
+	}
+	return nil
+}
+
+func (x *Params) GetMinimumGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.MinimumGasPrices
+	}
+	return nil
+}
+
+func (x *Params) GetBaseFeeTargetGas() uint64 {
+	if x != nil {
+		return x.BaseFeeTargetGas
+	}
 	return 0
 }
 
+func (x *Params) GetBaseFeeMaxChangeRate() string {
+	if x != nil {
+		return x.BaseFeeMaxChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFee() string {
+	if x != nil {
+		return x.MaxBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetBaseFeeBurnRate() string {
+	if x != nil {
+		return x.BaseFeeBurnRate
+	}
+	return ""
+}
+
+// RemoteController approves a channel of the optio port to execute the
+// distributions sent by the controller chain at its other end.
+type RemoteController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// quota caps the total amount distributed over the channel.
+	Quota string `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *RemoteController) Reset() {
+	*x = RemoteController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_optio_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteController) ProtoMessage() {}
+
+// Deprecated: Use RemoteController.ProtoReflect.Descriptor instead.
+func (*RemoteController) Descriptor() ([]byte, []int) {
+	return file_optio_optio_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RemoteController) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RemoteController) GetQuota() string {
+	if x != nil {
+		return x.Quota
+	}
+	return ""
+}
+
 var File_optio_optio_params_proto protoreflect.FileDescriptor
 
 var file_optio_optio_params_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x74, 0x69,
 	0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
-	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xd4, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x52, 0x09, 0x6d,
-	0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x9b, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58,
-	0xaa, 0x02, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0xca, 0x02,
-	0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0xe2, 0x02, 0x17, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x14, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x22, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x63,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x45, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x4f, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x40, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x4e, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1c,
+	0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x10, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x53,
+	0x0a, 0x13, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f,
+	0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x22, 0x52, 0x13,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x0f, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde,
+	0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0f, 0x67, 0x61, 0x73, 0x50,
+	0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0xac, 0x01, 0x0a, 0x16,
+	0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x59, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x16, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x78, 0x0a, 0x14, 0x66, 0x65,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x29, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14,
+	0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x22, 0x52, 0x13, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x66, 0x65, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x54, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x66, 0x65, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x68, 0x0a, 0x0f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x23,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x98,
+	0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x73, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x26,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73,
+	0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x66, 0x65, 0x65,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x67, 0x61, 0x73, 0x22, 0x52, 0x11, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x12, 0x5a, 0x0a, 0x15, 0x66, 0x65, 0x65, 0x45,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x78,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x15, 0x66,
+	0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x69, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f,
+	0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x9f, 0x01, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x55, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x4a, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x47, 0x61, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f,
+	0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x22, 0x52, 0x10, 0x62, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x12, 0x8d, 0x01,
+	0x0a, 0x14, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x59, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x4d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x6d, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x6d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x7d, 0x0a, 0x0f, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x53, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x7f, 0x0a, 0x10, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x9b, 0x01, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x42, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0xa2, 0x02, 0x03,
+	0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0xca, 0x02, 0x0b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0xe2,
+	0x02, 0x17, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x3a, 0x3a, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -717,16 +3332,27 @@ func file_optio_optio_params_proto_rawDescGZIP() []byte {
 	return file_optio_optio_params_proto_rawDescData
 }
 
-var file_optio_optio_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_optio_optio_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_optio_optio_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: optio.optio.Params
+	(*Params)(nil),              // 0: optio.optio.Params
+	(*RemoteController)(nil),    // 1: optio.optio.RemoteController
+	(*v1beta1.Coin)(nil),        // 2: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+	(*v1beta1.DecCoin)(nil),     // 4: cosmos.base.v1beta1.DecCoin
 }
 var file_optio_optio_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: optio.optio.Params.feeAllowanceSpendLimit:type_name -> cosmos.base.v1beta1.Coin
+	3, // 1: optio.optio.Params.feeAllowanceDuration:type_name -> google.protobuf.Duration
+	2, // 2: optio.optio.Params.feeAllowanceBudget:type_name -> cosmos.base.v1beta1.Coin
+	3, // 3: optio.optio.Params.transferTimeout:type_name -> google.protobuf.Duration
+	1, // 4: optio.optio.Params.remoteControllers:type_name -> optio.optio.RemoteController
+	3, // 5: optio.optio.Params.feeExemptWindow:type_name -> google.protobuf.Duration
+	4, // 6: optio.optio.Params.minimumGasPrices:type_name -> cosmos.base.v1beta1.DecCoin
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_optio_optio_params_proto_init() }
@@ -747,6 +3373,18 @@ func file_optio_optio_params_proto_init() {
 				return nil
 			}
 		}
+		file_optio_optio_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -754,7 +3392,7 @@ func file_optio_optio_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_optio_optio_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
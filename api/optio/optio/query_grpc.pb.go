@@ -19,7 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/optio.optio.Query/Params"
+	Query_Params_FullMethodName                = "/optio.optio.Query/Params"
+	Query_Distribution_FullMethodName          = "/optio.optio.Query/Distribution"
+	Query_DistributionAll_FullMethodName       = "/optio.optio.Query/DistributionAll"
+	Query_AddressStatus_FullMethodName         = "/optio.optio.Query/AddressStatus"
+	Query_AddressStatusAll_FullMethodName      = "/optio.optio.Query/AddressStatusAll"
+	Query_DistributeGas_FullMethodName         = "/optio.optio.Query/DistributeGas"
+	Query_AuthorizedAccounts_FullMethodName    = "/optio.optio.Query/AuthorizedAccounts"
+	Query_FeeAllowanceBudget_FullMethodName    = "/optio.optio.Query/FeeAllowanceBudget"
+	Query_TransferPacket_FullMethodName        = "/optio.optio.Query/TransferPacket"
+	Query_TransferPacketAll_FullMethodName     = "/optio.optio.Query/TransferPacketAll"
+	Query_RateLimit_FullMethodName             = "/optio.optio.Query/RateLimit"
+	Query_RateLimitAll_FullMethodName          = "/optio.optio.Query/RateLimitAll"
+	Query_RemoteTreasury_FullMethodName        = "/optio.optio.Query/RemoteTreasury"
+	Query_RemoteDistribution_FullMethodName    = "/optio.optio.Query/RemoteDistribution"
+	Query_RemoteDistributionAll_FullMethodName = "/optio.optio.Query/RemoteDistributionAll"
+	Query_PacketOutcome_FullMethodName         = "/optio.optio.Query/PacketOutcome"
+	Query_PacketOutcomeAll_FullMethodName      = "/optio.optio.Query/PacketOutcomeAll"
+	Query_PendingPayouts_FullMethodName        = "/optio.optio.Query/PendingPayouts"
+	Query_FeeExemption_FullMethodName          = "/optio.optio.Query/FeeExemption"
+	Query_BaseFee_FullMethodName               = "/optio.optio.Query/BaseFee"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +47,48 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Distribution by id.
+	Distribution(ctx context.Context, in *QueryGetDistributionRequest, opts ...grpc.CallOption) (*QueryGetDistributionResponse, error)
+	// Queries a list of Distribution items, optionally filtered by category.
+	DistributionAll(ctx context.Context, in *QueryAllDistributionRequest, opts ...grpc.CallOption) (*QueryAllDistributionResponse, error)
+	// Queries the compliance status of an address.
+	AddressStatus(ctx context.Context, in *QueryGetAddressStatusRequest, opts ...grpc.CallOption) (*QueryGetAddressStatusResponse, error)
+	// Queries a list of AddressStatus items.
+	AddressStatusAll(ctx context.Context, in *QueryAllAddressStatusRequest, opts ...grpc.CallOption) (*QueryAllAddressStatusResponse, error)
+	// DistributeGas returns the gas charged for distributing to a number of
+	// recipients and how many messages are needed to stay under the cap.
+	DistributeGas(ctx context.Context, in *QueryDistributeGasRequest, opts ...grpc.CallOption) (*QueryDistributeGasResponse, error)
+	// AuthorizedAccounts lists the accounts holding a role in Params with
+	// their distribution statistics.
+	AuthorizedAccounts(ctx context.Context, in *QueryAuthorizedAccountsRequest, opts ...grpc.CallOption) (*QueryAuthorizedAccountsResponse, error)
+	// FeeAllowanceBudget returns the fee allowance budget and its usage.
+	FeeAllowanceBudget(ctx context.Context, in *QueryFeeAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryFeeAllowanceBudgetResponse, error)
+	// Queries the ICS-20 transfer sent to a cross-chain recipient.
+	TransferPacket(ctx context.Context, in *QueryGetTransferPacketRequest, opts ...grpc.CallOption) (*QueryGetTransferPacketResponse, error)
+	// Queries a list of TransferPacket items.
+	TransferPacketAll(ctx context.Context, in *QueryAllTransferPacketRequest, opts ...grpc.CallOption) (*QueryAllTransferPacketResponse, error)
+	// Queries the rate limit of an ICS-20 channel.
+	RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error)
+	// Queries the remote treasury of the module on the host chain of a
+	// connection.
+	RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error)
+	// Queries a RemoteDistribution by channel and sequence.
+	RemoteDistribution(ctx context.Context, in *QueryGetRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryGetRemoteDistributionResponse, error)
+	// Queries a list of RemoteDistribution items.
+	RemoteDistributionAll(ctx context.Context, in *QueryAllRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryAllRemoteDistributionResponse, error)
+	// Queries the outcome of a packet sent by the module.
+	PacketOutcome(ctx context.Context, in *QueryGetPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryGetPacketOutcomeResponse, error)
+	// Queries a list of PacketOutcome items.
+	PacketOutcomeAll(ctx context.Context, in *QueryAllPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryAllPacketOutcomeResponse, error)
+	// Queries the cross-chain payouts that await their acknowledgement.
+	PendingPayouts(ctx context.Context, in *QueryPendingPayoutsRequest, opts ...grpc.CallOption) (*QueryPendingPayoutsResponse, error)
+	// Queries the fee exempt txs an authorized account sent in the current
+	// window.
+	FeeExemption(ctx context.Context, in *QueryFeeExemptionRequest, opts ...grpc.CallOption) (*QueryFeeExemptionResponse, error)
+	// Queries the base fee per unit of gas of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +108,225 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Distribution(ctx context.Context, in *QueryGetDistributionRequest, opts ...grpc.CallOption) (*QueryGetDistributionResponse, error) {
+	out := new(QueryGetDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_Distribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionAll(ctx context.Context, in *QueryAllDistributionRequest, opts ...grpc.CallOption) (*QueryAllDistributionResponse, error) {
+	out := new(QueryAllDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_DistributionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStatus(ctx context.Context, in *QueryGetAddressStatusRequest, opts ...grpc.CallOption) (*QueryGetAddressStatusResponse, error) {
+	out := new(QueryGetAddressStatusResponse)
+	err := c.cc.Invoke(ctx, Query_AddressStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStatusAll(ctx context.Context, in *QueryAllAddressStatusRequest, opts ...grpc.CallOption) (*QueryAllAddressStatusResponse, error) {
+	out := new(QueryAllAddressStatusResponse)
+	err := c.cc.Invoke(ctx, Query_AddressStatusAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributeGas(ctx context.Context, in *QueryDistributeGasRequest, opts ...grpc.CallOption) (*QueryDistributeGasResponse, error) {
+	out := new(QueryDistributeGasResponse)
+	err := c.cc.Invoke(ctx, Query_DistributeGas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizedAccounts(ctx context.Context, in *QueryAuthorizedAccountsRequest, opts ...grpc.CallOption) (*QueryAuthorizedAccountsResponse, error) {
+	out := new(QueryAuthorizedAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_AuthorizedAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAllowanceBudget(ctx context.Context, in *QueryFeeAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryFeeAllowanceBudgetResponse, error) {
+	out := new(QueryFeeAllowanceBudgetResponse)
+	err := c.cc.Invoke(ctx, Query_FeeAllowanceBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPacket(ctx context.Context, in *QueryGetTransferPacketRequest, opts ...grpc.CallOption) (*QueryGetTransferPacketResponse, error) {
+	out := new(QueryGetTransferPacketResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPacketAll(ctx context.Context, in *QueryAllTransferPacketRequest, opts ...grpc.CallOption) (*QueryAllTransferPacketResponse, error) {
+	out := new(QueryAllTransferPacketResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPacketAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error) {
+	out := new(QueryGetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error) {
+	out := new(QueryAllRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimitAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error) {
+	out := new(QueryRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, Query_RemoteTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteDistribution(ctx context.Context, in *QueryGetRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryGetRemoteDistributionResponse, error) {
+	out := new(QueryGetRemoteDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_RemoteDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteDistributionAll(ctx context.Context, in *QueryAllRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryAllRemoteDistributionResponse, error) {
+	out := new(QueryAllRemoteDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_RemoteDistributionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketOutcome(ctx context.Context, in *QueryGetPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryGetPacketOutcomeResponse, error) {
+	out := new(QueryGetPacketOutcomeResponse)
+	err := c.cc.Invoke(ctx, Query_PacketOutcome_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketOutcomeAll(ctx context.Context, in *QueryAllPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryAllPacketOutcomeResponse, error) {
+	out := new(QueryAllPacketOutcomeResponse)
+	err := c.cc.Invoke(ctx, Query_PacketOutcomeAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPayouts(ctx context.Context, in *QueryPendingPayoutsRequest, opts ...grpc.CallOption) (*QueryPendingPayoutsResponse, error) {
+	out := new(QueryPendingPayoutsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingPayouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeExemption(ctx context.Context, in *QueryFeeExemptionRequest, opts ...grpc.CallOption) (*QueryFeeExemptionResponse, error) {
+	out := new(QueryFeeExemptionResponse)
+	err := c.cc.Invoke(ctx, Query_FeeExemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Distribution by id.
+	Distribution(context.Context, *QueryGetDistributionRequest) (*QueryGetDistributionResponse, error)
+	// Queries a list of Distribution items, optionally filtered by category.
+	DistributionAll(context.Context, *QueryAllDistributionRequest) (*QueryAllDistributionResponse, error)
+	// Queries the compliance status of an address.
+	AddressStatus(context.Context, *QueryGetAddressStatusRequest) (*QueryGetAddressStatusResponse, error)
+	// Queries a list of AddressStatus items.
+	AddressStatusAll(context.Context, *QueryAllAddressStatusRequest) (*QueryAllAddressStatusResponse, error)
+	// DistributeGas returns the gas charged for distributing to a number of
+	// recipients and how many messages are needed to stay under the cap.
+	DistributeGas(context.Context, *QueryDistributeGasRequest) (*QueryDistributeGasResponse, error)
+	// AuthorizedAccounts lists the accounts holding a role in Params with
+	// their distribution statistics.
+	AuthorizedAccounts(context.Context, *QueryAuthorizedAccountsRequest) (*QueryAuthorizedAccountsResponse, error)
+	// FeeAllowanceBudget returns the fee allowance budget and its usage.
+	FeeAllowanceBudget(context.Context, *QueryFeeAllowanceBudgetRequest) (*QueryFeeAllowanceBudgetResponse, error)
+	// Queries the ICS-20 transfer sent to a cross-chain recipient.
+	TransferPacket(context.Context, *QueryGetTransferPacketRequest) (*QueryGetTransferPacketResponse, error)
+	// Queries a list of TransferPacket items.
+	TransferPacketAll(context.Context, *QueryAllTransferPacketRequest) (*QueryAllTransferPacketResponse, error)
+	// Queries the rate limit of an ICS-20 channel.
+	RateLimit(context.Context, *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(context.Context, *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error)
+	// Queries the remote treasury of the module on the host chain of a
+	// connection.
+	RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error)
+	// Queries a RemoteDistribution by channel and sequence.
+	RemoteDistribution(context.Context, *QueryGetRemoteDistributionRequest) (*QueryGetRemoteDistributionResponse, error)
+	// Queries a list of RemoteDistribution items.
+	RemoteDistributionAll(context.Context, *QueryAllRemoteDistributionRequest) (*QueryAllRemoteDistributionResponse, error)
+	// Queries the outcome of a packet sent by the module.
+	PacketOutcome(context.Context, *QueryGetPacketOutcomeRequest) (*QueryGetPacketOutcomeResponse, error)
+	// Queries a list of PacketOutcome items.
+	PacketOutcomeAll(context.Context, *QueryAllPacketOutcomeRequest) (*QueryAllPacketOutcomeResponse, error)
+	// Queries the cross-chain payouts that await their acknowledgement.
+	PendingPayouts(context.Context, *QueryPendingPayoutsRequest) (*QueryPendingPayoutsResponse, error)
+	// Queries the fee exempt txs an authorized account sent in the current
+	// window.
+	FeeExemption(context.Context, *QueryFeeExemptionRequest) (*QueryFeeExemptionResponse, error)
+	// Queries the base fee per unit of gas of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +337,63 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) Distribution(context.Context, *QueryGetDistributionRequest) (*QueryGetDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (UnimplementedQueryServer) DistributionAll(context.Context, *QueryAllDistributionRequest) (*QueryAllDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionAll not implemented")
+}
+func (UnimplementedQueryServer) AddressStatus(context.Context, *QueryGetAddressStatusRequest) (*QueryGetAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStatus not implemented")
+}
+func (UnimplementedQueryServer) AddressStatusAll(context.Context, *QueryAllAddressStatusRequest) (*QueryAllAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStatusAll not implemented")
+}
+func (UnimplementedQueryServer) DistributeGas(context.Context, *QueryDistributeGasRequest) (*QueryDistributeGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeGas not implemented")
+}
+func (UnimplementedQueryServer) AuthorizedAccounts(context.Context, *QueryAuthorizedAccountsRequest) (*QueryAuthorizedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAccounts not implemented")
+}
+func (UnimplementedQueryServer) FeeAllowanceBudget(context.Context, *QueryFeeAllowanceBudgetRequest) (*QueryFeeAllowanceBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAllowanceBudget not implemented")
+}
+func (UnimplementedQueryServer) TransferPacket(context.Context, *QueryGetTransferPacketRequest) (*QueryGetTransferPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPacket not implemented")
+}
+func (UnimplementedQueryServer) TransferPacketAll(context.Context, *QueryAllTransferPacketRequest) (*QueryAllTransferPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPacketAll not implemented")
+}
+func (UnimplementedQueryServer) RateLimit(context.Context, *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (UnimplementedQueryServer) RateLimitAll(context.Context, *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitAll not implemented")
+}
+func (UnimplementedQueryServer) RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteTreasury not implemented")
+}
+func (UnimplementedQueryServer) RemoteDistribution(context.Context, *QueryGetRemoteDistributionRequest) (*QueryGetRemoteDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteDistribution not implemented")
+}
+func (UnimplementedQueryServer) RemoteDistributionAll(context.Context, *QueryAllRemoteDistributionRequest) (*QueryAllRemoteDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteDistributionAll not implemented")
+}
+func (UnimplementedQueryServer) PacketOutcome(context.Context, *QueryGetPacketOutcomeRequest) (*QueryGetPacketOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketOutcome not implemented")
+}
+func (UnimplementedQueryServer) PacketOutcomeAll(context.Context, *QueryAllPacketOutcomeRequest) (*QueryAllPacketOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketOutcomeAll not implemented")
+}
+func (UnimplementedQueryServer) PendingPayouts(context.Context, *QueryPendingPayoutsRequest) (*QueryPendingPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPayouts not implemented")
+}
+func (UnimplementedQueryServer) FeeExemption(context.Context, *QueryFeeExemptionRequest) (*QueryFeeExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeExemption not implemented")
+}
+func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +425,348 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Distribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distribution(ctx, req.(*QueryGetDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DistributionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionAll(ctx, req.(*QueryAllDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressStatus(ctx, req.(*QueryGetAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressStatusAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressStatusAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressStatusAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressStatusAll(ctx, req.(*QueryAllAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributeGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributeGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributeGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DistributeGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributeGas(ctx, req.(*QueryDistributeGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuthorizedAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAccounts(ctx, req.(*QueryAuthorizedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAllowanceBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAllowanceBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAllowanceBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeAllowanceBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAllowanceBudget(ctx, req.(*QueryFeeAllowanceBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransferPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPacket(ctx, req.(*QueryGetTransferPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPacketAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransferPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPacketAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPacketAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPacketAll(ctx, req.(*QueryAllTransferPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryGetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimitAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitAll(ctx, req.(*QueryAllRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemoteTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemoteTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteTreasury(ctx, req.(*QueryRemoteTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemoteDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemoteDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteDistribution(ctx, req.(*QueryGetRemoteDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteDistributionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRemoteDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteDistributionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemoteDistributionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteDistributionAll(ctx, req.(*QueryAllRemoteDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPacketOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketOutcome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketOutcome(ctx, req.(*QueryGetPacketOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketOutcomeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPacketOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketOutcomeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PacketOutcomeAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketOutcomeAll(ctx, req.(*QueryAllPacketOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPayouts(ctx, req.(*QueryPendingPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeExemption(ctx, req.(*QueryFeeExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +778,82 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Distribution",
+			Handler:    _Query_Distribution_Handler,
+		},
+		{
+			MethodName: "DistributionAll",
+			Handler:    _Query_DistributionAll_Handler,
+		},
+		{
+			MethodName: "AddressStatus",
+			Handler:    _Query_AddressStatus_Handler,
+		},
+		{
+			MethodName: "AddressStatusAll",
+			Handler:    _Query_AddressStatusAll_Handler,
+		},
+		{
+			MethodName: "DistributeGas",
+			Handler:    _Query_DistributeGas_Handler,
+		},
+		{
+			MethodName: "AuthorizedAccounts",
+			Handler:    _Query_AuthorizedAccounts_Handler,
+		},
+		{
+			MethodName: "FeeAllowanceBudget",
+			Handler:    _Query_FeeAllowanceBudget_Handler,
+		},
+		{
+			MethodName: "TransferPacket",
+			Handler:    _Query_TransferPacket_Handler,
+		},
+		{
+			MethodName: "TransferPacketAll",
+			Handler:    _Query_TransferPacketAll_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimitAll",
+			Handler:    _Query_RateLimitAll_Handler,
+		},
+		{
+			MethodName: "RemoteTreasury",
+			Handler:    _Query_RemoteTreasury_Handler,
+		},
+		{
+			MethodName: "RemoteDistribution",
+			Handler:    _Query_RemoteDistribution_Handler,
+		},
+		{
+			MethodName: "RemoteDistributionAll",
+			Handler:    _Query_RemoteDistributionAll_Handler,
+		},
+		{
+			MethodName: "PacketOutcome",
+			Handler:    _Query_PacketOutcome_Handler,
+		},
+		{
+			MethodName: "PacketOutcomeAll",
+			Handler:    _Query_PacketOutcomeAll_Handler,
+		},
+		{
+			MethodName: "PendingPayouts",
+			Handler:    _Query_PendingPayouts_Handler,
+		},
+		{
+			MethodName: "FeeExemption",
+			Handler:    _Query_FeeExemption_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "optio/optio/query.proto",
@@ -19,8 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/optio.optio.Msg/UpdateParams"
-	Msg_Distribute_FullMethodName   = "/optio.optio.Msg/Distribute"
+	Msg_UpdateParams_FullMethodName           = "/optio.optio.Msg/UpdateParams"
+	Msg_Distribute_FullMethodName             = "/optio.optio.Msg/Distribute"
+	Msg_SetAddressStatus_FullMethodName       = "/optio.optio.Msg/SetAddressStatus"
+	Msg_SendDistribution_FullMethodName       = "/optio.optio.Msg/SendDistribution"
+	Msg_SetRateLimit_FullMethodName           = "/optio.optio.Msg/SetRateLimit"
+	Msg_RemoveRateLimit_FullMethodName        = "/optio.optio.Msg/RemoveRateLimit"
+	Msg_RegisterRemoteTreasury_FullMethodName = "/optio.optio.Msg/RegisterRemoteTreasury"
+	Msg_RemoteDistribute_FullMethodName       = "/optio.optio.Msg/RemoteDistribute"
+	Msg_RegisterFeeSponsor_FullMethodName     = "/optio.optio.Msg/RegisterFeeSponsor"
+	Msg_UnregisterFeeSponsor_FullMethodName   = "/optio.optio.Msg/UnregisterFeeSponsor"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +39,29 @@ type MsgClient interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	Distribute(ctx context.Context, in *MsgDistribute, opts ...grpc.CallOption) (*MsgDistributeResponse, error)
+	// SetAddressStatus updates the compliance status of one or more addresses.
+	SetAddressStatus(ctx context.Context, in *MsgSetAddressStatus, opts ...grpc.CallOption) (*MsgSetAddressStatusResponse, error)
+	// SendDistribution sends a distribution packet to the host chain at the
+	// other end of an optio channel, where it is minted and paid out.
+	SendDistribution(ctx context.Context, in *MsgSendDistribution, opts ...grpc.CallOption) (*MsgSendDistributionResponse, error)
+	// SetRateLimit defines a (governance) operation for adding or updating the
+	// rate limit of an ICS-20 channel. The flow of the channel is reset.
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit defines a (governance) operation for removing the rate
+	// limit of an ICS-20 channel.
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	// RegisterRemoteTreasury registers an interchain account owned by the
+	// module on the host chain of a connection.
+	RegisterRemoteTreasury(ctx context.Context, in *MsgRegisterRemoteTreasury, opts ...grpc.CallOption) (*MsgRegisterRemoteTreasuryResponse, error)
+	// RemoteDistribute executes a bank multi-send from the remote treasury of
+	// a connection.
+	RemoteDistribute(ctx context.Context, in *MsgRemoteDistribute, opts ...grpc.CallOption) (*MsgRemoteDistributeResponse, error)
+	// RegisterFeeSponsor records the consent of an account to pay for the fee
+	// allowances of recipients once governance makes it the sponsor.
+	RegisterFeeSponsor(ctx context.Context, in *MsgRegisterFeeSponsor, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorResponse, error)
+	// UnregisterFeeSponsor withdraws the consent of a fee sponsor, no further
+	// allowances are granted from it.
+	UnregisterFeeSponsor(ctx context.Context, in *MsgUnregisterFeeSponsor, opts ...grpc.CallOption) (*MsgUnregisterFeeSponsorResponse, error)
 }
 
 type msgClient struct {
@@ -59,6 +90,78 @@ func (c *msgClient) Distribute(ctx context.Context, in *MsgDistribute, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetAddressStatus(ctx context.Context, in *MsgSetAddressStatus, opts ...grpc.CallOption) (*MsgSetAddressStatusResponse, error) {
+	out := new(MsgSetAddressStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAddressStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendDistribution(ctx context.Context, in *MsgSendDistribution, opts ...grpc.CallOption) (*MsgSendDistributionResponse, error) {
+	out := new(MsgSendDistributionResponse)
+	err := c.cc.Invoke(ctx, Msg_SendDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterRemoteTreasury(ctx context.Context, in *MsgRegisterRemoteTreasury, opts ...grpc.CallOption) (*MsgRegisterRemoteTreasuryResponse, error) {
+	out := new(MsgRegisterRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterRemoteTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoteDistribute(ctx context.Context, in *MsgRemoteDistribute, opts ...grpc.CallOption) (*MsgRemoteDistributeResponse, error) {
+	out := new(MsgRemoteDistributeResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoteDistribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterFeeSponsor(ctx context.Context, in *MsgRegisterFeeSponsor, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorResponse, error) {
+	out := new(MsgRegisterFeeSponsorResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterFeeSponsor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterFeeSponsor(ctx context.Context, in *MsgUnregisterFeeSponsor, opts ...grpc.CallOption) (*MsgUnregisterFeeSponsorResponse, error) {
+	out := new(MsgUnregisterFeeSponsorResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterFeeSponsor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -67,6 +170,29 @@ type MsgServer interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	Distribute(context.Context, *MsgDistribute) (*MsgDistributeResponse, error)
+	// SetAddressStatus updates the compliance status of one or more addresses.
+	SetAddressStatus(context.Context, *MsgSetAddressStatus) (*MsgSetAddressStatusResponse, error)
+	// SendDistribution sends a distribution packet to the host chain at the
+	// other end of an optio channel, where it is minted and paid out.
+	SendDistribution(context.Context, *MsgSendDistribution) (*MsgSendDistributionResponse, error)
+	// SetRateLimit defines a (governance) operation for adding or updating the
+	// rate limit of an ICS-20 channel. The flow of the channel is reset.
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// RemoveRateLimit defines a (governance) operation for removing the rate
+	// limit of an ICS-20 channel.
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	// RegisterRemoteTreasury registers an interchain account owned by the
+	// module on the host chain of a connection.
+	RegisterRemoteTreasury(context.Context, *MsgRegisterRemoteTreasury) (*MsgRegisterRemoteTreasuryResponse, error)
+	// RemoteDistribute executes a bank multi-send from the remote treasury of
+	// a connection.
+	RemoteDistribute(context.Context, *MsgRemoteDistribute) (*MsgRemoteDistributeResponse, error)
+	// RegisterFeeSponsor records the consent of an account to pay for the fee
+	// allowances of recipients once governance makes it the sponsor.
+	RegisterFeeSponsor(context.Context, *MsgRegisterFeeSponsor) (*MsgRegisterFeeSponsorResponse, error)
+	// UnregisterFeeSponsor withdraws the consent of a fee sponsor, no further
+	// allowances are granted from it.
+	UnregisterFeeSponsor(context.Context, *MsgUnregisterFeeSponsor) (*MsgUnregisterFeeSponsorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -80,6 +206,30 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) Distribute(context.Context, *MsgDistribute) (*MsgDistributeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Distribute not implemented")
 }
+func (UnimplementedMsgServer) SetAddressStatus(context.Context, *MsgSetAddressStatus) (*MsgSetAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressStatus not implemented")
+}
+func (UnimplementedMsgServer) SendDistribution(context.Context, *MsgSendDistribution) (*MsgSendDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDistribution not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (UnimplementedMsgServer) RegisterRemoteTreasury(context.Context, *MsgRegisterRemoteTreasury) (*MsgRegisterRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRemoteTreasury not implemented")
+}
+func (UnimplementedMsgServer) RemoteDistribute(context.Context, *MsgRemoteDistribute) (*MsgRemoteDistributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteDistribute not implemented")
+}
+func (UnimplementedMsgServer) RegisterFeeSponsor(context.Context, *MsgRegisterFeeSponsor) (*MsgRegisterFeeSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeSponsor not implemented")
+}
+func (UnimplementedMsgServer) UnregisterFeeSponsor(context.Context, *MsgUnregisterFeeSponsor) (*MsgUnregisterFeeSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterFeeSponsor not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +279,150 @@ func _Msg_Distribute_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAddressStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAddressStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAddressStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAddressStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAddressStatus(ctx, req.(*MsgSetAddressStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendDistribution(ctx, req.(*MsgSendDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterRemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRemoteTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterRemoteTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRemoteTreasury(ctx, req.(*MsgRegisterRemoteTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoteDistribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoteDistribute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoteDistribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoteDistribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoteDistribute(ctx, req.(*MsgRemoteDistribute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterFeeSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterFeeSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeSponsor(ctx, req.(*MsgRegisterFeeSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterFeeSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterFeeSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterFeeSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterFeeSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterFeeSponsor(ctx, req.(*MsgUnregisterFeeSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +438,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Distribute",
 			Handler:    _Msg_Distribute_Handler,
 		},
+		{
+			MethodName: "SetAddressStatus",
+			Handler:    _Msg_SetAddressStatus_Handler,
+		},
+		{
+			MethodName: "SendDistribution",
+			Handler:    _Msg_SendDistribution_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "RegisterRemoteTreasury",
+			Handler:    _Msg_RegisterRemoteTreasury_Handler,
+		},
+		{
+			MethodName: "RemoteDistribute",
+			Handler:    _Msg_RemoteDistribute_Handler,
+		},
+		{
+			MethodName: "RegisterFeeSponsor",
+			Handler:    _Msg_RegisterFeeSponsor_Handler,
+		},
+		{
+			MethodName: "UnregisterFeeSponsor",
+			Handler:    _Msg_UnregisterFeeSponsor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "optio/optio/tx.proto",
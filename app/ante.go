@@ -0,0 +1,77 @@
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	optioante "github.com/OptioServices/optio/x/optio/ante"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+)
+
+// HandlerOptions are the dependencies of the ante handler of the app.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	OptioKeeper *optiokeeper.Keeper
+}
+
+// NewAnteHandler returns the default ante handler of the auth module with the
+// fee exemption of authorized optio txs and the chain-wide minimum gas prices
+// ahead of the fee deduction, and the base fee after it.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	if options.OptioKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "optio keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		optioante.NewFeeExemptionDecorator(*options.OptioKeeper), // must run before the global fee and the fee deduction
+		optioante.NewGlobalFeeDecorator(*options.OptioKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		optioante.NewBaseFeeDecorator(*options.OptioKeeper), // must run after the fee deduction
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),   // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// setAnteHandler replaces the default ante handler of the tx module, which
+// is skipped in the app config.
+func (app *App) setAnteHandler() error {
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SignModeHandler: app.txConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		OptioKeeper: &app.OptioKeeper,
+	})
+	if err != nil {
+		return err
+	}
+
+	app.SetAnteHandler(anteHandler)
+	return nil
+}
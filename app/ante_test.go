@@ -0,0 +1,238 @@
+package app_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// setupAnteChain returns a chain with the given optio params applied and a
+// function running the ante handler of the app on a tx signed by a sender of
+// the chain.
+func setupAnteChain(t *testing.T, update func(*ibctesting.TestChain, *optiotypes.Params)) (
+	*ibctesting.TestChain,
+	func(ctx sdk.Context, sender ibctesting.SenderAccount, fee sdk.Coins, gas uint64, msgs ...sdk.Msg) error,
+) {
+	t.Helper()
+
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	optioApp := chain.App.(*app.App)
+
+	k := optioApp.OptioKeeper
+	params := k.GetParams(chain.GetContext())
+	update(chain, &params)
+	require.NoError(t, k.SetParams(chain.GetContext(), params))
+	coordinator.CommitBlock(chain)
+
+	r := rand.New(rand.NewSource(1))
+	runTx := func(ctx sdk.Context, sender ibctesting.SenderAccount, fee sdk.Coins, gas uint64, msgs ...sdk.Msg) error {
+		acc := optioApp.AccountKeeper.GetAccount(ctx, sender.SenderAccount.GetAddress())
+		tx, err := simtestutil.GenSignedMockTx(
+			r, optioApp.TxConfig(), msgs, fee, gas, chain.ChainID,
+			[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, sender.SenderPrivKey,
+		)
+		require.NoError(t, err)
+
+		_, err = optioApp.AnteHandler()(ctx, tx, false)
+		return err
+	}
+	return chain, runTx
+}
+
+// stakePrices returns minimum gas prices of amount*10^-precision stake.
+func stakePrices(amount int64, precision int64) sdk.DecCoins {
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(amount, precision)))
+}
+
+func stakeFee(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+}
+
+func TestGlobalFee(t *testing.T) {
+	chain, runTx := setupAnteChain(t, func(_ *ibctesting.TestChain, params *optiotypes.Params) {
+		params.MinimumGasPrices = stakePrices(1, 2)
+	})
+	sender, receiver := chain.SenderAccounts[0], chain.SenderAccounts[1]
+	send := banktypes.NewMsgSend(sender.SenderAccount.GetAddress(), receiver.SenderAccount.GetAddress(), stakeFee(1))
+
+	// the txs are checked for the mempool
+	checkCtx := func(local sdk.DecCoins) sdk.Context {
+		return chain.GetContext().WithIsCheckTx(true).WithMinGasPrices(local)
+	}
+
+	// the chain-wide prices apply to a node without prices of its own
+	require.ErrorIs(t, runTx(checkCtx(nil), sender, nil, 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, runTx(checkCtx(nil), sender, stakeFee(1999), 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(checkCtx(nil), sender, stakeFee(2000), 200_000, send))
+
+	// a node cannot undercut them, but can raise them
+	require.ErrorIs(t, runTx(checkCtx(stakePrices(1, 3)), sender, stakeFee(1999), 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, runTx(checkCtx(stakePrices(2, 2)), sender, stakeFee(2000), 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(checkCtx(stakePrices(2, 2)), sender, stakeFee(4000), 200_000, send))
+
+	// nor accept fees in other denoms
+	uopt := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uOPT", math.LegacyNewDecWithPrec(1, 3)))
+	require.ErrorIs(t, runTx(checkCtx(uopt), sender, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000)), 200_000, send), sdkerrors.ErrInsufficientFee)
+
+	// unlike the prices of a node, they apply to blocks
+	require.ErrorIs(t, runTx(chain.GetContext(), sender, stakeFee(1999), 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(chain.GetContext(), sender, stakeFee(2000), 200_000, send))
+
+	// but not to simulations
+	optioApp := chain.App.(*app.App)
+	acc := optioApp.AccountKeeper.GetAccount(chain.GetContext(), sender.SenderAccount.GetAddress())
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(2)), optioApp.TxConfig(), []sdk.Msg{send}, nil, 200_000, chain.ChainID,
+		[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, sender.SenderPrivKey,
+	)
+	require.NoError(t, err)
+	_, err = optioApp.AnteHandler()(chain.GetContext(), tx, true)
+	require.NoError(t, err)
+
+	// a block rejects a tx without the fee
+	_, err = chain.SendMsgs(send)
+	require.ErrorContains(t, err, "insufficient fee")
+}
+
+func TestFeeExemption(t *testing.T) {
+	chain, runTx := setupAnteChain(t, func(chain *ibctesting.TestChain, params *optiotypes.Params) {
+		params.AuthorizedAccounts = []string{chain.SenderAccounts[0].SenderAccount.GetAddress().String()}
+		params.MinimumGasPrices = stakePrices(1, 2)
+		params.FeeExemptBlockGas = 500_000
+		params.FeeExemptTxsPerWindow = 2
+		params.FeeExemptWindow = time.Hour
+	})
+	distributor, outsider := chain.SenderAccounts[0], chain.SenderAccounts[1]
+	k := chain.App.(*app.App).OptioKeeper
+
+	// the txs are checked for the mempool of a node with the chain-wide prices
+	ctx := chain.GetContext().WithIsCheckTx(true)
+
+	distribute := func(sender ibctesting.SenderAccount) sdk.Msg {
+		return optiotypes.NewMsgDistribute(
+			sender.SenderAccount.GetAddress().String(),
+			math.NewInt(10),
+			[]*optiotypes.Recipient{{Address: outsider.SenderAccount.GetAddress().String(), Amount: math.NewInt(10)}},
+		)
+	}
+	send := banktypes.NewMsgSend(distributor.SenderAccount.GetAddress(), outsider.SenderAccount.GetAddress(), stakeFee(1))
+	fee := stakeFee(2000)
+
+	// a distribution of an authorized account needs no fee
+	require.NoError(t, runTx(ctx, distributor, nil, 200_000, distribute(distributor)))
+
+	// other messages and other accounts pay the minimum gas prices
+	require.ErrorIs(t, runTx(ctx, distributor, nil, 200_000, send), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, runTx(ctx, distributor, nil, 200_000, distribute(distributor), send), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, runTx(ctx, outsider, nil, 200_000, distribute(outsider)), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(ctx, distributor, fee, 200_000, send))
+
+	// the block budget does not cover the gas limit
+	require.ErrorIs(t, runTx(ctx, distributor, nil, 300_001, distribute(distributor)), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(ctx, distributor, nil, 300_000, distribute(distributor)))
+
+	// the window of the account is used up, a fee makes the tx valid again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.ErrorIs(t, runTx(ctx, distributor, nil, 200_000, distribute(distributor)), sdkerrors.ErrInsufficientFee)
+	require.NoError(t, runTx(ctx, distributor, fee, 200_000, distribute(distributor)))
+
+	usage, found := k.GetFeeExemptUsage(ctx, distributor.SenderAccount.GetAddress().String())
+	require.True(t, found)
+	require.Equal(t, uint64(2), usage.Count)
+}
+
+func TestBaseFee(t *testing.T) {
+	chain, runTx := setupAnteChain(t, func(chain *ibctesting.TestChain, params *optiotypes.Params) {
+		params.AuthorizedAccounts = []string{chain.SenderAccounts[0].SenderAccount.GetAddress().String()}
+		params.FeeExemptBlockGas = 500_000
+		params.FeeExemptTxsPerWindow = 1
+		params.FeeExemptWindow = time.Hour
+		params.BaseFeeTargetGas = 1_000_000
+		params.MinBaseFee = math.LegacyNewDecWithPrec(1, 2)
+		params.BaseFeeBurnRate = math.LegacyNewDecWithPrec(5, 1)
+
+		// the empty block committed by the setup lowers it to 0.0175
+		optioApp := chain.App.(*app.App)
+		optioApp.OptioKeeper.SetBaseFee(chain.GetContext(), math.LegacyNewDecWithPrec(2, 2))
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1_000_000))
+		require.NoError(t, optioApp.BankKeeper.MintCoins(chain.GetContext(), optiotypes.ModuleName, coins))
+		require.NoError(t, optioApp.BankKeeper.SendCoinsFromModuleToAccount(chain.GetContext(), optiotypes.ModuleName, chain.SenderAccounts[1].SenderAccount.GetAddress(), coins))
+	})
+	distributor, sender := chain.SenderAccounts[0], chain.SenderAccounts[1]
+	optioApp := chain.App.(*app.App)
+	k := optioApp.OptioKeeper
+	denom := k.GetParams(chain.GetContext()).Denom
+	uopt := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	res, err := k.BaseFee(chain.GetContext(), &optiotypes.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(denom, math.LegacyNewDecWithPrec(175, 4)), res.BaseFee)
+
+	// the base fee applies to blocks, the failed txs run on a cached context
+	// since the fee deduction is not reverted
+	send := banktypes.NewMsgSend(sender.SenderAccount.GetAddress(), distributor.SenderAccount.GetAddress(), stakeFee(1))
+	failTx := func(fee sdk.Coins) error {
+		ctx, _ := chain.GetContext().CacheContext()
+		return runTx(ctx, sender, fee, 200_000, send)
+	}
+	require.ErrorIs(t, failTx(nil), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, failTx(stakeFee(1_000_000)), sdkerrors.ErrInsufficientFee)
+	require.ErrorIs(t, failTx(uopt(3499)), sdkerrors.ErrInsufficientFee)
+
+	// half of the 3500 base fee is burned, the other half and the tip stay
+	// with the fee collector
+	ctx := chain.GetContext()
+	feeCollector := optioApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	supply := optioApp.BankKeeper.GetSupply(ctx, denom).Amount
+	require.NoError(t, runTx(ctx, sender, uopt(3600), 200_000, send))
+	require.Equal(t, supply.SubRaw(1750), optioApp.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.Equal(t, int64(1850), optioApp.BankKeeper.GetBalance(ctx, feeCollector, denom).Amount.Int64())
+	require.Equal(t, int64(1750), k.GetSupplyRecord(ctx).BaseFeeBurned.Int64())
+
+	// fee exempt txs do not pay it
+	distribute := optiotypes.NewMsgDistribute(
+		distributor.SenderAccount.GetAddress().String(),
+		math.NewInt(10),
+		[]*optiotypes.Recipient{{Address: sender.SenderAccount.GetAddress().String(), Amount: math.NewInt(10)}},
+	)
+	require.NoError(t, runTx(ctx, distributor, nil, 200_000, distribute))
+
+	// nor do simulations
+	acc := optioApp.AccountKeeper.GetAccount(ctx, sender.SenderAccount.GetAddress())
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(2)), optioApp.TxConfig(), []sdk.Msg{send}, nil, 200_000, chain.ChainID,
+		[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, sender.SenderPrivKey,
+	)
+	require.NoError(t, err)
+	_, err = optioApp.AnteHandler()(ctx, tx, true)
+	require.NoError(t, err)
+
+	// empty blocks lower the base fee to the min base fee
+	chain.NextBlock()
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0153125"), k.GetBaseFee(chain.GetContext()))
+	for i := 0; i < 10; i++ {
+		chain.NextBlock()
+	}
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 2), k.GetBaseFee(chain.GetContext()))
+
+	_, broken := optiokeeper.AllInvariants(k)(chain.GetContext())
+	require.False(t, broken)
+}
@@ -65,6 +65,7 @@ import (
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	_ "github.com/cosmos/cosmos-sdk/x/staking" // import for side-effects
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
 	_ "github.com/cosmos/ibc-go/modules/capability" // import for side-effects
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	_ "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts" // import for side-effects
@@ -75,6 +76,8 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	optiomodulekeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiomoduletypes "github.com/OptioServices/optio/x/optio/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"github.com/OptioServices/optio/docs"
@@ -132,6 +135,7 @@ type App struct {
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	PacketForwardKeeper *packetforwardkeeper.Keeper
 
 	// Scoped IBC
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -140,6 +144,7 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	OptioKeeper optiomodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -210,6 +215,16 @@ func New(
 				// This needs to be removed after IBC supports App Wiring.
 				app.GetIBCKeeper,
 				app.GetCapabilityScopedKeeper,
+				// The optio module pays cross-chain recipients with the IBC
+				// transfer keeper, which is passed as a getter for the same reason.
+				optiomoduletypes.TransferKeeperFn(func() optiomoduletypes.TransferKeeper {
+					return app.GetTransferKeeper()
+				}),
+				// The remote treasuries are interchain accounts of the controller
+				// keeper, also created after the module.
+				optiomoduletypes.ICAControllerKeeperFn(func() optiomoduletypes.ICAControllerKeeper {
+					return app.GetICAControllerKeeper()
+				}),
 
 				// here alternative options can be supplied to the DI container.
 				// those options can be used f.e to override the default behavior of some modules.
@@ -243,6 +258,7 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.OptioKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -260,6 +276,12 @@ func New(
 		return nil, err
 	}
 
+	// set the ante handler with the global fee, the fee exemption of
+	// authorized optio txs and the base fee
+	if err := app.setAnteHandler(); err != nil {
+		return nil, err
+	}
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -287,6 +309,12 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	// register the upgrade handlers and the store loader of a pending upgrade
+	app.setupUpgradeHandlers()
+	if err := app.setupUpgradeStoreLoaders(); err != nil {
+		return nil, err
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		return nil, err
 	}
@@ -362,6 +390,16 @@ func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
 	return app.IBCKeeper
 }
 
+// GetTransferKeeper returns the IBC transfer keeper.
+func (app *App) GetTransferKeeper() *ibctransferkeeper.Keeper {
+	return &app.TransferKeeper
+}
+
+// GetICAControllerKeeper returns the interchain accounts controller keeper.
+func (app *App) GetICAControllerKeeper() *icacontrollerkeeper.Keeper {
+	return &app.ICAControllerKeeper
+}
+
 // GetCapabilityScopedKeeper returns the capability scoped keeper.
 func (app *App) GetCapabilityScopedKeeper(moduleName string) capabilitykeeper.ScopedKeeper {
 	sk, ok := app.ScopedKeepers[moduleName]
@@ -372,6 +410,22 @@ func (app *App) GetCapabilityScopedKeeper(moduleName string) capabilitykeeper.Sc
 	return sk
 }
 
+// GetBaseApp returns the base app. It is part of the ibc-go testing.TestingApp
+// interface, like the getters below.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetScopedIBCKeeper returns the scoped keeper of the IBC module.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the app's TxConfig.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // SimulationManager implements the SimulationApp interface.
 func (app *App) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -0,0 +1,117 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// genGrantedTx returns a tx signed by priv whose fee is paid by granter.
+func genGrantedTx(t *testing.T, optioApp *app.App, chainID string, acc sdk.AccountI, priv cryptotypes.PrivKey, granter sdk.AccAddress, fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+
+	txConfig := optioApp.TxConfig()
+	signMode, err := authsign.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(fee)
+	builder.SetFeeGranter(granter)
+	builder.SetGasLimit(200_000)
+
+	sig := signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: acc.GetSequence(),
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+
+	signerData := authsign.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        priv.PubKey(),
+	}
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signMode, signerData, builder.GetTx())
+	require.NoError(t, err)
+	sig.Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	return builder.GetTx()
+}
+
+func TestFeeAllowancePaysRecipientFees(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	optioApp := chain.App.(*app.App)
+	k := optioApp.OptioKeeper
+	distributor := chain.SenderAccount.GetAddress()
+
+	params := k.GetParams(chain.GetContext())
+	params.AuthorizedAccounts = []string{distributor.String()}
+	params.FeeAllowanceSpendLimit = stakeFee(5000)
+	params.FeeAllowanceDuration = time.Hour
+	params.FeeAllowanceBudget = stakeFee(100_000)
+	require.NoError(t, k.SetParams(chain.GetContext(), params))
+	coordinator.CommitBlock(chain)
+
+	// anyone can fund the fee allowance account
+	_, err := chain.SendMsgs(banktypes.NewMsgSend(distributor, optiotypes.FeeAllowanceAddress, stakeFee(10_000)))
+	require.NoError(t, err)
+
+	// a recipient without any other tokens gets an allowance with its
+	// distribution
+	priv := secp256k1.GenPrivKey()
+	recipient := sdk.AccAddress(priv.PubKey().Address())
+	_, err = chain.SendMsgs(optiotypes.NewMsgDistribute(distributor.String(), math.NewInt(10), []*optiotypes.Recipient{
+		{Address: recipient.String(), Amount: math.NewInt(10)},
+	}))
+	require.NoError(t, err)
+
+	ctx := chain.GetContext()
+	allowance, err := optioApp.FeeGrantKeeper.GetAllowance(ctx, optiotypes.FeeAllowanceAddress, recipient)
+	require.NoError(t, err)
+	require.Equal(t, stakeFee(5000), allowance.(*feegrant.BasicAllowance).SpendLimit)
+	require.True(t, optioApp.BankKeeper.GetAllBalances(ctx, recipient).AmountOf(sdk.DefaultBondDenom).IsZero())
+
+	// the recipient cannot pay a fee on its own
+	acc := optioApp.AccountKeeper.GetAccount(ctx, recipient)
+	send := banktypes.NewMsgSend(recipient, distributor, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1)))
+	tx := genGrantedTx(t, optioApp, chain.ChainID, acc, priv, nil, stakeFee(2000), send)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = optioApp.AnteHandler()(cacheCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// but can with its allowance, which the fee allowance account pays
+	feeCollector := optioApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := optioApp.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount
+	tx = genGrantedTx(t, optioApp, chain.ChainID, acc, priv, optiotypes.FeeAllowanceAddress, stakeFee(2000), send)
+	_, err = optioApp.AnteHandler()(ctx, tx, false)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(8000), optioApp.BankKeeper.GetBalance(ctx, optiotypes.FeeAllowanceAddress, sdk.DefaultBondDenom).Amount.Int64())
+	require.Equal(t, collected.AddRaw(2000), optioApp.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	allowance, err = optioApp.FeeGrantKeeper.GetAllowance(ctx, optiotypes.FeeAllowanceAddress, recipient)
+	require.NoError(t, err)
+	require.Equal(t, stakeFee(3000), allowance.(*feegrant.BasicAllowance).SpendLimit)
+}
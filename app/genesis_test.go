@@ -0,0 +1,105 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	"github.com/OptioServices/optio/testutil/sample"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// initFundedChain starts a chain with a single validator and an account
+// funded with coins. Crisis asserts the invariants at the end of InitChain.
+func initFundedChain(t *testing.T, coins sdk.Coins) (*app.App, sdk.AccAddress) {
+	t.Helper()
+
+	appOptions := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(upgradeTestChainID))
+	require.NoError(t, err)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	acc := authtypes.NewBaseAccount(secp256k1.GenPrivKey().PubKey().Address().Bytes(), nil, 0, 0)
+	balance := banktypes.Balance{Address: acc.GetAddress().String(), Coins: coins}
+	genesisState, err := simtestutil.GenesisStateWithValSet(bApp.AppCodec(), bApp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = bApp.InitChain(&abci.RequestInitChain{
+		ChainId:         upgradeTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	finalizeAndCommit(t, bApp)
+
+	return bApp, acc.GetAddress()
+}
+
+func TestInitChainWithFundedDenom(t *testing.T) {
+	// the account is funded in the optio denom as well as the bond denom
+	bApp, _ := initFundedChain(t, sdk.NewCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000_000),
+		sdk.NewInt64Coin(optiotypes.DefaultDenom, 1_000_000_000),
+	))
+
+	// balances funded at genesis were not minted by the module
+	ctx := bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	require.Equal(t, optiotypes.NewSupplyRecord(), bApp.OptioKeeper.GetSupplyRecord(ctx))
+	_, broken := optiokeeper.AllInvariants(bApp.OptioKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestInvariantsWithOptioBondDenom(t *testing.T) {
+	// init --default-denom uOPT makes uOPT the bond, mint and gov denom
+	bondDenom := sdk.DefaultBondDenom
+	sdk.DefaultBondDenom = optiotypes.DefaultDenom
+	t.Cleanup(func() { sdk.DefaultBondDenom = bondDenom })
+
+	bApp, distributor := initFundedChain(t, sdk.NewCoins(sdk.NewInt64Coin(optiotypes.DefaultDenom, 100_000_000_000_000)))
+	ctx := bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	genesisSupply := bApp.BankKeeper.GetSupply(ctx, optiotypes.DefaultDenom).Amount
+
+	// x/mint inflates the supply every block
+	for i := 0; i < 3; i++ {
+		finalizeAndCommit(t, bApp)
+	}
+	ctx = bApp.NewUncachedContext(false, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	require.True(t, bApp.BankKeeper.GetSupply(ctx, optiotypes.DefaultDenom).Amount.GT(genesisSupply))
+	_, broken := optiokeeper.AllInvariants(bApp.OptioKeeper)(ctx)
+	require.False(t, broken)
+
+	params := bApp.OptioKeeper.GetParams(ctx)
+	params.AuthorizedAccounts = []string{distributor.String()}
+	require.NoError(t, bApp.OptioKeeper.SetParams(ctx, params))
+	_, err := optiokeeper.NewMsgServerImpl(bApp.OptioKeeper).Distribute(ctx, optiotypes.NewMsgDistribute(distributor.String(), math.NewInt(1000), []*optiotypes.Recipient{
+		{Address: sample.AccAddress(), Amount: math.NewInt(1000)},
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(1000), bApp.OptioKeeper.GetSupplyRecord(ctx).Minted)
+	res, broken := optiokeeper.AllInvariants(bApp.OptioKeeper)(ctx)
+	require.False(t, broken, res)
+}
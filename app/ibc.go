@@ -10,6 +10,10 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
 	"github.com/cosmos/ibc-go/modules/capability"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -36,8 +40,15 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	// this line is used by starport scaffolding # ibc/app/import
+
+	optiomodule "github.com/OptioServices/optio/x/optio/module"
+	optiomoduletypes "github.com/OptioServices/optio/x/optio/types"
 )
 
+// maxCallbackGas is the gas limit of the IBC callbacks the optio module
+// receives for the packets it sends.
+const maxCallbackGas = uint64(1_000_000)
+
 // registerIBCModules register IBC keepers and non dependency inject modules.
 func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	// set up non depinject support modules store keys
@@ -48,6 +59,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		storetypes.NewKVStoreKey(ibcfeetypes.StoreKey),
 		storetypes.NewKVStoreKey(icahosttypes.StoreKey),
 		storetypes.NewKVStoreKey(icacontrollertypes.StoreKey),
+		storetypes.NewKVStoreKey(packetforwardtypes.StoreKey),
 		storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey),
 		storetypes.NewTransientStoreKey(paramstypes.TStoreKey),
 	); err != nil {
@@ -61,6 +73,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	app.ParamsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	app.ParamsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	app.ParamsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
+	app.ParamsKeeper.Subspace(packetforwardtypes.ModuleName)
 
 	// add capability keeper and ScopeToModule for ibc module
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(
@@ -114,6 +127,19 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// The packet-forward keeper forwards through the transfer keeper, which
+	// sends through the transfer stack below. Its ICS4Wrapper writes the
+	// acknowledgements of forwarded packets.
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		app.appCodec,
+		app.GetKey(packetforwardtypes.StoreKey),
+		&app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.BankKeeper,
+		app.IBCFeeKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create interchain account keepers
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		app.appCodec,
@@ -142,15 +168,48 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Create IBC modules with ibcfee middleware. The optio middleware fans out
+	// incoming transfers with optio instructions in their memo and burns the
+	// refunds of the transfers sent to cross-chain distribution recipients,
+	// the packet-forward middleware routes incoming transfers with a forward
+	// memo to their next hop and the rate limit middleware caps the net flow
+	// of the optio denom per channel. The rate limit middleware sits above
+	// the packet-forward middleware, which consumes the acknowledgements of
+	// forwarded packets, and is the ICS4Wrapper of the transfer keeper, so
+	// that it sees the outgoing transfers. The callbacks middleware reports
+	// the acknowledgements and timeouts of these transfers to the optio
+	// keeper, which records the outcomes of their packets.
+	packetForwardConfig, err := ReadPacketForwardConfig(appOpts)
+	if err != nil {
+		return err
+	}
+	packetForwardMiddleware := packetforward.NewIBCMiddleware(
+		optiomodule.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.OptioKeeper),
+		app.PacketForwardKeeper,
+		packetForwardConfig.RetriesOnTimeout,
+		packetForwardConfig.ForwardTimeout,
+	)
+	rateLimitMiddleware := optiomodule.NewRateLimitMiddleware(
+		packetForwardMiddleware,
+		app.IBCFeeKeeper,
+		app.OptioKeeper,
+	)
+	transferCallbacksMiddleware := ibccallbacks.NewIBCMiddleware(rateLimitMiddleware, app.IBCFeeKeeper, app.OptioKeeper, maxCallbackGas)
+	rateLimitMiddleware = rateLimitMiddleware.WithICS4Wrapper(transferCallbacksMiddleware)
+	app.TransferKeeper.WithICS4Wrapper(rateLimitMiddleware)
+	transferIBCModule := ibcfee.NewIBCMiddleware(transferCallbacksMiddleware, app.IBCFeeKeeper)
 
-	// integration point for custom authentication modules
-	var noAuthzModule porttypes.IBCModule
-	icaControllerIBCModule := ibcfee.NewIBCMiddleware(
-		icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper),
+	// The optio module authenticates the interchain accounts of its remote
+	// treasuries. The callbacks middleware reports the acknowledgements and
+	// timeouts of their remote distributions to the optio keeper.
+	icaControllerCallbacksMiddleware := ibccallbacks.NewIBCMiddleware(
+		icacontroller.NewIBCMiddleware(optiomodule.NewICAControllerModule(app.OptioKeeper), app.ICAControllerKeeper),
 		app.IBCFeeKeeper,
+		app.OptioKeeper,
+		maxCallbackGas,
 	)
+	app.ICAControllerKeeper.WithICS4Wrapper(icaControllerCallbacksMiddleware)
+	icaControllerIBCModule := ibcfee.NewIBCMiddleware(icaControllerCallbacksMiddleware, app.IBCFeeKeeper)
 
 	icaHostIBCModule := ibcfee.NewIBCMiddleware(icahost.NewIBCModule(app.ICAHostKeeper), app.IBCFeeKeeper)
 
@@ -160,6 +219,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
+	// The optio port carries the distribution packets of controller chains.
+	ibcRouter.AddRoute(optiomoduletypes.ModuleName, optiomodule.NewIBCModule(app.OptioKeeper))
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -174,6 +235,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctransfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		icamodule.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		capability.NewAppModule(app.appCodec, *app.CapabilityKeeper, false),
 		ibctm.NewAppModule(),
@@ -190,13 +252,14 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 // This needs to be removed after IBC supports App Wiring.
 func RegisterIBC(registry cdctypes.InterfaceRegistry) map[string]appmodule.AppModule {
 	modules := map[string]appmodule.AppModule{
-		ibcexported.ModuleName:      ibc.AppModule{},
-		ibctransfertypes.ModuleName: ibctransfer.AppModule{},
-		ibcfeetypes.ModuleName:      ibcfee.AppModule{},
-		icatypes.ModuleName:         icamodule.AppModule{},
-		capabilitytypes.ModuleName:  capability.AppModule{},
-		ibctm.ModuleName:            ibctm.AppModule{},
-		solomachine.ModuleName:      solomachine.AppModule{},
+		ibcexported.ModuleName:        ibc.AppModule{},
+		ibctransfertypes.ModuleName:   ibctransfer.AppModule{},
+		ibcfeetypes.ModuleName:        ibcfee.AppModule{},
+		packetforwardtypes.ModuleName: packetforward.AppModule{},
+		icatypes.ModuleName:           icamodule.AppModule{},
+		capabilitytypes.ModuleName:    capability.AppModule{},
+		ibctm.ModuleName:              ibctm.AppModule{},
+		solomachine.ModuleName:        solomachine.AppModule{},
 	}
 
 	for name, m := range modules {
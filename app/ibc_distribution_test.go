@@ -0,0 +1,159 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// setupOptioPath connects a controller chain A to a host chain B with an
+// optio channel and authorizes the sender of chain A to distribute. The
+// channel is approved on chain B if approve is set.
+func setupOptioPath(t *testing.T, approve bool) (*ibctesting.Coordinator, *ibctesting.Path) {
+	t.Helper()
+
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = optiotypes.PortID
+		endpoint.ChannelConfig.Version = optiotypes.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coordinator.Setup(path)
+
+	kA := chainA.App.(*app.App).OptioKeeper
+	params := kA.GetParams(chainA.GetContext())
+	params.AuthorizedAccounts = []string{chainA.SenderAccount.GetAddress().String()}
+	require.NoError(t, kA.SetParams(chainA.GetContext(), params))
+	coordinator.CommitBlock(chainA)
+
+	if approve {
+		kB := chainB.App.(*app.App).OptioKeeper
+		params := kB.GetParams(chainB.GetContext())
+		params.RemoteControllers = []optiotypes.RemoteController{{ChannelId: path.EndpointB.ChannelID, Quota: math.NewInt(1000)}}
+		require.NoError(t, kB.SetParams(chainB.GetContext(), params))
+		coordinator.CommitBlock(chainB)
+	}
+
+	return coordinator, path
+}
+
+// sendDistribution sends a distribution of 10 and 20 to two accounts of
+// chain B and returns the sent packet.
+func sendDistribution(t *testing.T, path *ibctesting.Path) channeltypes.Packet {
+	t.Helper()
+
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	res, err := chainA.SendMsgs(optiotypes.NewMsgSendDistribution(
+		chainA.SenderAccount.GetAddress().String(),
+		path.EndpointA.ChannelID,
+		math.NewInt(30),
+		[]*optiotypes.Recipient{
+			{Address: chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), Amount: math.NewInt(10)},
+			{Address: chainB.SenderAccounts[2].SenderAccount.GetAddress().String(), Amount: math.NewInt(20)},
+		},
+	))
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+func TestSendDistribution(t *testing.T) {
+	t.Run("executed on the host chain", func(t *testing.T) {
+		_, path := setupOptioPath(t, true)
+		chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+		appB := chainB.App.(*app.App)
+		alice := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+		bob := chainB.SenderAccounts[2].SenderAccount.GetAddress()
+
+		// bob is denied on chain B and skipped
+		appB.OptioKeeper.SetAddressStatus(chainB.GetContext(), optiotypes.AddressStatus{
+			Address: bob.String(), Status: optiotypes.COMPLIANCE_STATUS_DENIED,
+		})
+		chainB.Coordinator.CommitBlock(chainB)
+
+		packet := sendDistribution(t, path)
+		_, ackBz, err := path.RelayPacketWithResults(packet)
+		require.NoError(t, err)
+
+		var ack channeltypes.Acknowledgement
+		require.NoError(t, optiotypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+		require.True(t, ack.Success(), ack.GetError())
+		var packetAck optiotypes.DistributionPacketAck
+		require.NoError(t, optiotypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck))
+		require.Len(t, packetAck.Results, 2)
+		require.True(t, packetAck.Results[0].Success)
+		require.False(t, packetAck.Results[1].Success)
+
+		ctxB := chainB.GetContext()
+		distribution, found := appB.OptioKeeper.GetDistribution(ctxB, packetAck.DistributionId)
+		require.True(t, found)
+		require.Equal(t, optiotypes.RemoteDistributorAddress(path.EndpointB.ChannelID).String(), distribution.Distributor)
+		require.Equal(t, math.NewInt(10), appB.BankKeeper.GetBalance(ctxB, alice, optiotypes.DefaultDenom).Amount)
+		require.True(t, appB.BankKeeper.GetBalance(ctxB, bob, optiotypes.DefaultDenom).IsZero())
+		require.Equal(t, math.NewInt(10), appB.BankKeeper.GetSupply(ctxB, optiotypes.DefaultDenom).Amount)
+
+		// nothing is minted on the controller chain
+		appA := chainA.App.(*app.App)
+		require.True(t, appA.BankKeeper.GetSupply(chainA.GetContext(), optiotypes.DefaultDenom).IsZero())
+	})
+
+	t.Run("rejected without approval", func(t *testing.T) {
+		_, path := setupOptioPath(t, false)
+		chainB := path.EndpointB.Chain
+
+		packet := sendDistribution(t, path)
+		_, ackBz, err := path.RelayPacketWithResults(packet)
+		require.NoError(t, err)
+
+		var ack channeltypes.Acknowledgement
+		require.NoError(t, optiotypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+		require.False(t, ack.Success())
+		require.Zero(t, chainB.App.(*app.App).OptioKeeper.GetDistributionCount(chainB.GetContext()))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		coordinator, path := setupOptioPath(t, true)
+		chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+
+		packet := sendDistribution(t, path)
+
+		params := chainA.App.(*app.App).OptioKeeper.GetParams(chainA.GetContext())
+		coordinator.IncrementTimeBy(params.TransferTimeout + time.Minute)
+		require.NoError(t, path.EndpointA.UpdateClient())
+		require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+		require.Zero(t, chainB.App.(*app.App).OptioKeeper.GetDistributionCount(chainB.GetContext()))
+	})
+
+	t.Run("unauthorized sender", func(t *testing.T) {
+		coordinator, path := setupOptioPath(t, true)
+		chainA := path.EndpointA.Chain
+
+		k := chainA.App.(*app.App).OptioKeeper
+		params := k.GetParams(chainA.GetContext())
+		params.AuthorizedAccounts = nil
+		require.NoError(t, k.SetParams(chainA.GetContext(), params))
+		coordinator.CommitBlock(chainA)
+
+		_, err := chainA.SendMsgs(optiotypes.NewMsgSendDistribution(
+			chainA.SenderAccount.GetAddress().String(),
+			path.EndpointA.ChannelID,
+			math.NewInt(1),
+			[]*optiotypes.Recipient{{Address: chainA.SenderAccount.GetAddress().String(), Amount: math.NewInt(1)}},
+		))
+		require.ErrorContains(t, err, optiotypes.ErrUnauthorized.Error())
+	})
+}
@@ -0,0 +1,100 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// transferWithMemo sends 100stake from chain B to chain A with the given
+// memo, relays it and returns whether chain A acknowledged it successfully.
+func transferWithMemo(t *testing.T, path *ibctesting.Path, receiver, memo string) bool {
+	t.Helper()
+
+	chainB := path.EndpointB.Chain
+	res, err := chainB.SendMsgs(transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		chainB.SenderAccount.GetAddress().String(),
+		receiver,
+		path.EndpointA.Chain.GetTimeoutHeight(),
+		0,
+		memo,
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	_, ackBytes, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack.Success()
+}
+
+func TestTransferMemoHook(t *testing.T) {
+	setup := func(t *testing.T) (*ibctesting.Path, string, sdk.AccAddress, sdk.AccAddress) {
+		_, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+		voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+		)).IBCDenom()
+		return path, voucher, chainA.SenderAccounts[1].SenderAccount.GetAddress(), chainA.SenderAccounts[2].SenderAccount.GetAddress()
+	}
+	memo := func(alice, bob sdk.AccAddress, bobAmount int) string {
+		return fmt.Sprintf(`{"optio":{"distribute":[{"address":"%s","amount":"60"},{"address":"%s","amount":"%d"}]}}`, alice, bob, bobAmount)
+	}
+
+	t.Run("fans the transfer out", func(t *testing.T) {
+		path, voucher, alice, bob := setup(t)
+		chainA := path.EndpointA.Chain
+		bankA := chainA.App.(*app.App).BankKeeper
+
+		// the receiver is replaced by the memo hook address
+		require.True(t, transferWithMemo(t, path, "ignored", memo(alice, bob, 40)))
+		require.Equal(t, math.NewInt(60), bankA.GetBalance(chainA.GetContext(), alice, voucher).Amount)
+		require.Equal(t, math.NewInt(40), bankA.GetBalance(chainA.GetContext(), bob, voucher).Amount)
+		require.True(t, bankA.GetAllBalances(chainA.GetContext(), optiotypes.MemoHookAddress).IsZero())
+	})
+
+	t.Run("invalid instructions refund the sender", func(t *testing.T) {
+		path, voucher, alice, bob := setup(t)
+		chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+		bankA, bankB := chainA.App.(*app.App).BankKeeper, chainB.App.(*app.App).BankKeeper
+		sender := chainB.SenderAccount.GetAddress()
+		before := bankB.GetBalance(chainB.GetContext(), sender, sdk.DefaultBondDenom)
+
+		require.False(t, transferWithMemo(t, path, "ignored", memo(alice, bob, 30)))
+		require.Equal(t, before, bankB.GetBalance(chainB.GetContext(), sender, sdk.DefaultBondDenom))
+		require.True(t, bankA.GetBalance(chainA.GetContext(), alice, voucher).IsZero())
+		require.True(t, bankA.GetSupply(chainA.GetContext(), voucher).IsZero())
+	})
+
+	t.Run("rejected recipient refunds the sender", func(t *testing.T) {
+		path, voucher, alice, bob := setup(t)
+		chainA := path.EndpointA.Chain
+		appA := chainA.App.(*app.App)
+		appA.OptioKeeper.SetAddressStatus(chainA.GetContext(), optiotypes.AddressStatus{Address: bob.String(), Status: optiotypes.COMPLIANCE_STATUS_DENIED})
+
+		require.False(t, transferWithMemo(t, path, "ignored", memo(alice, bob, 40)))
+		require.True(t, appA.BankKeeper.GetSupply(chainA.GetContext(), voucher).IsZero())
+	})
+
+	t.Run("other memos are left alone", func(t *testing.T) {
+		path, voucher, alice, _ := setup(t)
+		chainA := path.EndpointA.Chain
+
+		require.True(t, transferWithMemo(t, path, alice.String(), `{"wasm":{}}`))
+		require.Equal(t, math.NewInt(100), chainA.App.(*app.App).BankKeeper.GetBalance(chainA.GetContext(), alice, voucher).Amount)
+	})
+}
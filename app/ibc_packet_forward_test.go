@@ -0,0 +1,285 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+)
+
+// setupForwardPaths connects chain A to chain C over chain B with transfer
+// channels, the optio app of every chain built with the given app options.
+func setupForwardPaths(t *testing.T, options simtestutil.AppOptionsMap) (*ibctesting.Coordinator, *ibctesting.Path, *ibctesting.Path) {
+	t.Helper()
+
+	ibctesting.DefaultTestingAppInit = setupTestingAppWithOptions(options)
+	coordinator := ibctesting.NewCoordinator(t, 3)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := coordinator.GetChain(ibctesting.GetChainID(3))
+
+	pathAB := ibctesting.NewTransferPath(chainA, chainB)
+	coordinator.Setup(pathAB)
+	pathBC := ibctesting.NewTransferPath(chainB, chainC)
+	coordinator.Setup(pathBC)
+
+	return coordinator, pathAB, pathBC
+}
+
+// forwardMemo routes a transfer received by chain B to receiver on chain C.
+func forwardMemo(pathBC *ibctesting.Path, receiver string) string {
+	return fmt.Sprintf(`{"forward":{"receiver":%q,"port":%q,"channel":%q}}`,
+		receiver, pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+}
+
+// sendForward sends amount stake from chain A with a forward memo to chain C
+// and returns the packet received by chain B.
+func sendForward(t *testing.T, pathAB, pathBC *ibctesting.Path, receiver string, amount int64) channeltypes.Packet {
+	t.Helper()
+
+	chainA := pathAB.EndpointA.Chain
+	res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		pathAB.EndpointA.ChannelConfig.PortID,
+		pathAB.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		chainA.SenderAccount.GetAddress().String(),
+		"pfm",
+		clienttypes.NewHeight(1, 1000),
+		0,
+		forwardMemo(pathBC, receiver),
+	))
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+// recvForward receives the packet of chain A on chain B, which holds the
+// acknowledgement back and forwards the transfer. It returns the forwarded
+// packet.
+func recvForward(t *testing.T, pathAB *ibctesting.Path, packet channeltypes.Packet) channeltypes.Packet {
+	t.Helper()
+
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err := pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	require.Error(t, err, "the acknowledgement of a forwarded packet is asynchronous")
+
+	forwarded, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return forwarded
+}
+
+// acknowledgeWithResult acknowledges a packet on the source endpoint and
+// returns the result of the transaction.
+func acknowledgeWithResult(t *testing.T, endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) *abci.ExecTxResult {
+	t.Helper()
+
+	require.NoError(t, endpoint.UpdateClient())
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+	require.NoError(t, endpoint.Counterparty.UpdateClient())
+	return res
+}
+
+// timeoutWithResult times out a packet on the source endpoint and returns
+// the result of the transaction.
+func timeoutWithResult(t *testing.T, endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *abci.ExecTxResult {
+	t.Helper()
+
+	require.NoError(t, endpoint.UpdateClient())
+	counterparty := endpoint.Counterparty
+	proof, proofHeight := counterparty.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(t, found)
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+	return res
+}
+
+// relayForward relays a forwarded packet from chain B to chain C and the
+// acknowledgement of chain C back to chain A through chain B. It returns the
+// acknowledgement of chain B.
+func relayForward(t *testing.T, pathAB, pathBC *ibctesting.Path, packet, forwarded channeltypes.Packet) channeltypes.Acknowledgement {
+	t.Helper()
+
+	require.NoError(t, pathBC.EndpointB.UpdateClient())
+	res, err := pathBC.EndpointB.RecvPacketWithResult(forwarded)
+	require.NoError(t, err)
+	ackC, err := ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+
+	res = acknowledgeWithResult(t, pathBC.EndpointA, forwarded, ackC)
+	ackB, err := ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+	acknowledgeWithResult(t, pathAB.EndpointA, packet, ackB)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ackB, &ack))
+	return ack
+}
+
+// forwardedDenom is the denom on chain C of the stake of chain A forwarded
+// by chain B.
+func forwardedDenom(pathAB, pathBC *ibctesting.Path) string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	)).IBCDenom()
+}
+
+// voucherB is the denom on chain B of the stake of chain A.
+func voucherB(pathAB *ibctesting.Path) string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+}
+
+func TestPacketForward(t *testing.T) {
+	t.Run("routes a transfer across optio in one action", func(t *testing.T) {
+		_, pathAB, pathBC := setupForwardPaths(t, nil)
+		chainA, chainC := pathAB.EndpointA.Chain, pathBC.EndpointB.Chain
+		bankA, bankC := chainA.App.(*app.App).BankKeeper, chainC.App.(*app.App).BankKeeper
+		sender, receiver := chainA.SenderAccount.GetAddress(), chainC.SenderAccount.GetAddress()
+		before := bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount
+
+		packet := sendForward(t, pathAB, pathBC, receiver.String(), 100)
+		forwarded := recvForward(t, pathAB, packet)
+		require.Equal(t, pathBC.EndpointA.ChannelID, forwarded.SourceChannel)
+
+		ack := relayForward(t, pathAB, pathBC, packet, forwarded)
+		require.True(t, ack.Success())
+
+		require.Equal(t, math.NewInt(100), bankC.GetBalance(chainC.GetContext(), receiver, forwardedDenom(pathAB, pathBC)).Amount)
+		require.Equal(t, before.SubRaw(100), bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount)
+	})
+
+	t.Run("error acknowledgement of the last hop refunds the sender", func(t *testing.T) {
+		_, pathAB, pathBC := setupForwardPaths(t, nil)
+		chainA, chainB := pathAB.EndpointA.Chain, pathAB.EndpointB.Chain
+		bankA := chainA.App.(*app.App).BankKeeper
+		sender := chainA.SenderAccount.GetAddress()
+		before := bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount
+
+		// chain C cannot decode the receiver and acknowledges with an error
+		packet := sendForward(t, pathAB, pathBC, "not-an-address", 100)
+		forwarded := recvForward(t, pathAB, packet)
+		ack := relayForward(t, pathAB, pathBC, packet, forwarded)
+		require.False(t, ack.Success())
+
+		require.Equal(t, before, bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount)
+		require.True(t, chainB.App.(*app.App).BankKeeper.GetSupply(chainB.GetContext(), voucherB(pathAB)).IsZero())
+	})
+
+	t.Run("invalid forward memo is acknowledged with an error", func(t *testing.T) {
+		_, pathAB, _ := setupForwardPaths(t, nil)
+		chainA := pathAB.EndpointA.Chain
+
+		res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+			pathAB.EndpointA.ChannelConfig.PortID,
+			pathAB.EndpointA.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			chainA.SenderAccount.GetAddress().String(),
+			"pfm",
+			clienttypes.NewHeight(1, 1000),
+			0,
+			`{"forward":{"receiver":"cosmos1receiver"}}`,
+		))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+
+		require.NoError(t, pathAB.EndpointB.UpdateClient())
+		res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+		require.NoError(t, err)
+		ackBz, err := ibctesting.ParseAckFromEvents(res.Events)
+		require.NoError(t, err)
+		var ack channeltypes.Acknowledgement
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+		require.False(t, ack.Success())
+	})
+
+	t.Run("retries a forward that timed out", func(t *testing.T) {
+		coordinator, pathAB, pathBC := setupForwardPaths(t, simtestutil.AppOptionsMap{
+			app.FlagPacketForwardRetriesOnTimeout: 1,
+			app.FlagPacketForwardTimeout:          "1m",
+		})
+		chainB, chainC := pathAB.EndpointB.Chain, pathBC.EndpointB.Chain
+		receiver := chainC.SenderAccount.GetAddress()
+
+		packet := sendForward(t, pathAB, pathBC, receiver.String(), 100)
+		sentAfter := chainB.CurrentHeader.Time
+		forwarded := recvForward(t, pathAB, packet)
+
+		// the forward times out after the configured timeout
+		require.GreaterOrEqual(t, forwarded.TimeoutTimestamp, uint64(sentAfter.Add(time.Minute).UnixNano()))
+		require.LessOrEqual(t, forwarded.TimeoutTimestamp, uint64(chainB.CurrentHeader.Time.Add(time.Minute).UnixNano()))
+
+		coordinator.IncrementTimeBy(2 * time.Minute)
+		require.NoError(t, pathBC.EndpointB.UpdateClient())
+		res := timeoutWithResult(t, pathBC.EndpointA, forwarded)
+		retried, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		require.Greater(t, retried.Sequence, forwarded.Sequence)
+
+		ack := relayForward(t, pathAB, pathBC, packet, retried)
+		require.True(t, ack.Success())
+		require.Equal(t, math.NewInt(100), chainC.App.(*app.App).BankKeeper.GetBalance(chainC.GetContext(), receiver, forwardedDenom(pathAB, pathBC)).Amount)
+	})
+
+	t.Run("refunds a forward that timed out without retries", func(t *testing.T) {
+		coordinator, pathAB, pathBC := setupForwardPaths(t, simtestutil.AppOptionsMap{
+			app.FlagPacketForwardTimeout: "1m",
+		})
+		chainA := pathAB.EndpointA.Chain
+		bankA := chainA.App.(*app.App).BankKeeper
+		sender := chainA.SenderAccount.GetAddress()
+		before := bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount
+
+		packet := sendForward(t, pathAB, pathBC, pathBC.EndpointB.Chain.SenderAccount.GetAddress().String(), 100)
+		forwarded := recvForward(t, pathAB, packet)
+
+		coordinator.IncrementTimeBy(2 * time.Minute)
+		require.NoError(t, pathBC.EndpointB.UpdateClient())
+		res := timeoutWithResult(t, pathBC.EndpointA, forwarded)
+		ackB, err := ibctesting.ParseAckFromEvents(res.Events)
+		require.NoError(t, err)
+		acknowledgeWithResult(t, pathAB.EndpointA, packet, ackB)
+
+		require.Equal(t, before, bankA.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount)
+	})
+}
+
+func TestReadPacketForwardConfig(t *testing.T) {
+	cfg, err := app.ReadPacketForwardConfig(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, app.DefaultPacketForwardConfig(), cfg)
+
+	cfg, err = app.ReadPacketForwardConfig(simtestutil.AppOptionsMap{
+		app.FlagPacketForwardRetriesOnTimeout: "2",
+		app.FlagPacketForwardTimeout:          "90s",
+	})
+	require.NoError(t, err)
+	require.Equal(t, app.PacketForwardConfig{RetriesOnTimeout: 2, ForwardTimeout: 90 * time.Second}, cfg)
+
+	_, err = app.ReadPacketForwardConfig(simtestutil.AppOptionsMap{app.FlagPacketForwardRetriesOnTimeout: "256"})
+	require.Error(t, err)
+	_, err = app.ReadPacketForwardConfig(simtestutil.AppOptionsMap{app.FlagPacketForwardTimeout: "0s"})
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// setRateLimit rate limits the transfer channel of chain A to a net flow of
+// maxAmount per hour.
+func setRateLimit(t *testing.T, coordinator *ibctesting.Coordinator, path *ibctesting.Path, maxAmount int64) {
+	t.Helper()
+
+	chainA := path.EndpointA.Chain
+	k := chainA.App.(*app.App).OptioKeeper
+	quota := optiotypes.RateLimitQuota{MaxPercent: math.LegacyZeroDec(), MaxAmount: math.NewInt(maxAmount), Window: time.Hour}
+	_, err := optiokeeper.NewMsgServerImpl(k).SetRateLimit(chainA.GetContext(), optiotypes.NewMsgSetRateLimit(k.GetAuthority(), path.EndpointA.ChannelID, quota))
+	require.NoError(t, err)
+	coordinator.CommitBlock(chainA)
+}
+
+// requireOutflow checks the outflow counted for the transfer channel of
+// chain A.
+func requireOutflow(t *testing.T, path *ibctesting.Path, outflow int64) {
+	t.Helper()
+
+	chainA := path.EndpointA.Chain
+	rateLimit, found := chainA.App.(*app.App).OptioKeeper.GetRateLimit(chainA.GetContext(), path.EndpointA.ChannelID)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(outflow), rateLimit.Flow.Outflow)
+}
+
+func TestTransferRateLimit(t *testing.T) {
+	t.Run("outflow over the cap is rejected", func(t *testing.T) {
+		coordinator, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+		setRateLimit(t, coordinator, path, 100)
+
+		receiver := path.EndpointB.Chain.SenderAccount.GetAddress().String()
+		distributeCrossChain(t, path, receiver, 100)
+		requireOutflow(t, path, 100)
+
+		_, err := chainA.SendMsgs(optiotypes.NewMsgDistribute(
+			chainA.SenderAccount.GetAddress().String(),
+			math.NewInt(1),
+			[]*optiotypes.Recipient{{SourceChannel: path.EndpointA.ChannelID, Receiver: receiver, Amount: math.NewInt(1)}},
+		))
+		require.ErrorContains(t, err, optiotypes.ErrRateLimitExceeded.Error())
+		requireOutflow(t, path, 100)
+	})
+
+	t.Run("timeout reverts the outflow", func(t *testing.T) {
+		coordinator, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+		setRateLimit(t, coordinator, path, 100)
+
+		packet := distributeCrossChain(t, path, path.EndpointB.Chain.SenderAccount.GetAddress().String(), 100)
+		requireOutflow(t, path, 100)
+
+		// the window outlasts the timeout
+		params := chainA.App.(*app.App).OptioKeeper.GetParams(chainA.GetContext())
+		coordinator.IncrementTimeBy(params.TransferTimeout + time.Minute)
+		require.NoError(t, path.EndpointA.UpdateClient())
+		require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+		requireOutflow(t, path, 0)
+	})
+
+	t.Run("inflow over the cap is acknowledged with an error", func(t *testing.T) {
+		coordinator, path := setupTransferPath(t)
+		chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+		receiver := chainB.SenderAccount.GetAddress()
+
+		packet := distributeCrossChain(t, path, receiver.String(), 100)
+		require.NoError(t, path.RelayPacket(packet))
+		setRateLimit(t, coordinator, path, 50)
+
+		// chain B sends the vouchers back
+		voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, optiotypes.DefaultDenom,
+		)).IBCDenom()
+		res, err := chainB.SendMsgs(transfertypes.NewMsgTransfer(
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			sdk.NewInt64Coin(voucher, 100),
+			receiver.String(),
+			chainA.SenderAccount.GetAddress().String(),
+			chainA.GetTimeoutHeight(),
+			0,
+			"",
+		))
+		require.NoError(t, err)
+		packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+
+		_, ack, err := path.RelayPacketWithResults(packet)
+		require.NoError(t, err)
+		var acknowledgement channeltypes.Acknowledgement
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+		require.False(t, acknowledgement.Success())
+
+		// the vouchers are refunded on chain B and chain A keeps the escrow
+		appA, appB := chainA.App.(*app.App), chainB.App.(*app.App)
+		require.Equal(t, math.NewInt(100), appB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher).Amount)
+		escrow := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		require.Equal(t, math.NewInt(100), appA.BankKeeper.GetBalance(chainA.GetContext(), escrow, optiotypes.DefaultDenom).Amount)
+
+		rateLimit, found := appA.OptioKeeper.GetRateLimit(chainA.GetContext(), path.EndpointA.ChannelID)
+		require.True(t, found)
+		require.True(t, rateLimit.Flow.Inflow.IsZero())
+	})
+}
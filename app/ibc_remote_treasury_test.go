@@ -0,0 +1,150 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// setupRemoteTreasury registers the remote treasury of chain A on chain B,
+// completes the channel handshake and funds the treasury with 100stake.
+func setupRemoteTreasury(t *testing.T) (*ibctesting.Path, string) {
+	t.Helper()
+
+	_, transferPath := setupTransferPath(t)
+	chainA, chainB := transferPath.EndpointA.Chain, transferPath.EndpointB.Chain
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ConnectionID = transferPath.EndpointA.ConnectionID
+	path.EndpointA.ClientID = transferPath.EndpointA.ClientID
+	path.EndpointB.ConnectionID = transferPath.EndpointB.ConnectionID
+	path.EndpointB.ClientID = transferPath.EndpointB.ClientID
+	path.EndpointA.ChannelConfig.PortID = optiotypes.RemoteTreasuryPortID()
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+
+	res, err := chainA.SendMsgs(optiotypes.NewMsgRegisterRemoteTreasury(
+		chainA.SenderAccount.GetAddress().String(),
+		path.EndpointA.ConnectionID,
+		"",
+	))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.Events)
+	require.NoError(t, err)
+
+	version := path.EndpointA.GetChannel().Version
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	treasury, err := chainA.App.(*app.App).OptioKeeper.RemoteTreasury(chainA.GetContext(), &optiotypes.QueryRemoteTreasuryRequest{
+		ConnectionId: path.EndpointA.ConnectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, path.EndpointA.ChannelID, treasury.ChannelId)
+
+	_, err = chainB.SendMsgs(banktypes.NewMsgSend(
+		chainB.SenderAccount.GetAddress(),
+		sdk.MustAccAddressFromBech32(treasury.Address),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	))
+	require.NoError(t, err)
+
+	return path, treasury.Address
+}
+
+// remoteDistribute pays 60stake and amount to two accounts of chain B from
+// the remote treasury of chain A, relays the packet and returns the tracked
+// distribution.
+func remoteDistribute(t *testing.T, path *ibctesting.Path, amount int64) optiotypes.RemoteDistribution {
+	t.Helper()
+
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	alice, bob := chainB.SenderAccounts[1].SenderAccount.GetAddress(), chainB.SenderAccounts[2].SenderAccount.GetAddress()
+	res, err := chainA.SendMsgs(optiotypes.NewMsgRemoteDistribute(
+		chainA.SenderAccount.GetAddress().String(),
+		path.EndpointA.ConnectionID,
+		[]banktypes.Output{
+			{Address: alice.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))},
+			{Address: bob.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))},
+		},
+		"treasury",
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	k := chainA.App.(*app.App).OptioKeeper
+	distribution, found := k.GetRemoteDistribution(chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+
+	// the IBC callbacks resolved the payout
+	outcome, found := k.GetPacketOutcome(chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+	require.False(t, outcome.IsPending())
+	pending, err := k.PendingPayouts(chainA.GetContext(), &optiotypes.QueryPendingPayoutsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.PacketOutcome)
+	return distribution
+}
+
+func TestRemoteTreasury(t *testing.T) {
+	t.Run("executes the multi-send on the host chain", func(t *testing.T) {
+		path, treasury := setupRemoteTreasury(t)
+		chainB := path.EndpointB.Chain
+		bankB := chainB.App.(*app.App).BankKeeper
+		before := func(address sdk.AccAddress) math.Int {
+			return bankB.GetBalance(chainB.GetContext(), address, sdk.DefaultBondDenom).Amount
+		}
+		alice, bob := chainB.SenderAccounts[1].SenderAccount.GetAddress(), chainB.SenderAccounts[2].SenderAccount.GetAddress()
+		aliceBefore, bobBefore := before(alice), before(bob)
+
+		distribution := remoteDistribute(t, path, 40)
+		require.Equal(t, optiotypes.REMOTE_DISTRIBUTION_STATUS_COMPLETED, distribution.Status)
+		require.Equal(t, treasury, distribution.Treasury)
+		require.Equal(t, "treasury", distribution.Memo)
+		outcome, _ := path.EndpointA.Chain.App.(*app.App).OptioKeeper.GetPacketOutcome(path.EndpointA.Chain.GetContext(), path.EndpointA.ChannelConfig.PortID, distribution.ChannelId, distribution.Sequence)
+		require.Equal(t, optiotypes.PACKET_OUTCOME_STATUS_ACKNOWLEDGED, outcome.Status)
+
+		require.Equal(t, aliceBefore.AddRaw(60), before(alice))
+		require.Equal(t, bobBefore.AddRaw(40), before(bob))
+		require.True(t, bankB.GetBalance(chainB.GetContext(), sdk.MustAccAddressFromBech32(treasury), sdk.DefaultBondDenom).IsZero())
+	})
+
+	t.Run("error acknowledgement is recorded", func(t *testing.T) {
+		path, treasury := setupRemoteTreasury(t)
+		chainB := path.EndpointB.Chain
+
+		// the treasury only holds 100stake
+		distribution := remoteDistribute(t, path, 41)
+		require.Equal(t, optiotypes.REMOTE_DISTRIBUTION_STATUS_FAILED, distribution.Status)
+		require.NotEmpty(t, distribution.Error)
+		outcome, _ := path.EndpointA.Chain.App.(*app.App).OptioKeeper.GetPacketOutcome(path.EndpointA.Chain.GetContext(), path.EndpointA.ChannelConfig.PortID, distribution.ChannelId, distribution.Sequence)
+		require.Equal(t, optiotypes.PACKET_OUTCOME_STATUS_FAILED, outcome.Status)
+		require.Equal(t, distribution.Error, outcome.Error)
+		require.Equal(t, math.NewInt(100), chainB.App.(*app.App).BankKeeper.GetBalance(chainB.GetContext(), sdk.MustAccAddressFromBech32(treasury), sdk.DefaultBondDenom).Amount)
+	})
+
+	t.Run("unregistered connection", func(t *testing.T) {
+		_, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+
+		_, err := chainA.SendMsgs(optiotypes.NewMsgRemoteDistribute(
+			chainA.SenderAccount.GetAddress().String(),
+			path.EndpointA.ConnectionID,
+			[]banktypes.Output{{Address: "cosmos1receiver", Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))}},
+			"",
+		))
+		require.ErrorContains(t, err, optiotypes.ErrNoRemoteTreasury.Error())
+	})
+}
@@ -0,0 +1,252 @@
+package app_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/app"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiomodule "github.com/OptioServices/optio/x/optio/module"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+var _ ibctesting.TestingApp = (*app.App)(nil)
+
+// setupTestingApp builds the optio app for the chains of an ibc-go testing
+// coordinator.
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return setupTestingAppWithOptions(nil)()
+}
+
+// setupTestingAppWithOptions returns a builder of the optio app with the
+// given app options for the chains of an ibc-go testing coordinator.
+func setupTestingAppWithOptions(options simtestutil.AppOptionsMap) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		appOptions := simtestutil.AppOptionsMap{flags.FlagHome: app.DefaultNodeHome}
+		for key, value := range options {
+			appOptions[key] = value
+		}
+		bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+		if err != nil {
+			panic(err)
+		}
+
+		// the testing chains sign their txs without fees, so they start
+		// without chain-wide minimum gas prices
+		genesis := bApp.DefaultGenesis()
+		var optioGenesis optiotypes.GenesisState
+		bApp.AppCodec().MustUnmarshalJSON(genesis[optiotypes.ModuleName], &optioGenesis)
+		optioGenesis.Params.MinimumGasPrices = nil
+		genesis[optiotypes.ModuleName] = bApp.AppCodec().MustMarshalJSON(&optioGenesis)
+		return bApp, genesis
+	}
+}
+
+// setupTransferPath connects two optio chains with a transfer channel and
+// authorizes the sender of chain A to distribute.
+func setupTransferPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	t.Helper()
+
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	k := chainA.App.(*app.App).OptioKeeper
+	params := k.GetParams(chainA.GetContext())
+	params.AuthorizedAccounts = []string{chainA.SenderAccount.GetAddress().String()}
+	params.MaxSupply = math.NewInt(1000)
+	require.NoError(t, k.SetParams(chainA.GetContext(), params))
+	coordinator.CommitBlock(chainA)
+
+	return coordinator, path
+}
+
+// distributeCrossChain distributes amount to receiver on chain B and to a
+// local recipient on chain A and returns the sent transfer packet.
+func distributeCrossChain(t *testing.T, path *ibctesting.Path, receiver string, amount int64) channeltypes.Packet {
+	t.Helper()
+
+	chainA := path.EndpointA.Chain
+	res, err := chainA.SendMsgs(optiotypes.NewMsgDistribute(
+		chainA.SenderAccount.GetAddress().String(),
+		math.NewInt(amount+10),
+		[]*optiotypes.Recipient{
+			{Address: chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), Amount: math.NewInt(10)},
+			{SourceChannel: path.EndpointA.ChannelID, Receiver: receiver, Amount: math.NewInt(amount)},
+		},
+	))
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+// transferOutcomes maps the status of a tracked transfer to the outcome the
+// IBC callbacks record for its packet.
+var transferOutcomes = map[optiotypes.TransferStatus]optiotypes.PacketOutcomeStatus{
+	optiotypes.TRANSFER_STATUS_PENDING:          optiotypes.PACKET_OUTCOME_STATUS_PENDING,
+	optiotypes.TRANSFER_STATUS_COMPLETED:        optiotypes.PACKET_OUTCOME_STATUS_ACKNOWLEDGED,
+	optiotypes.TRANSFER_STATUS_REFUNDED_ERROR:   optiotypes.PACKET_OUTCOME_STATUS_FAILED,
+	optiotypes.TRANSFER_STATUS_REFUNDED_TIMEOUT: optiotypes.PACKET_OUTCOME_STATUS_TIMEOUT,
+}
+
+// requireTransferStatus checks the tracked packet, its outcome and the optio
+// invariants on chain A.
+func requireTransferStatus(t *testing.T, path *ibctesting.Path, packet channeltypes.Packet, status optiotypes.TransferStatus) {
+	t.Helper()
+
+	chainA := path.EndpointA.Chain
+	k := chainA.App.(*app.App).OptioKeeper
+	ctx := chainA.GetContext()
+
+	tracked, found := k.GetTransferPacket(ctx, packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, status, tracked.Status)
+
+	outcome, found := k.GetPacketOutcome(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, transferOutcomes[status], outcome.Status)
+
+	msg, broken := optiokeeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDistributeCrossChain(t *testing.T) {
+	t.Run("acknowledged", func(t *testing.T) {
+		_, path := setupTransferPath(t)
+		chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+		receiver := chainB.SenderAccount.GetAddress()
+
+		packet := distributeCrossChain(t, path, receiver.String(), 100)
+		requireTransferStatus(t, path, packet, optiotypes.TRANSFER_STATUS_PENDING)
+		require.NoError(t, path.RelayPacket(packet))
+		requireTransferStatus(t, path, packet, optiotypes.TRANSFER_STATUS_COMPLETED)
+
+		voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, optiotypes.DefaultDenom,
+		)).IBCDenom()
+		balance := chainB.App.(*app.App).BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher)
+		require.Equal(t, math.NewInt(100), balance.Amount)
+
+		appA := chainA.App.(*app.App)
+		escrow := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		require.Equal(t, math.NewInt(100), appA.BankKeeper.GetBalance(chainA.GetContext(), escrow, optiotypes.DefaultDenom).Amount)
+		require.Equal(t, math.NewInt(110), appA.BankKeeper.GetSupply(chainA.GetContext(), optiotypes.DefaultDenom).Amount)
+	})
+
+	t.Run("error acknowledgement", func(t *testing.T) {
+		_, path := setupTransferPath(t)
+
+		// chain B cannot decode the receiver and acknowledges with an error
+		packet := distributeCrossChain(t, path, "not-an-address", 990)
+		require.NoError(t, path.RelayPacket(packet))
+		requireTransferStatus(t, path, packet, optiotypes.TRANSFER_STATUS_REFUNDED_ERROR)
+		requireRefunded(t, path, 990)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		coordinator, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+
+		packet := distributeCrossChain(t, path, path.EndpointB.Chain.SenderAccount.GetAddress().String(), 990)
+
+		params := chainA.App.(*app.App).OptioKeeper.GetParams(chainA.GetContext())
+		coordinator.IncrementTimeBy(params.TransferTimeout + time.Minute)
+		require.NoError(t, path.EndpointA.UpdateClient())
+		require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+		requireTransferStatus(t, path, packet, optiotypes.TRANSFER_STATUS_REFUNDED_TIMEOUT)
+		requireRefunded(t, path, 990)
+	})
+
+	t.Run("timeout with a failing callback", func(t *testing.T) {
+		_, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+		appA := chainA.App.(*app.App)
+
+		// the callbacks middleware drops the error of the callback, the
+		// optio middleware below it still burns the refund
+		packet := distributeCrossChain(t, path, path.EndpointB.Chain.SenderAccount.GetAddress().String(), 990)
+		stack := ibccallbacks.NewIBCMiddleware(
+			optiomodule.NewIBCMiddleware(ibctransfer.NewIBCModule(appA.TransferKeeper), appA.OptioKeeper),
+			appA.IBCFeeKeeper,
+			failingContractKeeper{appA.OptioKeeper},
+			1_000_000,
+		)
+		require.NoError(t, stack.OnTimeoutPacket(chainA.GetContext(), packet, chainA.SenderAccount.GetAddress()))
+
+		tracked, found := appA.OptioKeeper.GetTransferPacket(chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+		require.True(t, found)
+		require.Equal(t, optiotypes.TRANSFER_STATUS_REFUNDED_TIMEOUT, tracked.Status)
+		requireRefunded(t, path, 990)
+	})
+
+	t.Run("other senders cannot request the optio callbacks", func(t *testing.T) {
+		_, path := setupTransferPath(t)
+		chainA := path.EndpointA.Chain
+
+		_, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+			chainA.SenderAccount.GetAddress().String(),
+			path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 1000),
+			0,
+			optiotypes.CallbackMemo(),
+		))
+		require.ErrorContains(t, err, optiotypes.ErrInvalidCallback.Error())
+	})
+}
+
+// failingContractKeeper fails the acknowledgement and timeout callbacks of
+// the optio keeper.
+type failingContractKeeper struct {
+	optiokeeper.Keeper
+}
+
+func (failingContractKeeper) IBCOnAcknowledgementPacketCallback(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress, string, string) error {
+	return errors.New("callback failed")
+}
+
+func (failingContractKeeper) IBCOnTimeoutPacketCallback(sdk.Context, channeltypes.Packet, sdk.AccAddress, string, string) error {
+	return errors.New("callback failed")
+}
+
+// requireRefunded checks that the refunded amount was burned and that the
+// headroom below the max supply is available again.
+func requireRefunded(t *testing.T, path *ibctesting.Path, amount int64) {
+	t.Helper()
+
+	chainA := path.EndpointA.Chain
+	appA := chainA.App.(*app.App)
+	ctx := chainA.GetContext()
+
+	require.Equal(t, math.NewInt(amount), appA.OptioKeeper.GetSupplyRecord(ctx).Burned)
+	require.Equal(t, math.NewInt(10), appA.BankKeeper.GetSupply(ctx, optiotypes.DefaultDenom).Amount)
+	require.True(t, appA.BankKeeper.GetBalance(ctx, optiotypes.TransferSenderAddress, optiotypes.DefaultDenom).IsZero())
+	require.Equal(t, sdk.NewCoins(), appA.BankKeeper.GetAllBalances(ctx, transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)))
+
+	// the refunded amount can be distributed again
+	distributeCrossChain(t, path, path.EndpointB.Chain.SenderAccount.GetAddress().String(), amount-10)
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagPacketForwardRetriesOnTimeout is the app.toml key of
+	// PacketForwardConfig.RetriesOnTimeout.
+	FlagPacketForwardRetriesOnTimeout = "packet-forward.retries-on-timeout"
+	// FlagPacketForwardTimeout is the app.toml key of
+	// PacketForwardConfig.ForwardTimeout.
+	FlagPacketForwardTimeout = "packet-forward.forward-timeout"
+)
+
+// PacketForwardConfig configures the packet-forward middleware of the
+// transfer stack. A forward memo may override both values for its hop.
+type PacketForwardConfig struct {
+	// RetriesOnTimeout is the number of times a forwarded transfer is sent
+	// again after it timed out, before the incoming transfer is refunded.
+	RetriesOnTimeout uint8 `mapstructure:"retries-on-timeout"`
+	// ForwardTimeout is the relative timeout of forwarded transfers.
+	ForwardTimeout time.Duration `mapstructure:"forward-timeout"`
+}
+
+// DefaultPacketForwardConfig returns the packet-forward config of a new node:
+// no retries and the default ICS-20 timeout.
+func DefaultPacketForwardConfig() PacketForwardConfig {
+	return PacketForwardConfig{
+		RetriesOnTimeout: 0,
+		ForwardTimeout:   packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+	}
+}
+
+// PacketForwardConfigTemplate is the app.toml section of PacketForwardConfig.
+const PacketForwardConfigTemplate = `
+###############################################################################
+###                      Packet Forward Configuration                       ###
+###############################################################################
+
+[packet-forward]
+
+# Number of times a forwarded transfer is sent again after it timed out,
+# before the incoming transfer is refunded.
+retries-on-timeout = {{ .PacketForward.RetriesOnTimeout }}
+
+# Relative timeout of forwarded transfers, e.g. "10m0s".
+forward-timeout = "{{ .PacketForward.ForwardTimeout }}"
+`
+
+// ReadPacketForwardConfig reads the packet-forward config from the app
+// options. Missing values keep their default.
+func ReadPacketForwardConfig(appOpts servertypes.AppOptions) (PacketForwardConfig, error) {
+	cfg := DefaultPacketForwardConfig()
+
+	if v := appOpts.Get(FlagPacketForwardRetriesOnTimeout); v != nil {
+		retries, err := cast.ToUintE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagPacketForwardRetriesOnTimeout, err)
+		}
+		if retries > math.MaxUint8 {
+			return cfg, fmt.Errorf("%s must be at most %d, got %d", FlagPacketForwardRetriesOnTimeout, math.MaxUint8, retries)
+		}
+		cfg.RetriesOnTimeout = uint8(retries)
+	}
+	if v := appOpts.Get(FlagPacketForwardTimeout); v != nil {
+		timeout, err := cast.ToDurationE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagPacketForwardTimeout, err)
+		}
+		if timeout <= 0 {
+			return cfg, fmt.Errorf("%s must be positive, got %s", FlagPacketForwardTimeout, timeout)
+		}
+		cfg.ForwardTimeout = timeout
+	}
+
+	return cfg, nil
+}
@@ -2,6 +2,7 @@ package app_test
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"math/rand"
@@ -23,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -33,6 +36,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/OptioServices/optio/app"
+	optiomodule "github.com/OptioServices/optio/x/optio/module"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
 )
 
 const (
@@ -59,6 +64,31 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// simulationOperations returns the operations of the simulation manager of
+// the app. The SDK modules send some of their txs without fees, which the
+// minimum gas prices and the base fee of the randomized optio genesis reject,
+// so these rejections are no-ops rather than failures. The optio operations
+// pay random fees that cover the randomized prices and still fail.
+func simulationOperations(bApp *app.App, config simulationtypes.Config) []simulationtypes.WeightedOperation {
+	operations := simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config)
+	for i, operation := range operations {
+		operations[i] = simulation.NewWeightedOperation(operation.Weight(), skipUnpaidTxs(operation.Op()))
+	}
+	return operations
+}
+
+func skipUnpaidTxs(operation simulationtypes.Operation) simulationtypes.Operation {
+	return func(
+		r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []simulationtypes.Account, chainID string,
+	) (simulationtypes.OperationMsg, []simulationtypes.FutureOperation, error) {
+		opMsg, futureOps, err := operation(r, bApp, ctx, accs, chainID)
+		if opMsg.Route != optiotypes.ModuleName && errors.Is(err, sdkerrors.ErrInsufficientFee) {
+			return simulationtypes.NoOpMsg(opMsg.Route, opMsg.Name, "tx without fees"), nil, nil
+		}
+		return opMsg, futureOps, err
+	}
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -99,7 +129,7 @@ func BenchmarkSimulation(b *testing.B) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		app.BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -145,7 +175,7 @@ func TestAppImportExport(t *testing.T) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		app.BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -197,6 +227,11 @@ func TestAppImportExport(t *testing.T) {
 	require.NoError(t, err)
 	err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 	require.NoError(t, err)
+
+	optioGenesis := optiomodule.ExportGenesis(ctxB, newApp.OptioKeeper)
+	require.NoError(t, optioGenesis.Validate())
+	require.Equal(t, optiomodule.ExportGenesis(ctxA, bApp.OptioKeeper), optioGenesis)
+
 	fmt.Printf("comparing stores...\n")
 
 	// skip certain prefixes
@@ -268,7 +303,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		app.BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -319,7 +354,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		newApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(newApp, newApp.AppCodec(), config),
+		simulationOperations(newApp, config),
 		app.BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -405,7 +440,7 @@ func TestAppStateDeterminism(t *testing.T) {
 					bApp.DefaultGenesis(),
 				),
 				simulationtypes.RandomAccounts,
-				simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+				simulationOperations(bApp, config),
 				app.BlockedAddresses(),
 				config,
 				bApp.AppCodec(),
@@ -0,0 +1,12 @@
+package app
+
+import (
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+)
+
+// GetStakingKeeper returns the staking keeper. It completes the
+// ibctesting.TestingApp interface in tests only, so that the ibc-go testing
+// package is not built into the binary.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/OptioServices/optio/app/upgrades"
+	v2 "github.com/OptioServices/optio/app/upgrades/v2"
+)
+
+// Upgrades lists the named upgrades this binary can run. Add new upgrades
+// at the end.
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setupUpgradeHandlers registers the upgrade handler of every upgrade.
+func (app *App) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), upgrades.AppKeepers{
+				AccountKeeper: app.AccountKeeper,
+				OptioKeeper:   app.OptioKeeper,
+			}),
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders applies the store upgrades of the upgrade that
+// stopped the chain, if any, when the new binary loads the stores.
+func (app *App) setupUpgradeStoreLoaders() error {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Package upgrades defines the named software upgrades of the chain. Each
+// upgrade lives in its own package and is listed in app.Upgrades.
+package upgrades
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+)
+
+// Upgrade defines a named software upgrade. The upgrade handler built by
+// CreateUpgradeHandler runs at the upgrade height, StoreUpgrades lists the
+// stores added, renamed or deleted by the new binary and is applied when it
+// starts at that height.
+type Upgrade struct {
+	// UpgradeName is the name of the governance upgrade plan.
+	UpgradeName string
+
+	// CreateUpgradeHandler builds the handler run at the upgrade height.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator, AppKeepers) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the store changes of the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// AppKeepers are the keepers an upgrade handler may use for state changes
+// that are not module migrations.
+type AppKeepers struct {
+	AccountKeeper authkeeper.AccountKeeper
+	OptioKeeper   optiokeeper.Keeper
+}
@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+
+	"github.com/OptioServices/optio/app/upgrades"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+// UpgradeName is the name of the upgrade plan.
+const UpgradeName = "v2"
+
+// Upgrade runs the module migrations up to optio consensus version 4: the
+// math.Int params of v2, the backfilled distributor stats and supply record
+// of v3 and the base fee params of v4. It also grants the optio module account the burner
+// permission used for refunded cross-chain transfers and binds the port of
+// the optio IBC application. It adds the store of the packet-forward
+// middleware, whose module is initialized by the migrations.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{packetforwardtypes.StoreKey},
+	},
+}
+
+// CreateUpgradeHandler returns the handler running the module migrations.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator, keepers upgrades.AppKeepers) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		grantOptioBurner(ctx, keepers.AccountKeeper)
+		if err := bindOptioPort(sdk.UnwrapSDKContext(ctx), keepers.OptioKeeper); err != nil {
+			return nil, err
+		}
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+// grantOptioBurner adds the burner permission to an existing optio module
+// account. Module account permissions are stored with the account, so the
+// new permission in the app config only applies to accounts created later.
+func grantOptioBurner(ctx context.Context, accountKeeper authkeeper.AccountKeeper) {
+	acc := accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(optiotypes.ModuleName))
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok || moduleAcc.HasPermission(authtypes.Burner) {
+		return
+	}
+
+	moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+	accountKeeper.SetAccount(ctx, moduleAcc)
+}
+
+// bindOptioPort binds the optio port on chains started before the optio IBC
+// application existed, InitGenesis only binds it for new chains.
+func bindOptioPort(ctx sdk.Context, k optiokeeper.Keeper) error {
+	if k.GetPort(ctx) == "" {
+		k.SetPort(ctx, optiotypes.PortID)
+	}
+	if !k.ShouldBound(ctx, k.GetPort(ctx)) {
+		return nil
+	}
+	return k.BindPort(ctx, k.GetPort(ctx))
+}
@@ -0,0 +1,225 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/OptioServices/optio/app"
+	v2 "github.com/OptioServices/optio/app/upgrades/v2"
+	"github.com/OptioServices/optio/testutil/sample"
+	optiokeeper "github.com/OptioServices/optio/x/optio/keeper"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
+)
+
+const upgradeTestChainID = "optio-upgrade"
+
+// setupUpgradeApp starts a single validator chain and commits its first
+// block.
+func setupUpgradeApp(t *testing.T) *app.App {
+	t.Helper()
+
+	appOptions := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(upgradeTestChainID))
+	require.NoError(t, err)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	acc := authtypes.NewBaseAccount(secp256k1.GenPrivKey().PubKey().Address().Bytes(), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000_000)),
+	}
+	genesisState, err := simtestutil.GenesisStateWithValSet(bApp.AppCodec(), bApp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = bApp.InitChain(&abci.RequestInitChain{
+		ChainId:         upgradeTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	finalizeAndCommit(t, bApp)
+
+	return bApp
+}
+
+func finalizeAndCommit(t *testing.T, bApp *app.App) {
+	t.Helper()
+
+	_, err := bApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: bApp.LastBlockHeight() + 1,
+		Time:   time.Unix(1_700_000_000+bApp.LastBlockHeight(), 0),
+	})
+	require.NoError(t, err)
+	_, err = bApp.Commit()
+	require.NoError(t, err)
+}
+
+// runUpgrade writes the pre-upgrade state with populate, sets the optio
+// consensus version to fromVersion and runs the named upgrade at the next
+// block.
+func runUpgrade(t *testing.T, bApp *app.App, name string, fromVersion uint64, populate func(ctx sdk.Context)) sdk.Context {
+	t.Helper()
+
+	ctx := bApp.NewUncachedContext(false, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	populate(ctx)
+
+	vm, err := bApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	vm[optiotypes.ModuleName] = fromVersion
+	require.NoError(t, bApp.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+
+	plan := upgradetypes.Plan{Name: name, Height: bApp.LastBlockHeight() + 1}
+	require.NoError(t, bApp.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	finalizeAndCommit(t, bApp)
+
+	done, err := bApp.UpgradeKeeper.GetDoneHeight(ctx, name)
+	require.NoError(t, err)
+	require.Equal(t, plan.Height, done)
+
+	ctx = bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	vm, err = bApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bApp.ModuleManager.GetVersionMap()[optiotypes.ModuleName], vm[optiotypes.ModuleName])
+
+	_, broken := optiokeeper.AllInvariants(bApp.OptioKeeper)(ctx)
+	require.False(t, broken)
+
+	return ctx
+}
+
+func TestUpgradeV2(t *testing.T) {
+	t.Run("from v1 params", func(t *testing.T) {
+		bApp := setupUpgradeApp(t)
+		account := sample.AccAddress()
+
+		ctx := runUpgrade(t, bApp, v2.UpgradeName, 1, func(ctx sdk.Context) {
+			// v1 params: repeated string authorizedAccounts = 1; string denom = 2; uint64 maxSupply = 3;
+			var bz []byte
+			bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+			bz = protowire.AppendString(bz, account)
+			bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+			bz = protowire.AppendString(bz, "uOPT")
+			bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+			bz = protowire.AppendVarint(bz, 1_000_000)
+			ctx.KVStore(bApp.GetKey(optiotypes.StoreKey)).Set(optiotypes.ParamsKey, bz)
+		})
+
+		params := bApp.OptioKeeper.GetParams(ctx)
+		require.Equal(t, []string{account}, params.AuthorizedAccounts)
+		require.Equal(t, math.NewInt(1_000_000), params.MaxSupply)
+		require.Equal(t, math.ZeroInt(), bApp.OptioKeeper.GetSupplyRecord(ctx).Minted)
+		require.Equal(t, optiotypes.DefaultBaseFeeBurnRate, params.BaseFeeBurnRate)
+		require.NoError(t, params.Validate())
+
+		// the recipient cap applies on the migrated chain
+		recipients := make([]*optiotypes.Recipient, params.MaxRecipientsPerMsg+1)
+		for i := range recipients {
+			recipients[i] = &optiotypes.Recipient{Address: sample.AccAddress(), Amount: math.OneInt()}
+		}
+		msg := optiotypes.NewMsgDistribute(account, math.NewInt(int64(len(recipients))), recipients)
+		_, err := optiokeeper.NewMsgServerImpl(bApp.OptioKeeper).Distribute(ctx, msg)
+		require.ErrorIs(t, err, optiotypes.ErrTooManyRecipients)
+	})
+
+	t.Run("from v2 distribution history", func(t *testing.T) {
+		bApp := setupUpgradeApp(t)
+		distributor := sample.AccAddress()
+		recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+		ctx := runUpgrade(t, bApp, v2.UpgradeName, 2, func(ctx sdk.Context) {
+			k := bApp.OptioKeeper
+			params := k.GetParams(ctx)
+			params.AuthorizedAccounts = []string{distributor}
+			require.NoError(t, k.SetParams(ctx, params))
+
+			// a v2 distribution: minted and recorded, without stats or
+			// supply record
+			for _, amount := range []int64{100, 250} {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(params.Denom, amount))
+				require.NoError(t, bApp.BankKeeper.MintCoins(ctx, optiotypes.ModuleName, coins))
+				require.NoError(t, bApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, optiotypes.ModuleName, recipient, coins))
+				k.AppendDistribution(ctx, optiotypes.Distribution{
+					Distributor: distributor,
+					Amount:      math.NewInt(amount),
+					Recipients:  []optiotypes.Recipient{{Address: recipient.String(), Amount: math.NewInt(amount)}},
+					Height:      ctx.BlockHeight(),
+				})
+			}
+
+			// genesis allocations and inflation in the optio denom are not
+			// minted by the module
+			coins := sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1000))
+			require.NoError(t, bApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+			require.NoError(t, bApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, coins))
+
+			// v2 module accounts were created without the burner permission
+			acc := bApp.AccountKeeper.GetModuleAccount(ctx, optiotypes.ModuleName).(*authtypes.ModuleAccount)
+			acc.Permissions = []string{authtypes.Minter}
+			bApp.AccountKeeper.SetAccount(ctx, acc)
+
+			// and without the optio port
+			scopedKeeper := bApp.GetCapabilityScopedKeeper(optiotypes.ModuleName)
+			portCap, found := scopedKeeper.GetCapability(ctx, host.PortPath(optiotypes.PortID))
+			require.True(t, found)
+			require.NoError(t, scopedKeeper.ReleaseCapability(ctx, portCap))
+			require.NoError(t, bApp.ScopedIBCKeeper.ReleaseCapability(ctx, portCap))
+			ctx.KVStore(bApp.GetKey(optiotypes.StoreKey)).Delete(optiotypes.PortKey)
+		})
+
+		stats, found := bApp.OptioKeeper.GetDistributorStats(ctx, distributor)
+		require.True(t, found)
+		require.Equal(t, math.NewInt(350), stats.TotalDistributed)
+		require.Equal(t, uint64(2), stats.DistributionCount)
+		require.Equal(t, math.NewInt(350), bApp.OptioKeeper.GetSupplyRecord(ctx).Minted)
+		require.True(t, bApp.AccountKeeper.GetModuleAccount(ctx, optiotypes.ModuleName).HasPermission(authtypes.Burner))
+		require.Equal(t, optiotypes.PortID, bApp.OptioKeeper.GetPort(ctx))
+		require.True(t, bApp.IBCKeeper.PortKeeper.IsBound(ctx, optiotypes.PortID))
+		require.False(t, bApp.OptioKeeper.ShouldBound(ctx, optiotypes.PortID))
+	})
+}
+
+func TestUpgradesStoreUpgrades(t *testing.T) {
+	bApp := setupUpgradeApp(t)
+
+	names := make(map[string]bool)
+	for _, upgrade := range app.Upgrades {
+		require.False(t, names[upgrade.UpgradeName], "duplicate upgrade %s", upgrade.UpgradeName)
+		names[upgrade.UpgradeName] = true
+
+		// added and renamed stores have to be mounted by this binary
+		for _, name := range upgrade.StoreUpgrades.Added {
+			require.NotNil(t, bApp.GetKey(name), "%s adds unknown store %s", upgrade.UpgradeName, name)
+		}
+		for _, rename := range upgrade.StoreUpgrades.Renamed {
+			require.NotNil(t, bApp.GetKey(rename.NewKey), "%s renames to unknown store %s", upgrade.UpgradeName, rename.NewKey)
+		}
+	}
+}
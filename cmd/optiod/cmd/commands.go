@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/OptioServices/optio/app"
+	optiocli "github.com/OptioServices/optio/x/optio/client/cli"
 )
 
 func initRootCmd(
@@ -45,7 +46,7 @@ func initRootCmd(
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager, optiocli.CmdMigrateGenesis()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
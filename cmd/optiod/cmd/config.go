@@ -3,6 +3,9 @@ package cmd
 import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+
+	"github.com/OptioServices/optio/app"
+	optiotypes "github.com/OptioServices/optio/x/optio/types"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -20,9 +23,10 @@ func initCometBFTConfig() *cmtcfg.Config {
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
-	// The following code snippet is just for reference.
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		PacketForward app.PacketForwardConfig `mapstructure:"packet-forward"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -30,24 +34,18 @@ func initAppConfig() (string, interface{}) {
 	srvCfg := serverconfig.DefaultConfig()
 	// The SDK's default minimum gas price is set to "" (empty value) inside
 	// app.toml. If left empty by validators, the node will halt on startup.
-	// However, the chain developer can set a default app.toml value for their
-	// validators here.
-	//
-	// In summary:
-	// - if you leave srvCfg.MinGasPrices = "", all validators MUST tweak their
-	//   own app.toml config,
-	// - if you set srvCfg.MinGasPrices non-empty, validators CAN tweak their
-	//   own app.toml to override, or use this default value.
-	//
-	// In tests, we set the min gas prices to 0.
-	// srvCfg.MinGasPrices = "0stake"
+	// The default matches the chain-wide minimum gas prices of the optio
+	// params, which nodes cannot undercut. Validators CAN raise it in their
+	// own app.toml.
+	srvCfg.MinGasPrices = optiotypes.DefaultMinimumGasPrices.String()
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:        *srvCfg,
+		PacketForward: app.DefaultPacketForwardConfig(),
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + app.PacketForwardConfigTemplate
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
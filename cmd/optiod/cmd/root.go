@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/client/v2/autocli"
+	autocliflag "cosmossdk.io/client/v2/autocli/flag"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -19,8 +20,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/OptioServices/optio/app"
+	optiocli "github.com/OptioServices/optio/x/optio/client/cli"
 )
 
 // NewRootCmd creates a new root command for optiod. It is called once in the main function.
@@ -94,13 +98,36 @@ func NewRootCmd() *cobra.Command {
 		flags.FlagKeyringBackend: "test",
 	})
 
-	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
+	if err := autoCliOpts.EnhanceRootCommandWithBuilder(rootCmd, newAutoCLIBuilder(autoCliOpts)); err != nil {
 		panic(err)
 	}
+	optiocli.SplitListArgs(rootCmd)
 
 	return rootCmd
 }
 
+// newAutoCLIBuilder mirrors autocli.AppOptions.EnhanceRootCommand and adds
+// the optio flag types.
+func newAutoCLIBuilder(autoCliOpts autocli.AppOptions) *autocli.Builder {
+	builder := &autocli.Builder{
+		Builder: autocliflag.Builder{
+			TypeResolver:          protoregistry.GlobalTypes,
+			FileResolver:          autoCliOpts.ClientCtx.InterfaceRegistry,
+			AddressCodec:          autoCliOpts.AddressCodec,
+			ValidatorAddressCodec: autoCliOpts.ValidatorAddressCodec,
+			ConsensusAddressCodec: autoCliOpts.ConsensusAddressCodec,
+		},
+		GetClientConn: func(cmd *cobra.Command) (grpc.ClientConnInterface, error) {
+			return client.GetClientQueryContext(cmd)
+		},
+		AddQueryConnFlags: flags.AddQueryFlagsToCmd,
+		AddTxConnFlags:    flags.AddTxFlagsToCmd,
+	}
+	optiocli.RegisterFlagTypes(&builder.Builder)
+
+	return builder
+}
+
 func overwriteFlagDefaults(c *cobra.Command, defaults map[string]string) {
 	set := func(s *pflag.FlagSet, key, val string) {
 		if f := s.Lookup(key); f != nil {
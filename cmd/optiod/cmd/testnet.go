@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
 	tmos "github.com/cometbft/cometbft/libs/os"
@@ -210,6 +211,20 @@ func initAppForTestnet(app *app.App, args valArgs) *app.App {
 		}
 	}
 
+	// UPGRADE
+	//
+
+	// Schedule the requested upgrade a few blocks ahead
+	if args.upgradeToTrigger != "" {
+		upgradePlan := upgradetypes.Plan{
+			Name:   args.upgradeToTrigger,
+			Height: app.LastBlockHeight() + 10,
+		}
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradePlan); err != nil {
+			tmos.Exit(err.Error())
+		}
+	}
+
 	return app
 }
 
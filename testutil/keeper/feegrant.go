@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeegrantKeeper is a minimal in-memory feegrant keeper used by keeper unit
+// tests.
+type FeegrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+}
+
+func NewFeegrantKeeper() *FeegrantKeeper {
+	return &FeegrantKeeper{allowances: make(map[string]feegrant.FeeAllowanceI)}
+}
+
+func (f *FeegrantKeeper) GrantAllowance(_ context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	key := granter.String() + "/" + grantee.String()
+	if _, ok := f.allowances[key]; ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
+	}
+	f.allowances[key] = feeAllowance
+	return nil
+}
+
+func (f *FeegrantKeeper) GetAllowance(_ context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, ok := f.allowances[granter.String()+"/"+grantee.String()]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+	return allowance, nil
+}
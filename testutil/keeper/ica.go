@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAControllerKeeper is a minimal interchain accounts controller keeper used
+// by keeper unit tests. Registered accounts are opened right away with the
+// host address of the owner on channel-<n> and packets are assigned increasing
+// sequences per channel.
+type ICAControllerKeeper struct {
+	accounts  map[string]string
+	channels  map[string]string
+	sequences map[string]uint64
+	Sent      []icatypes.InterchainAccountPacketData
+}
+
+func NewICAControllerKeeper() *ICAControllerKeeper {
+	return &ICAControllerKeeper{
+		accounts:  make(map[string]string),
+		channels:  make(map[string]string),
+		sequences: make(map[string]uint64),
+	}
+}
+
+// Open opens the interchain account of a port on a connection.
+func (k *ICAControllerKeeper) Open(connectionID, portID, channelID, address string) {
+	k.accounts[connectionID+portID] = address
+	k.channels[connectionID+portID] = channelID
+}
+
+func (k *ICAControllerKeeper) RegisterInterchainAccount(_ sdk.Context, connectionID, owner, _ string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+	if _, found := k.channels[connectionID+portID]; found {
+		return icatypes.ErrInvalidChannelFlow
+	}
+	k.Open(connectionID, portID, fmt.Sprintf("channel-%d", len(k.channels)), "host1"+owner)
+	return nil
+}
+
+func (k *ICAControllerKeeper) GetInterchainAccountAddress(_ sdk.Context, connectionID, portID string) (string, bool) {
+	address, found := k.accounts[connectionID+portID]
+	return address, found
+}
+
+func (k *ICAControllerKeeper) GetActiveChannelID(_ sdk.Context, connectionID, portID string) (string, bool) {
+	channelID, found := k.channels[connectionID+portID]
+	return channelID, found
+}
+
+func (k *ICAControllerKeeper) SendTx(_ sdk.Context, _ *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	channelID, found := k.channels[connectionID+portID]
+	if !found {
+		return 0, icatypes.ErrActiveChannelNotFound
+	}
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	k.sequences[channelID]++
+	k.Sent = append(k.Sent, icaPacketData)
+	return k.sequences[channelID], nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func OptioKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx, _ := OptioKeeperWithBank(t)
+	return k, ctx
+}
+
+// OptioKeeperWithBank returns an optio keeper backed by an in-memory bank
+// keeper so that minting and transfers can be asserted on.
+func OptioKeeperWithBank(t testing.TB) (keeper.Keeper, sdk.Context, *BankKeeper) {
+	k, ctx, bankKeeper, _ := OptioKeeperWithFeegrant(t)
+	return k, ctx, bankKeeper
+}
+
+// OptioKeeperWithFeegrant additionally returns the in-memory feegrant keeper
+// so that granted fee allowances can be asserted on.
+func OptioKeeperWithFeegrant(t testing.TB) (keeper.Keeper, sdk.Context, *BankKeeper, *FeegrantKeeper) {
+	k, ctx, bankKeeper, feegrantKeeper, _, _ := newOptioKeeper(t)
+	return k, ctx, bankKeeper, feegrantKeeper
+}
+
+// OptioKeeperWithTransfer additionally returns the in-memory IBC transfer
+// keeper so that cross-chain distributions can be asserted on.
+func OptioKeeperWithTransfer(t testing.TB) (keeper.Keeper, sdk.Context, *BankKeeper, *TransferKeeper) {
+	k, ctx, bankKeeper, _, transferKeeper, _ := newOptioKeeper(t)
+	return k, ctx, bankKeeper, transferKeeper
+}
+
+// OptioKeeperWithICA returns the in-memory interchain accounts controller
+// keeper so that remote treasuries can be asserted on.
+func OptioKeeperWithICA(t testing.TB) (keeper.Keeper, sdk.Context, *ICAControllerKeeper) {
+	k, ctx, _, _, _, icaKeeper := newOptioKeeper(t)
+	return k, ctx, icaKeeper
+}
+
+func newOptioKeeper(t testing.TB) (keeper.Keeper, sdk.Context, *BankKeeper, *FeegrantKeeper, *TransferKeeper, *ICAControllerKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bankKeeper := NewBankKeeper()
+	feegrantKeeper := NewFeegrantKeeper()
+	transferKeeper := NewTransferKeeper(bankKeeper)
+	icaKeeper := NewICAControllerKeeper()
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		bankKeeper,
+		feegrantKeeper,
+		func() types.TransferKeeper { return transferKeeper },
+		nil,
+		nil,
+		func() types.ICAControllerKeeper { return icaKeeper },
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, ctx, bankKeeper, feegrantKeeper, transferKeeper, icaKeeper
+}
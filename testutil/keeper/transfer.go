@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// TransferKeeper is a minimal ICS-20 transfer keeper used by keeper unit
+// tests. Transfers escrow the tokens in the escrow account of their channel
+// and are assigned increasing sequences per channel.
+type TransferKeeper struct {
+	bank      *BankKeeper
+	sequences map[string]uint64
+	Sent      []*transfertypes.MsgTransfer
+}
+
+func NewTransferKeeper(bank *BankKeeper) *TransferKeeper {
+	return &TransferKeeper{bank: bank, sequences: make(map[string]uint64)}
+}
+
+func (t *TransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.bank.send(sender, transfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel), sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	t.sequences[msg.SourceChannel]++
+	t.Sent = append(t.Sent, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: t.sequences[msg.SourceChannel]}, nil
+}
+
+// Refund returns the escrowed tokens of a transfer to its sender, as the
+// transfer module does on a timeout or an error acknowledgement.
+func (t *TransferKeeper) Refund(msg *transfertypes.MsgTransfer) error {
+	return t.bank.send(transfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel), sdk.MustAccAddressFromBech32(msg.Sender), sdk.NewCoins(msg.Token))
+}
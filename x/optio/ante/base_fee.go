@@ -0,0 +1,47 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// BaseFeeDecorator requires txs to pay the base fee in the module denom for
+// their gas limit, and burns the BaseFeeBurnRate share of it. Unlike minimum
+// gas prices, the base fee applies in blocks as well as to the mempool.
+// Simulations, genesis txs and fee exempt txs do not pay it. It must run
+// after the fee deduction, which moves the fee to the fee collector.
+type BaseFeeDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewBaseFeeDecorator returns a BaseFeeDecorator.
+func NewBaseFeeDecorator(k keeper.Keeper) BaseFeeDecorator {
+	return BaseFeeDecorator{keeper: k}
+}
+
+func (d BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params := d.keeper.GetParams(ctx)
+	if !params.BaseFeeEnabled() || simulate || ctx.BlockHeight() == 0 || isFeeExempt(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	required := types.RequiredBaseFee(d.keeper.GetBaseFee(ctx), feeTx.GetGas())
+	if paid := feeTx.GetFee().AmountOf(params.Denom); paid.LT(required) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee,
+			"insufficient fee; got: %s%s required base fee: %s%s", paid, params.Denom, required, params.Denom)
+	}
+
+	if _, err := d.keeper.BurnBaseFee(ctx, required); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,65 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// feeExemptKey marks the context of a fee exempt tx.
+type feeExemptKey struct{}
+
+// isFeeExempt reports whether the FeeExemptionDecorator exempted the tx.
+func isFeeExempt(ctx sdk.Context) bool {
+	exempt, _ := ctx.Value(feeExemptKey{}).(bool)
+	return exempt
+}
+
+// FeeExemptionDecorator exempts txs containing only optio messages signed by
+// authorized accounts from minimum gas prices and the base fee, within the
+// block gas budget and the per-account window set in the params. It must run
+// before the GlobalFeeDecorator, so that the chain-wide prices are lifted as
+// well, and before the fee deduction, which then accepts any fee the tx
+// carries. Txs that are not exempt pass through unchanged and pay the usual
+// fees.
+type FeeExemptionDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewFeeExemptionDecorator returns a FeeExemptionDecorator.
+func NewFeeExemptionDecorator(k keeper.Keeper) FeeExemptionDecorator {
+	return FeeExemptionDecorator{keeper: k}
+}
+
+func (d FeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+
+	if !types.OnlyOptioMsgs(tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	signerBytes, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	signers := make([]sdk.AccAddress, len(signerBytes))
+	for i, signer := range signerBytes {
+		signers[i] = signer
+	}
+
+	if d.keeper.UseFeeExemption(ctx, signers, feeTx.GetGas()) {
+		ctx = ctx.WithMinGasPrices(sdk.DecCoins{}).WithValue(feeExemptKey{}, true)
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,52 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GlobalFeeDecorator enforces the chain-wide minimum gas prices of the params.
+// When a tx is checked for the mempool, they apply on top of the minimum gas
+// prices of the node, which the fee deduction checks. In blocks, where the
+// fee deduction checks no prices, the decorator requires the fee itself.
+// Simulations, genesis txs and fee exempt txs do not pay them. It must run
+// after the FeeExemptionDecorator and before the fee deduction.
+type GlobalFeeDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewGlobalFeeDecorator returns a GlobalFeeDecorator.
+func NewGlobalFeeDecorator(k keeper.Keeper) GlobalFeeDecorator {
+	return GlobalFeeDecorator{keeper: k}
+}
+
+func (d GlobalFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate || ctx.BlockHeight() == 0 || isFeeExempt(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	global := d.keeper.GetParams(ctx).MinimumGasPrices
+	if ctx.IsCheckTx() {
+		ctx = ctx.WithMinGasPrices(types.CombineMinGasPrices(global, ctx.MinGasPrices()))
+		return next(ctx, tx, simulate)
+	}
+
+	if global.Empty() {
+		return next(ctx, tx, simulate)
+	}
+	required := types.RequiredFees(global, feeTx.GetGas())
+	if !feeTx.GetFee().IsAnyGTE(required) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee,
+			"insufficient fee; got: %s required: %s", feeTx.GetFee(), required)
+	}
+	return next(ctx, tx, simulate)
+}
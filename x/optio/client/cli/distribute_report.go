@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// DistributeReport summarizes a simulated distribute-file run.
+type DistributeReport struct {
+	File           string              `json:"file" yaml:"file"`
+	FileHash       string              `json:"file_hash" yaml:"file_hash"`
+	Distributor    string              `json:"distributor" yaml:"distributor"`
+	Recipients     int                 `json:"recipients" yaml:"recipients"`
+	ChunkSize      uint64              `json:"chunk_size" yaml:"chunk_size"`
+	Total          sdk.Coin            `json:"total" yaml:"total"`
+	EstimatedGas   uint64              `json:"estimated_gas" yaml:"estimated_gas"`
+	EstimatedFees  sdk.Coins           `json:"estimated_fees" yaml:"estimated_fees"`
+	Supply         math.Int            `json:"supply" yaml:"supply"`
+	MaxSupply      math.Int            `json:"max_supply" yaml:"max_supply"`
+	HeadroomBefore math.Int            `json:"headroom_before" yaml:"headroom_before"`
+	HeadroomAfter  math.Int            `json:"headroom_after" yaml:"headroom_after"`
+	QuotaUsage     string              `json:"quota_usage" yaml:"quota_usage"`
+	Rejected       []RejectedRecipient `json:"rejected" yaml:"rejected"`
+	Chunks         []ChunkReport       `json:"chunks" yaml:"chunks"`
+	OK             bool                `json:"ok" yaml:"ok"`
+}
+
+// RejectedRecipient is a recipient the compliance policy would reject.
+type RejectedRecipient struct {
+	Address string `json:"address" yaml:"address"`
+	Reason  string `json:"reason" yaml:"reason"`
+}
+
+// ChunkReport is the simulation result of a single MsgDistribute.
+type ChunkReport struct {
+	Chunk      int       `json:"chunk" yaml:"chunk"`
+	Recipients int       `json:"recipients" yaml:"recipients"`
+	Amount     math.Int  `json:"amount" yaml:"amount"`
+	Gas        uint64    `json:"gas" yaml:"gas"`
+	Fees       sdk.Coins `json:"fees" yaml:"fees"`
+	Error      string    `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// dryRun simulates every chunk of a payout against the node without
+// signing anything and builds the report approvers sign off on. Chunks are
+// simulated independently against the current state, supply headroom is
+// therefore computed for the whole run.
+func dryRun(
+	ctx context.Context,
+	clientCtx client.Context,
+	txf tx.Factory,
+	params types.Params,
+	recipients []*types.Recipient,
+	chunkSize uint64,
+	category, memo string,
+) (*DistributeReport, error) {
+	supplyRes, err := banktypes.NewQueryClient(clientCtx).SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: params.Denom})
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := queryAddressStatuses(ctx, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DistributeReport{
+		Distributor:   clientCtx.FromAddress.String(),
+		Recipients:    len(recipients),
+		ChunkSize:     chunkSize,
+		EstimatedFees: sdk.NewCoins(),
+		Supply:        supplyRes.Amount.Amount,
+		MaxSupply:     params.MaxSupply,
+		Rejected:      rejectedRecipients(params, statuses, recipients),
+		OK:            true,
+	}
+
+	total := math.ZeroInt()
+	for i, chunk := range ChunkRecipients(recipients, chunkSize) {
+		amount := chunkTotal(chunk)
+		total = total.Add(amount)
+
+		msg := types.NewMsgDistribute(report.Distributor, amount, chunk)
+		msg.Category = category
+		msg.Memo = memo
+
+		chunkReport := ChunkReport{Chunk: i + 1, Recipients: len(chunk), Amount: amount}
+		if _, gas, err := tx.CalculateGas(clientCtx, txf, msg); err != nil {
+			chunkReport.Error = err.Error()
+			report.OK = false
+		} else {
+			chunkReport.Gas = gas
+			chunkReport.Fees = estimateFees(txf, gas)
+			report.EstimatedGas += gas
+			report.EstimatedFees = report.EstimatedFees.Add(chunkReport.Fees...)
+		}
+		report.Chunks = append(report.Chunks, chunkReport)
+	}
+
+	report.Total = sdk.NewCoin(params.Denom, total)
+	report.HeadroomBefore = params.MaxSupply.Sub(report.Supply)
+	report.HeadroomAfter = report.HeadroomBefore.Sub(total)
+	report.QuotaUsage = quotaUsage(total, report.HeadroomBefore)
+	if report.HeadroomAfter.IsNegative() || len(report.Rejected) > 0 {
+		report.OK = false
+	}
+	return report, nil
+}
+
+// queryAddressStatuses returns the compliance status of every address that
+// has one.
+func queryAddressStatuses(ctx context.Context, clientCtx client.Context) (map[string]types.ComplianceStatus, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	statuses := make(map[string]types.ComplianceStatus)
+	pageReq := &query.PageRequest{Limit: query.PaginationMaxLimit}
+	for {
+		res, err := queryClient.AddressStatusAll(ctx, &types.QueryAllAddressStatusRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		for _, status := range res.AddressStatus {
+			statuses[status.Address] = status.Status
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return statuses, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: query.PaginationMaxLimit}
+	}
+}
+
+func rejectedRecipients(params types.Params, statuses map[string]types.ComplianceStatus, recipients []*types.Recipient) []RejectedRecipient {
+	var rejected []RejectedRecipient
+	for _, recipient := range recipients {
+		if reason := params.RejectionReason(statuses[recipient.Account()]); reason != "" {
+			rejected = append(rejected, RejectedRecipient{Address: recipient.Account(), Reason: reason})
+		}
+	}
+	return rejected
+}
+
+// estimateFees returns the fees of a tx using gas, preferring explicit
+// --fees over --gas-prices.
+func estimateFees(txf tx.Factory, gas uint64) sdk.Coins {
+	if !txf.Fees().IsZero() {
+		return txf.Fees()
+	}
+	fees := sdk.NewCoins()
+	limit := math.LegacyNewDec(int64(gas))
+	for _, price := range txf.GasPrices() {
+		fees = fees.Add(sdk.NewCoin(price.Denom, price.Amount.Mul(limit).Ceil().RoundInt()))
+	}
+	return fees
+}
+
+// quotaUsage returns the share of the remaining supply headroom used by
+// amount.
+func quotaUsage(amount, headroom math.Int) string {
+	if !headroom.IsPositive() {
+		return "n/a"
+	}
+	bps := amount.MulRaw(10000).Quo(headroom)
+	return fmt.Sprintf("%s.%02d%%", bps.QuoRaw(100), bps.ModRaw(100).Int64())
+}
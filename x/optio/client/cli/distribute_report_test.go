@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestRejectedRecipients(t *testing.T) {
+	allowed, denied, unknown := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	statuses := map[string]types.ComplianceStatus{
+		allowed: types.COMPLIANCE_STATUS_ALLOWED,
+		denied:  types.COMPLIANCE_STATUS_DENIED,
+	}
+	recipients := []*types.Recipient{{Address: allowed}, {Address: denied}, {Address: unknown}}
+
+	params := types.DefaultParams()
+	require.Equal(t, []RejectedRecipient{{Address: denied, Reason: types.RejectedDenied}}, rejectedRecipients(params, statuses, recipients))
+
+	params.AllowlistEnabled = true
+	require.Equal(t, []RejectedRecipient{
+		{Address: denied, Reason: types.RejectedDenied},
+		{Address: unknown, Reason: types.RejectedNotAllowlisted},
+	}, rejectedRecipients(params, statuses, recipients))
+}
+
+func TestEstimateFees(t *testing.T) {
+	txf := tx.Factory{}.WithGasPrices("0.025uOPT")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 2501)), estimateFees(txf, 100_001))
+
+	txf = txf.WithFees("10uOPT")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 10)), estimateFees(txf, 100_001))
+}
+
+func TestQuotaUsage(t *testing.T) {
+	require.Equal(t, "12.50%", quotaUsage(math.NewInt(125), math.NewInt(1000)))
+	require.Equal(t, "0.00%", quotaUsage(math.NewInt(1), math.NewInt(1_000_000)))
+	require.Equal(t, "n/a", quotaUsage(math.NewInt(1), math.ZeroInt()))
+}
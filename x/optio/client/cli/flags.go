@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	modulev1 "github.com/OptioServices/optio/api/optio/optio"
+)
+
+// RegisterFlagTypes registers the optio specific autocli flag types, so that
+// recipients, address statuses and remote outputs can be given as colon separated values
+// instead of JSON.
+func RegisterFlagTypes(b *flag.Builder) {
+	b.DefineMessageFlagType((&modulev1.Recipient{}).ProtoReflect().Descriptor().FullName(), recipientType{})
+	b.DefineMessageFlagType((&modulev1.AddressStatus{}).ProtoReflect().Descriptor().FullName(), addressStatusType{})
+	b.DefineMessageFlagType((&bankv1beta1.Output{}).ProtoReflect().Descriptor().FullName(), outputType{})
+}
+
+// SplitListArgs wraps the autocli generated optio tx commands so that their
+// repeated positional arguments also accept comma separated values.
+func SplitListArgs(rootCmd *cobra.Command) {
+	for use, first := range map[string]int{"distribute": 1, "send-distribution": 2, "set-address-status": 0, "remote-distribute": 1} {
+		cmd, _, err := rootCmd.Find([]string{"tx", "optio", use})
+		if err != nil || cmd.Name() != use || cmd.RunE == nil {
+			continue
+		}
+		runE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return runE(cmd, splitArgs(args, first))
+		}
+	}
+}
+
+func splitArgs(args []string, first int) []string {
+	if len(args) <= first {
+		return args
+	}
+	out := append([]string{}, args[:first]...)
+	for _, arg := range args[first:] {
+		for _, s := range strings.Split(arg, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+type recipientType struct{}
+
+func (recipientType) NewValue(*context.Context, *flag.Builder) flag.Value {
+	return &recipientValue{}
+}
+
+func (recipientType) DefaultValue() string { return "" }
+
+type recipientValue struct {
+	value *modulev1.Recipient
+}
+
+func (r *recipientValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if r.value == nil {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfMessage(r.value.ProtoReflect()), nil
+}
+
+func (r *recipientValue) String() string {
+	if r.value == nil {
+		return ""
+	}
+	if r.value.SourceChannel != "" {
+		return r.value.SourceChannel + "/" + r.value.Receiver + ":" + r.value.Amount
+	}
+	return r.value.Address + ":" + r.value.Amount
+}
+
+// Set parses address:amount[:reference], or channel/receiver:amount[:reference]
+// for a cross-chain recipient.
+func (r *recipientValue) Set(s string) error {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return fmt.Errorf("invalid recipient %q, expected address:amount[:reference]", s)
+	}
+	amount, ok := math.NewIntFromString(parts[1])
+	if !ok || !amount.IsPositive() {
+		return fmt.Errorf("invalid recipient amount %q", parts[1])
+	}
+	r.value = &modulev1.Recipient{Address: parts[0], Amount: amount.String()}
+	if channel, receiver, found := strings.Cut(parts[0], "/"); found {
+		if channel == "" || receiver == "" {
+			return fmt.Errorf("invalid cross-chain recipient %q, expected channel/receiver", parts[0])
+		}
+		r.value = &modulev1.Recipient{SourceChannel: channel, Receiver: receiver, Amount: amount.String()}
+	}
+	if len(parts) == 3 {
+		r.value.Reference = parts[2]
+	}
+	return nil
+}
+
+func (r *recipientValue) Type() string { return "address:amount" }
+
+type addressStatusType struct{}
+
+func (addressStatusType) NewValue(*context.Context, *flag.Builder) flag.Value {
+	return &addressStatusValue{}
+}
+
+func (addressStatusType) DefaultValue() string { return "" }
+
+type addressStatusValue struct {
+	value *modulev1.AddressStatus
+}
+
+func (a *addressStatusValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if a.value == nil {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfMessage(a.value.ProtoReflect()), nil
+}
+
+func (a *addressStatusValue) String() string {
+	if a.value == nil {
+		return ""
+	}
+	return a.value.Address + ":" + a.value.Status.String()
+}
+
+// Set parses address:status where status is allowed, denied or unspecified.
+func (a *addressStatusValue) Set(s string) error {
+	address, status, ok := strings.Cut(s, ":")
+	if !ok || address == "" {
+		return fmt.Errorf("invalid address status %q, expected address:status", s)
+	}
+	name := strings.ToUpper(status)
+	if !strings.HasPrefix(name, "COMPLIANCE_STATUS_") {
+		name = "COMPLIANCE_STATUS_" + name
+	}
+	v, ok := modulev1.ComplianceStatus_value[name]
+	if !ok {
+		return fmt.Errorf("invalid compliance status %q", status)
+	}
+	a.value = &modulev1.AddressStatus{Address: address, Status: modulev1.ComplianceStatus(v)}
+	return nil
+}
+
+func (a *addressStatusValue) Type() string { return "address:status" }
+
+type outputType struct{}
+
+func (outputType) NewValue(*context.Context, *flag.Builder) flag.Value {
+	return &outputValue{}
+}
+
+func (outputType) DefaultValue() string { return "" }
+
+type outputValue struct {
+	value *bankv1beta1.Output
+}
+
+func (o *outputValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if o.value == nil {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfMessage(o.value.ProtoReflect()), nil
+}
+
+func (o *outputValue) String() string {
+	if o.value == nil || len(o.value.Coins) == 0 {
+		return ""
+	}
+	coin := o.value.Coins[0]
+	return o.value.Address + ":" + coin.Amount + coin.Denom
+}
+
+// Set parses address:coin, for instance cosmos1...:100uatom. An output of
+// several denoms is given as several outputs for the same address.
+func (o *outputValue) Set(s string) error {
+	address, coinStr, ok := strings.Cut(s, ":")
+	if !ok || address == "" {
+		return fmt.Errorf("invalid output %q, expected address:coin", s)
+	}
+	coin, err := sdk.ParseCoinNormalized(coinStr)
+	if err != nil || !coin.IsPositive() {
+		return fmt.Errorf("invalid output coin %q", coinStr)
+	}
+	o.value = &bankv1beta1.Output{
+		Address: address,
+		Coins:   []*basev1beta1.Coin{{Denom: coin.Denom, Amount: coin.Amount.String()}},
+	}
+	return nil
+}
+
+func (o *outputValue) Type() string { return "address:coin" }
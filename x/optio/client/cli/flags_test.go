@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	modulev1 "github.com/OptioServices/optio/api/optio/optio"
+)
+
+func TestSplitArgs(t *testing.T) {
+	require.Equal(t, []string{"300", "a:100", "b:200", "c:1"}, splitArgs([]string{"300", "a:100,b:200", "c:1"}, 1))
+	require.Equal(t, []string{"a:denied", "b:allowed"}, splitArgs([]string{"a:denied, b:allowed"}, 0))
+}
+
+func TestRecipientValue(t *testing.T) {
+	var v recipientValue
+	require.NoError(t, v.Set("optio1abc:100:INV-1"))
+	require.Equal(t, "optio1abc", v.value.Address)
+	require.Equal(t, "100", v.value.Amount)
+	require.Equal(t, "INV-1", v.value.Reference)
+
+	require.NoError(t, v.Set("channel-0/cosmos1abc:50"))
+	require.Empty(t, v.value.Address)
+	require.Equal(t, "channel-0", v.value.SourceChannel)
+	require.Equal(t, "cosmos1abc", v.value.Receiver)
+	require.Equal(t, "channel-0/cosmos1abc:50", v.String())
+
+	require.Error(t, v.Set("channel-0/:50"))
+	require.Error(t, v.Set("optio1abc"))
+	require.Error(t, v.Set("optio1abc:-1"))
+	require.Error(t, v.Set(":10"))
+}
+
+func TestOutputValue(t *testing.T) {
+	var v outputValue
+	require.NoError(t, v.Set("cosmos1abc:100uatom"))
+	require.Equal(t, "cosmos1abc", v.value.Address)
+	require.Equal(t, "uatom", v.value.Coins[0].Denom)
+	require.Equal(t, "100", v.value.Coins[0].Amount)
+	require.Equal(t, "cosmos1abc:100uatom", v.String())
+
+	require.Error(t, v.Set("cosmos1abc"))
+	require.Error(t, v.Set("cosmos1abc:0uatom"))
+	require.Error(t, v.Set(":100uatom"))
+}
+
+func TestAddressStatusValue(t *testing.T) {
+	var v addressStatusValue
+	require.NoError(t, v.Set("optio1abc:denied"))
+	require.Equal(t, modulev1.ComplianceStatus_COMPLIANCE_STATUS_DENIED, v.value.Status)
+	require.NoError(t, v.Set("optio1abc:COMPLIANCE_STATUS_ALLOWED"))
+	require.Equal(t, modulev1.ComplianceStatus_COMPLIANCE_STATUS_ALLOWED, v.value.Status)
+	require.Error(t, v.Set("optio1abc:blocked"))
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/OptioServices/optio/x/optio/migrations/v2"
+)
+
+// CmdMigrateGenesis returns a command that rewrites a v1 optio genesis state,
+// which used uint64 amounts, into the current math.Int based format.
+func CmdMigrateGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-optio [optio-genesis-file]",
+		Short: "Migrate a v1 optio module genesis state to the current format",
+		Long: `Migrate a v1 optio module genesis state to the current format.
+The input file contains only the optio section of app_state, the migrated
+section is printed to stdout.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+
+			genState, err := v2.MigrateGenesis(bz)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(genState)
+		},
+	}
+
+	return cmd
+}
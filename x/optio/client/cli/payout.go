@@ -0,0 +1,294 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// Payout is a single row of a payout file.
+type Payout struct {
+	Address   string      `json:"address"`
+	Amount    json.Number `json:"amount"`
+	Reference string      `json:"reference,omitempty"`
+}
+
+// ReadPayoutFile reads and validates a CSV or JSON payout file. It returns
+// the recipients in file order and the hex encoded sha256 of the file, which
+// ties a progress journal to its input.
+func ReadPayoutFile(path string, params types.Params) ([]*types.Recipient, string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(bz)
+
+	var payouts []Payout
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		payouts, err = parsePayoutJSON(bz)
+	} else {
+		payouts, err = parsePayoutCSV(bz)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	recipients, err := validatePayouts(payouts, params)
+	if err != nil {
+		return nil, "", err
+	}
+	return recipients, hex.EncodeToString(sum[:]), nil
+}
+
+// parsePayoutCSV parses address,amount[,reference] rows. A first row starting
+// with "address" is treated as a header.
+func parsePayoutCSV(bz []byte) ([]Payout, error) {
+	r := csv.NewReader(bytes.NewReader(bz))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var payouts []Payout
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if len(payouts) == 0 && line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected address,amount[,reference]", line)
+		}
+		p := Payout{Address: strings.TrimSpace(record[0]), Amount: json.Number(strings.TrimSpace(record[1]))}
+		if len(record) == 3 {
+			p.Reference = strings.TrimSpace(record[2])
+		}
+		payouts = append(payouts, p)
+	}
+	return payouts, nil
+}
+
+func parsePayoutJSON(bz []byte) ([]Payout, error) {
+	var payouts []Payout
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&payouts); err != nil {
+		return nil, fmt.Errorf("invalid payout file: %w", err)
+	}
+	return payouts, nil
+}
+
+// validatePayouts checks addresses, amounts and references and rejects
+// addresses listed more than once, which are almost always a mistake in a
+// payout run.
+func validatePayouts(payouts []Payout, params types.Params) ([]*types.Recipient, error) {
+	if len(payouts) == 0 {
+		return nil, errors.New("payout file has no recipients")
+	}
+
+	var errs []error
+	seen := make(map[string]int, len(payouts))
+	recipients := make([]*types.Recipient, 0, len(payouts))
+	for i, p := range payouts {
+		row := i + 1
+		if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+			errs = append(errs, fmt.Errorf("row %d: invalid address %q: %w", row, p.Address, err))
+			continue
+		}
+		if prev, ok := seen[p.Address]; ok {
+			errs = append(errs, fmt.Errorf("row %d: duplicate address %s, first seen in row %d", row, p.Address, prev))
+			continue
+		}
+		seen[p.Address] = row
+
+		amount, ok := math.NewIntFromString(p.Amount.String())
+		if !ok || !amount.IsPositive() {
+			errs = append(errs, fmt.Errorf("row %d: invalid amount %q", row, p.Amount))
+			continue
+		}
+		if params.MaxReferenceLength != 0 && uint64(len(p.Reference)) > params.MaxReferenceLength {
+			errs = append(errs, fmt.Errorf("row %d: reference longer than %d", row, params.MaxReferenceLength))
+			continue
+		}
+		recipients = append(recipients, &types.Recipient{Address: p.Address, Amount: amount, Reference: p.Reference})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return recipients, nil
+}
+
+// ChunkSize returns the number of recipients per MsgDistribute. requested is
+// an upper bound chosen by the user, zero meaning as large as allowed. The
+// result honours Params.MaxRecipientsPerMsg and keeps the per-recipient gas
+// charge of a chunk below the block gas limit, maxBlockGas <= 0 meaning no
+// limit.
+func ChunkSize(requested uint64, params types.Params, maxBlockGas int64) (uint64, error) {
+	size := requested
+	limit := func(n uint64) {
+		if size == 0 || n < size {
+			size = n
+		}
+	}
+	if params.MaxRecipientsPerMsg != 0 {
+		limit(params.MaxRecipientsPerMsg)
+	}
+	if maxBlockGas > 0 && params.GasPerRecipient != 0 {
+		n := uint64(maxBlockGas) / params.GasPerRecipient
+		if n == 0 {
+			return 0, errors.New("gas per recipient exceeds the block gas limit")
+		}
+		limit(n)
+	}
+	return size, nil
+}
+
+// ChunkRecipients splits recipients into consecutive chunks of at most size
+// recipients. A size of zero yields a single chunk.
+func ChunkRecipients(recipients []*types.Recipient, size uint64) [][]*types.Recipient {
+	if size == 0 || uint64(len(recipients)) <= size {
+		return [][]*types.Recipient{recipients}
+	}
+	chunks := make([][]*types.Recipient, 0, (uint64(len(recipients))+size-1)/size)
+	for uint64(len(recipients)) > size {
+		chunks = append(chunks, recipients[:size])
+		recipients = recipients[size:]
+	}
+	return append(chunks, recipients)
+}
+
+// Journal statuses of a chunk.
+const (
+	ChunkPending = "pending"
+	ChunkDone    = "done"
+	ChunkFailed  = "failed"
+)
+
+// JournalHeader identifies the payout run a journal belongs to.
+type JournalHeader struct {
+	FileHash  string `json:"file_hash"`
+	ChunkSize uint64 `json:"chunk_size"`
+	Chunks    int    `json:"chunks"`
+}
+
+// JournalEntry records the progress of a chunk. A pending entry is written
+// before its tx is broadcast, so a crash can always be resolved by looking
+// up the tx hash or the account sequence.
+type JournalEntry struct {
+	Chunk    int    `json:"chunk"`
+	Status   string `json:"status"`
+	Sequence uint64 `json:"sequence"`
+	TxHash   string `json:"txhash,omitempty"`
+	Height   int64  `json:"height,omitempty"`
+	Code     uint32 `json:"code,omitempty"`
+	Log      string `json:"log,omitempty"`
+}
+
+// Journal is an append-only JSON lines file recording the progress of a
+// distribute-file run. The first line is the JournalHeader, later lines are
+// JournalEntry values, the last one per chunk wins.
+type Journal struct {
+	Header  JournalHeader
+	file    *os.File
+	entries map[int]JournalEntry
+}
+
+// OpenJournal opens the journal at path, creating it with header if it does
+// not exist. An existing journal must have been written for the same file
+// and chunk size.
+func OpenJournal(path string, header JournalHeader) (*Journal, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	j := &Journal{file: f, entries: make(map[int]JournalEntry)}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 0; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		if line == 0 {
+			if err := json.Unmarshal(scanner.Bytes(), &j.Header); err != nil {
+				f.Close()
+				return nil, fmt.Errorf("invalid journal header: %w", err)
+			}
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// a torn final write can only belong to a tx that was never broadcast
+			break
+		}
+		j.entries[entry.Chunk] = entry
+	}
+	if err := scanner.Err(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if j.Header == (JournalHeader{}) {
+		j.Header = header
+		if err := j.write(header); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return j, nil
+	}
+	if j.Header != header {
+		f.Close()
+		return nil, fmt.Errorf("journal %s was written for a different payout file or chunk size", path)
+	}
+	return j, nil
+}
+
+// Entry returns the latest entry recorded for chunk.
+func (j *Journal) Entry(chunk int) (JournalEntry, bool) {
+	entry, ok := j.entries[chunk]
+	return entry, ok
+}
+
+// Record appends entry and syncs it to disk.
+func (j *Journal) Record(entry JournalEntry) error {
+	if err := j.write(entry); err != nil {
+		return err
+	}
+	j.entries[entry.Chunk] = entry
+	return nil
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
+
+func (j *Journal) write(v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadPayoutFile(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	params := types.DefaultParams()
+
+	csvPath := writeFile(t, "payouts.csv", "address,amount,reference\n"+alice+",100,INV-1\n"+bob+", 200\n")
+	recipients, hash, err := ReadPayoutFile(csvPath, params)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+	require.Equal(t, []*types.Recipient{
+		{Address: alice, Amount: math.NewInt(100), Reference: "INV-1"},
+		{Address: bob, Amount: math.NewInt(200)},
+	}, recipients)
+
+	jsonPath := writeFile(t, "payouts.json", `[{"address":"`+alice+`","amount":"100","reference":"INV-1"},{"address":"`+bob+`","amount":200}]`)
+	fromJSON, _, err := ReadPayoutFile(jsonPath, params)
+	require.NoError(t, err)
+	require.Equal(t, recipients, fromJSON)
+
+	for name, content := range map[string]string{
+		"duplicate": alice + ",1\n" + alice + ",2\n",
+		"address":   "optio1invalid,1\n",
+		"amount":    alice + ",-5\n",
+		"columns":   alice + "\n",
+		"empty":     "address,amount\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ReadPayoutFile(writeFile(t, "payouts.csv", content), params)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestChunkSize(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxRecipientsPerMsg = 100
+	params.GasPerRecipient = 1000
+
+	size, err := ChunkSize(0, params, -1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), size)
+
+	size, err = ChunkSize(10, params, -1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), size)
+
+	size, err = ChunkSize(0, params, 50_000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), size)
+
+	_, err = ChunkSize(0, params, 500)
+	require.Error(t, err)
+
+	params.MaxRecipientsPerMsg = 0
+	size, err = ChunkSize(0, params, -1)
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
+
+func TestChunkRecipients(t *testing.T) {
+	recipients := make([]*types.Recipient, 7)
+	require.Len(t, ChunkRecipients(recipients, 0), 1)
+	require.Len(t, ChunkRecipients(recipients, 7), 1)
+
+	chunks := ChunkRecipients(recipients, 3)
+	require.Len(t, chunks, 3)
+	require.Len(t, chunks[2], 1)
+}
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "payouts.journal")
+	header := JournalHeader{FileHash: "abc", ChunkSize: 10, Chunks: 2}
+
+	j, err := OpenJournal(path, header)
+	require.NoError(t, err)
+	require.NoError(t, j.Record(JournalEntry{Chunk: 0, Status: ChunkPending, Sequence: 4, TxHash: "AA"}))
+	require.NoError(t, j.Record(JournalEntry{Chunk: 0, Status: ChunkDone, Sequence: 4, TxHash: "AA", Height: 9}))
+	require.NoError(t, j.Record(JournalEntry{Chunk: 1, Status: ChunkPending, Sequence: 5, TxHash: "BB"}))
+	require.NoError(t, j.Close())
+
+	j, err = OpenJournal(path, header)
+	require.NoError(t, err)
+	entry, ok := j.Entry(0)
+	require.True(t, ok)
+	require.Equal(t, ChunkDone, entry.Status)
+	entry, ok = j.Entry(1)
+	require.True(t, ok)
+	require.Equal(t, ChunkPending, entry.Status)
+	_, ok = j.Entry(2)
+	require.False(t, ok)
+	require.NoError(t, j.Close())
+
+	_, err = OpenJournal(path, JournalHeader{FileHash: "def", ChunkSize: 10, Chunks: 2})
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GetTxCmd returns the hand written transaction commands for this module.
+// AutoCLI adds the generated Msg commands next to them.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdDistributeFile())
+	cmd.AddCommand(CmdProposeParams())
+	cmd.AddCommand(CmdGrantDistribute())
+	// this line is used by starport scaffolding # 1
+
+	return cmd
+}
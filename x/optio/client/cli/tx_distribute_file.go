@@ -0,0 +1,354 @@
+package cli
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/spf13/cobra"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+const (
+	FlagCategory       = "category"
+	FlagMemo           = "memo"
+	FlagJournal        = "journal"
+	FlagChunkSize      = "chunk-size"
+	FlagConfirmTimeout = "confirm-timeout"
+)
+
+// CmdDistributeFile distributes to every recipient of a CSV or JSON payout
+// file, split over as many MsgDistribute txs as the chain limits require.
+func CmdDistributeFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-file [payout-file]",
+		Short: "Distribute to all recipients of a CSV or JSON payout file",
+		Long: `Distribute to all recipients of a payout file, chunked into as many
+MsgDistribute transactions as max_recipients_per_msg and the block gas limit
+require. Transactions are signed and broadcast one after another and each is
+confirmed in a block before the next one is sent.
+
+CSV files contain address,amount[,reference] rows with an optional header.
+JSON files contain an array of {"address", "amount", "reference"} objects.
+
+Progress is written to a journal (default: <payout-file>.journal). Running the
+command again with the same journal resumes where the previous run stopped and
+never re-sends a chunk that may already have been included.
+
+With --dry-run nothing is signed or broadcast. Every chunk is simulated against
+the node and a report with the total amount, estimated gas and fees, supply
+headroom before and after the run and the recipients the compliance policy
+would reject is printed instead.`,
+		Example: "distribute-file payouts.csv --category payroll --gas auto --from distributor",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly {
+				return errors.New("distribute-file always broadcasts, use distribute to generate a single tx")
+			}
+
+			category, _ := cmd.Flags().GetString(FlagCategory)
+			memo, _ := cmd.Flags().GetString(FlagMemo)
+			journalPath, _ := cmd.Flags().GetString(FlagJournal)
+			requested, _ := cmd.Flags().GetUint64(FlagChunkSize)
+			timeout, _ := cmd.Flags().GetDuration(FlagConfirmTimeout)
+			if journalPath == "" {
+				journalPath = args[0] + ".journal"
+			}
+			if err := types.ValidateCategory(category); err != nil {
+				return err
+			}
+
+			paramsRes, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			params := paramsRes.Params
+
+			var maxBlockGas int64
+			consensusRes, err := consensustypes.NewQueryClient(clientCtx).Params(cmd.Context(), &consensustypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			if consensusRes.Params != nil && consensusRes.Params.Block != nil {
+				maxBlockGas = consensusRes.Params.Block.MaxGas
+			}
+
+			recipients, fileHash, err := ReadPayoutFile(args[0], params)
+			if err != nil {
+				return err
+			}
+			size, err := ChunkSize(requested, params, maxBlockGas)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Simulate {
+				txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+				if err != nil {
+					return err
+				}
+				txf, err = txf.Prepare(clientCtx)
+				if err != nil {
+					return err
+				}
+				report, err := dryRun(cmd.Context(), clientCtx, txf, params, recipients, size, category, memo)
+				if err != nil {
+					return err
+				}
+				report.File = args[0]
+				report.FileHash = fileHash
+				return clientCtx.PrintObjectLegacy(report)
+			}
+
+			journal, err := OpenJournal(journalPath, JournalHeader{FileHash: fileHash, ChunkSize: size, Chunks: len(ChunkRecipients(recipients, size))})
+			if err != nil {
+				return err
+			}
+			defer journal.Close()
+
+			d := &fileDistributor{
+				clientCtx: clientCtx,
+				journal:   journal,
+				category:  category,
+				memo:      memo,
+				timeout:   timeout,
+			}
+			return d.run(cmd, recipients)
+		},
+	}
+
+	cmd.Flags().String(FlagCategory, "", "Category recorded with every distribution")
+	cmd.Flags().String(FlagMemo, "", "Memo recorded with every distribution")
+	cmd.Flags().String(FlagJournal, "", "Progress journal used to resume an interrupted run (default <payout-file>.journal)")
+	cmd.Flags().Uint64(FlagChunkSize, 0, "Maximum recipients per tx, capped by the chain limits (default as many as allowed)")
+	cmd.Flags().Duration(FlagConfirmTimeout, time.Minute, "How long to wait for each tx to be included in a block")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+var errTxNotFound = errors.New("tx not found")
+
+// fileDistributor signs and broadcasts the chunks of a payout file in order.
+type fileDistributor struct {
+	clientCtx client.Context
+	journal   *Journal
+	category  string
+	memo      string
+	timeout   time.Duration
+}
+
+func (d *fileDistributor) run(cmd *cobra.Command, recipients []*types.Recipient) error {
+	chunks := ChunkRecipients(recipients, d.journal.Header.ChunkSize)
+
+	total, remaining, remainingChunks := math.ZeroInt(), math.ZeroInt(), 0
+	for i, chunk := range chunks {
+		sum := chunkTotal(chunk)
+		total = total.Add(sum)
+		if entry, ok := d.journal.Entry(i); !ok || entry.Status != ChunkDone {
+			remaining = remaining.Add(sum)
+			remainingChunks++
+		}
+	}
+	if remainingChunks == 0 {
+		return d.clientCtx.PrintString("all chunks already distributed\n")
+	}
+
+	if !d.clientCtx.SkipConfirm {
+		prompt := fmt.Sprintf("distribute %s of %s to %d recipients in %d of %d txs", remaining, total, len(recipients), remainingChunks, len(chunks))
+		ok, err := input.GetConfirmation(prompt, bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("cancelled")
+		}
+	}
+
+	txf, err := tx.NewFactoryCLI(d.clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	txf, err = txf.Prepare(d.clientCtx)
+	if err != nil {
+		return err
+	}
+	if txf.Memo() == "" {
+		txf = txf.WithMemo(fmt.Sprintf("distribute-file %s", d.journal.Header.FileHash[:16]))
+	}
+
+	for i, chunk := range chunks {
+		entry, ok := d.journal.Entry(i)
+		if ok && entry.Status == ChunkDone {
+			continue
+		}
+		if ok && entry.Status == ChunkPending {
+			done, err := d.resolvePending(entry)
+			if err != nil {
+				return err
+			}
+			if done {
+				continue
+			}
+		}
+
+		_, seq, err := d.clientCtx.AccountRetriever.GetAccountNumberSequence(d.clientCtx, d.clientCtx.FromAddress)
+		if err != nil {
+			return err
+		}
+		msg := types.NewMsgDistribute(d.clientCtx.FromAddress.String(), chunkTotal(chunk), chunk)
+		msg.Category = d.category
+		msg.Memo = d.memo
+		if err := d.send(cmd, txf.WithSequence(seq), i, len(chunks), msg); err != nil {
+			return err
+		}
+	}
+
+	return d.clientCtx.PrintString(fmt.Sprintf("distributed %s to %d recipients in %d txs\n", total, len(recipients), len(chunks)))
+}
+
+// send signs msg with txf, journals it as pending, broadcasts it and waits
+// for it to be included.
+func (d *fileDistributor) send(cmd *cobra.Command, txf tx.Factory, chunk, chunks int, msg *types.MsgDistribute) error {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(d.clientCtx, txf, msg)
+		if err != nil {
+			return fmt.Errorf("chunk %d: %w", chunk+1, err)
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txb, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(cmd.Context(), txf, d.clientCtx.FromName, txb, true); err != nil {
+		return err
+	}
+	txBytes, err := d.clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return err
+	}
+
+	entry := JournalEntry{
+		Chunk:    chunk,
+		Status:   ChunkPending,
+		Sequence: txf.Sequence(),
+		TxHash:   fmt.Sprintf("%X", sha256.Sum256(txBytes)),
+	}
+	if err := d.journal.Record(entry); err != nil {
+		return err
+	}
+
+	res, err := d.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("chunk %d: %w", chunk+1, err)
+	}
+	if res.Code != 0 {
+		// rejected by CheckTx, the sequence was not used
+		return d.fail(entry, res)
+	}
+	if err := d.clientCtx.PrintString(fmt.Sprintf("chunk %d/%d: broadcast %s\n", chunk+1, chunks, entry.TxHash)); err != nil {
+		return err
+	}
+
+	res, err = d.waitForTx(entry.TxHash, d.timeout)
+	if err != nil {
+		return fmt.Errorf("chunk %d: %w; run the command again to resume", chunk+1, err)
+	}
+	return d.complete(entry, res)
+}
+
+// resolvePending determines the outcome of a chunk whose tx may have been
+// broadcast by an interrupted run. It returns true if the chunk is done and
+// false if a new tx has to be sent for it.
+func (d *fileDistributor) resolvePending(entry JournalEntry) (bool, error) {
+	// the tx may still be in a mempool, so wait for it before giving up on it
+	for _, timeout := range []time.Duration{0, d.timeout} {
+		res, err := d.waitForTx(entry.TxHash, timeout)
+		if err == nil {
+			return true, d.complete(entry, res)
+		}
+		if !errors.Is(err, errTxNotFound) {
+			return false, err
+		}
+
+		_, seq, err := d.clientCtx.AccountRetriever.GetAccountNumberSequence(d.clientCtx, d.clientCtx.FromAddress)
+		if err != nil {
+			return false, err
+		}
+		if seq > entry.Sequence {
+			return false, fmt.Errorf("chunk %d: sequence %d was used but tx %s is not indexed, verify it manually before resuming", entry.Chunk+1, entry.Sequence, entry.TxHash)
+		}
+	}
+
+	// the sequence is still unused, a new tx for the chunk and the old one can
+	// never both be included
+	return false, nil
+}
+
+func (d *fileDistributor) complete(entry JournalEntry, res *sdk.TxResponse) error {
+	if res.Code != 0 {
+		return d.fail(entry, res)
+	}
+	entry.Status = ChunkDone
+	entry.Height = res.Height
+	if err := d.journal.Record(entry); err != nil {
+		return err
+	}
+	return d.clientCtx.PrintString(fmt.Sprintf("chunk %d/%d: included at height %d\n", entry.Chunk+1, d.journal.Header.Chunks, res.Height))
+}
+
+func (d *fileDistributor) fail(entry JournalEntry, res *sdk.TxResponse) error {
+	entry.Status = ChunkFailed
+	entry.Height = res.Height
+	entry.Code = res.Code
+	entry.Log = res.RawLog
+	if err := d.journal.Record(entry); err != nil {
+		return err
+	}
+	return fmt.Errorf("chunk %d failed with code %d: %s", entry.Chunk+1, res.Code, res.RawLog)
+}
+
+// waitForTx polls for a tx until it is found or timeout elapsed. A zero
+// timeout queries once.
+func (d *fileDistributor) waitForTx(hash string, timeout time.Duration) (*sdk.TxResponse, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		res, err := authtx.QueryTx(d.clientCtx, hash)
+		if err == nil {
+			return res, nil
+		}
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("%w: %s after %s", errTxNotFound, hash, timeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func chunkTotal(chunk []*types.Recipient) math.Int {
+	total := math.ZeroInt()
+	for _, recipient := range chunk {
+		total = total.Add(recipient.Amount)
+	}
+	return total
+}
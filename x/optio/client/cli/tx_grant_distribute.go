@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagAllowedRecipients = "allowed-recipients"
+	FlagMaxPerMsg         = "max-per-msg"
+	FlagExpiration        = "expiration"
+)
+
+// CmdGrantDistribute grants a DistributeAuthorization to grantee.
+func CmdGrantDistribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-distribute [grantee]",
+		Short: "Grant bounded distribution rights to another account",
+		Long: `Grant bounded distribution rights to another account through x/authz. The
+grantee can execute MsgDistribute on behalf of the signer, who must be an
+authorized account, until the spend limit is used up or the expiration passed.`,
+		Example: "grant-distribute optio1... --spend-limit 1000000 --max-per-msg 10000 --expiration 2027-01-01T00:00:00Z --from distributor",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimitStr, _ := cmd.Flags().GetString(FlagSpendLimit)
+			spendLimit, ok := math.NewIntFromString(spendLimitStr)
+			if !ok {
+				return fmt.Errorf("invalid spend limit %q", spendLimitStr)
+			}
+			maxPerMsg := math.ZeroInt()
+			if s, _ := cmd.Flags().GetString(FlagMaxPerMsg); s != "" {
+				if maxPerMsg, ok = math.NewIntFromString(s); !ok {
+					return fmt.Errorf("invalid max per message %q", s)
+				}
+			}
+			allowedRecipients, _ := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+
+			var expiration *time.Time
+			if s, _ := cmd.Flags().GetString(FlagExpiration); s != "" {
+				t, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					return err
+				}
+				expiration = &t
+			}
+
+			authorization := types.NewDistributeAuthorization(spendLimit, allowedRecipients, maxPerMsg, expiration)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// the grant expires together with the authorization
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Total amount the grantee may distribute")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, nil, "Recipients the grantee may distribute to, any if empty")
+	cmd.Flags().String(FlagMaxPerMsg, "", "Maximum amount of a single distribution")
+	cmd.Flags().String(FlagExpiration, "", "Expiration of the grant in RFC 3339 format")
+	_ = cmd.MarkFlagRequired(FlagSpendLimit)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+const (
+	FlagAuthorizedAccounts     = "authorized-accounts"
+	FlagDenom                  = "denom"
+	FlagMaxSupply              = "max-supply"
+	FlagMaxReferenceLength     = "max-reference-length"
+	FlagMaxCategoryLength      = "max-category-length"
+	FlagMaxMemoLength          = "max-memo-length"
+	FlagComplianceAccounts     = "compliance-accounts"
+	FlagAllowlistEnabled       = "allowlist-enabled"
+	FlagMaxRecipientsPerMsg    = "max-recipients-per-msg"
+	FlagGasPerRecipient        = "gas-per-recipient"
+	FlagFeeAllowanceSpendLimit = "fee-allowance-spend-limit"
+	FlagFeeAllowanceDuration   = "fee-allowance-duration"
+	FlagFeeAllowanceSponsor    = "fee-allowance-sponsor"
+	FlagFeeAllowanceBudget     = "fee-allowance-budget"
+	FlagTransferTimeout        = "transfer-timeout"
+	FlagRemoteControllers      = "remote-controllers"
+	FlagFeeExemptBlockGas      = "fee-exempt-block-gas"
+	FlagFeeExemptTxsPerWindow  = "fee-exempt-txs-per-window"
+	FlagFeeExemptWindow        = "fee-exempt-window"
+	FlagMinimumGasPrices       = "minimum-gas-prices"
+	FlagBaseFeeTargetGas       = "base-fee-target-gas"
+	FlagBaseFeeMaxChangeRate   = "base-fee-max-change-rate"
+	FlagMinBaseFee             = "min-base-fee"
+	FlagMaxBaseFee             = "max-base-fee"
+	FlagBaseFeeBurnRate        = "base-fee-burn-rate"
+	FlagExpedited              = "expedited"
+)
+
+// CmdProposeParams submits a gov v1 proposal executing MsgUpdateParams with
+// the current params overridden by the given flags.
+func CmdProposeParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-params",
+		Short: "Submit a governance proposal updating the optio params",
+		Long: `Submit a governance proposal updating the optio params. The current params
+are fetched from the chain and every param flag that is set overrides its
+value. The resulting changes are printed to stderr before the proposal is
+signed, use --generate-only to review the proposal without broadcasting.`,
+		Example: "propose-params --max-recipients-per-msg 500 --title \"Lower recipient cap\" --summary \"...\" --deposit 10000000stake --from proposer",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			params, err := overrideParams(res.Params, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := params.Validate(); err != nil {
+				return err
+			}
+
+			diff, err := diffParams(clientCtx.Codec, res.Params, params)
+			if err != nil {
+				return err
+			}
+			if len(diff) == 0 {
+				return errors.New("no param changes, set at least one param flag")
+			}
+			for _, line := range diff {
+				fmt.Fprintln(cmd.ErrOrStderr(), line)
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			summary, _ := cmd.Flags().GetString(govcli.FlagSummary)
+			metadata, _ := cmd.Flags().GetString(govcli.FlagMetadata)
+			expedited, _ := cmd.Flags().GetBool(FlagExpedited)
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			if title == "" || summary == "" {
+				return errors.New("--title and --summary are required")
+			}
+
+			msg := &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    params,
+			}
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary, expedited,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAuthorizedAccounts, nil, "Accounts allowed to distribute, replaces the current list")
+	cmd.Flags().String(FlagDenom, "", "Denom of the distributed token")
+	cmd.Flags().String(FlagMaxSupply, "", "Maximum supply of the distributed token")
+	cmd.Flags().Uint64(FlagMaxReferenceLength, 0, "Maximum length of a recipient reference")
+	cmd.Flags().Uint64(FlagMaxCategoryLength, 0, "Maximum length of a distribution category")
+	cmd.Flags().Uint64(FlagMaxMemoLength, 0, "Maximum length of a distribution memo")
+	cmd.Flags().StringSlice(FlagComplianceAccounts, nil, "Accounts allowed to set address statuses, replaces the current list")
+	cmd.Flags().Bool(FlagAllowlistEnabled, false, "Restrict recipients to allowlisted addresses")
+	cmd.Flags().Uint64(FlagMaxRecipientsPerMsg, 0, "Maximum recipients of a single distribution, 0 disables the cap")
+	cmd.Flags().Uint64(FlagGasPerRecipient, 0, "Gas charged per recipient of a distribution")
+	cmd.Flags().String(FlagFeeAllowanceSpendLimit, "", "Spend limit of the fee allowance granted to first-time recipients, empty disables the allowances")
+	cmd.Flags().Duration(FlagFeeAllowanceDuration, 0, "Validity of a granted fee allowance")
+	cmd.Flags().String(FlagFeeAllowanceSponsor, "", "Registered fee sponsor paying for the fee allowances, empty for the fee allowance account of the module")
+	cmd.Flags().String(FlagFeeAllowanceBudget, "", "Total spend limit that can be granted as fee allowances")
+	cmd.Flags().Duration(FlagTransferTimeout, 0, "Timeout of the ICS-20 transfers to cross-chain recipients and of the distribution packets, 0 disables them")
+	cmd.Flags().StringSlice(FlagRemoteControllers, nil, "Optio channels approved for remote distributions as channel:quota, replaces the current list")
+	cmd.Flags().Uint64(FlagFeeExemptBlockGas, 0, "Gas limit of the fee exempt txs of authorized accounts per block, 0 disables fee exemptions")
+	cmd.Flags().Uint64(FlagFeeExemptTxsPerWindow, 0, "Fee exempt txs an authorized account may send per window")
+	cmd.Flags().Duration(FlagFeeExemptWindow, 0, "Window after which the fee exempt txs of an account are reset")
+	cmd.Flags().String(FlagMinimumGasPrices, "", "Chain-wide minimum gas prices nodes cannot undercut, empty leaves the fees to the nodes")
+	cmd.Flags().Uint64(FlagBaseFeeTargetGas, 0, "Block gas the base fee targets, 0 disables the base fee")
+	cmd.Flags().String(FlagBaseFeeMaxChangeRate, "", "Maximum change of the base fee per block, between 0 and 1")
+	cmd.Flags().String(FlagMinBaseFee, "", "Minimum base fee per unit of gas")
+	cmd.Flags().String(FlagMaxBaseFee, "", "Maximum base fee per unit of gas, 0 disables the cap")
+	cmd.Flags().String(FlagBaseFeeBurnRate, "", "Share of the base fee that is burned, the rest goes to the fee collector")
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "Summary of the proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "Metadata of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Initial deposit of the proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// overrideParams returns params with every changed param flag applied.
+func overrideParams(params types.Params, fs *pflag.FlagSet) (types.Params, error) {
+	var err error
+	set := func(name string, apply func()) {
+		if err == nil && fs.Changed(name) {
+			apply()
+		}
+	}
+
+	set(FlagAuthorizedAccounts, func() { params.AuthorizedAccounts, err = fs.GetStringSlice(FlagAuthorizedAccounts) })
+	set(FlagDenom, func() { params.Denom, err = fs.GetString(FlagDenom) })
+	set(FlagMaxSupply, func() {
+		var s string
+		if s, err = fs.GetString(FlagMaxSupply); err != nil {
+			return
+		}
+		var ok bool
+		if params.MaxSupply, ok = math.NewIntFromString(s); !ok {
+			err = fmt.Errorf("invalid max supply %q", s)
+		}
+	})
+	set(FlagMaxReferenceLength, func() { params.MaxReferenceLength, err = fs.GetUint64(FlagMaxReferenceLength) })
+	set(FlagMaxCategoryLength, func() { params.MaxCategoryLength, err = fs.GetUint64(FlagMaxCategoryLength) })
+	set(FlagMaxMemoLength, func() { params.MaxMemoLength, err = fs.GetUint64(FlagMaxMemoLength) })
+	set(FlagComplianceAccounts, func() { params.ComplianceAccounts, err = fs.GetStringSlice(FlagComplianceAccounts) })
+	set(FlagAllowlistEnabled, func() { params.AllowlistEnabled, err = fs.GetBool(FlagAllowlistEnabled) })
+	set(FlagMaxRecipientsPerMsg, func() { params.MaxRecipientsPerMsg, err = fs.GetUint64(FlagMaxRecipientsPerMsg) })
+	set(FlagGasPerRecipient, func() { params.GasPerRecipient, err = fs.GetUint64(FlagGasPerRecipient) })
+	set(FlagFeeAllowanceSpendLimit, func() { params.FeeAllowanceSpendLimit, err = coinsFlag(fs, FlagFeeAllowanceSpendLimit) })
+	set(FlagFeeAllowanceDuration, func() { params.FeeAllowanceDuration, err = fs.GetDuration(FlagFeeAllowanceDuration) })
+	set(FlagFeeAllowanceSponsor, func() { params.FeeAllowanceSponsor, err = fs.GetString(FlagFeeAllowanceSponsor) })
+	set(FlagFeeAllowanceBudget, func() { params.FeeAllowanceBudget, err = coinsFlag(fs, FlagFeeAllowanceBudget) })
+	set(FlagTransferTimeout, func() { params.TransferTimeout, err = fs.GetDuration(FlagTransferTimeout) })
+	set(FlagRemoteControllers, func() { params.RemoteControllers, err = remoteControllersFlag(fs, FlagRemoteControllers) })
+	set(FlagFeeExemptBlockGas, func() { params.FeeExemptBlockGas, err = fs.GetUint64(FlagFeeExemptBlockGas) })
+	set(FlagFeeExemptTxsPerWindow, func() { params.FeeExemptTxsPerWindow, err = fs.GetUint64(FlagFeeExemptTxsPerWindow) })
+	set(FlagFeeExemptWindow, func() { params.FeeExemptWindow, err = fs.GetDuration(FlagFeeExemptWindow) })
+	set(FlagMinimumGasPrices, func() { params.MinimumGasPrices, err = decCoinsFlag(fs, FlagMinimumGasPrices) })
+	set(FlagBaseFeeTargetGas, func() { params.BaseFeeTargetGas, err = fs.GetUint64(FlagBaseFeeTargetGas) })
+	set(FlagBaseFeeMaxChangeRate, func() { params.BaseFeeMaxChangeRate, err = decFlag(fs, FlagBaseFeeMaxChangeRate) })
+	set(FlagMinBaseFee, func() { params.MinBaseFee, err = decFlag(fs, FlagMinBaseFee) })
+	set(FlagMaxBaseFee, func() { params.MaxBaseFee, err = decFlag(fs, FlagMaxBaseFee) })
+	set(FlagBaseFeeBurnRate, func() { params.BaseFeeBurnRate, err = decFlag(fs, FlagBaseFeeBurnRate) })
+
+	return params, err
+}
+
+func coinsFlag(fs *pflag.FlagSet, name string) (sdk.Coins, error) {
+	s, err := fs.GetString(name)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(s)
+}
+
+func decCoinsFlag(fs *pflag.FlagSet, name string) (sdk.DecCoins, error) {
+	s, err := fs.GetString(name)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ParseDecCoins(s)
+}
+
+func decFlag(fs *pflag.FlagSet, name string) (math.LegacyDec, error) {
+	s, err := fs.GetString(name)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return math.LegacyNewDecFromStr(s)
+}
+
+// remoteControllersFlag parses channel:quota pairs.
+func remoteControllersFlag(fs *pflag.FlagSet, name string) ([]types.RemoteController, error) {
+	values, err := fs.GetStringSlice(name)
+	if err != nil {
+		return nil, err
+	}
+
+	controllers := make([]types.RemoteController, 0, len(values))
+	for _, value := range values {
+		channelID, quotaStr, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid remote controller %q, expected channel:quota", value)
+		}
+		quota, ok := math.NewIntFromString(quotaStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid quota %q for remote controller %s", quotaStr, channelID)
+		}
+		controllers = append(controllers, types.RemoteController{ChannelId: channelID, Quota: quota})
+	}
+	return controllers, nil
+}
+
+// diffParams returns one "field: old -> new" line per changed param, in the
+// JSON encoding of the params.
+func diffParams(cdc codec.JSONCodec, before, after types.Params) ([]string, error) {
+	fields := func(p types.Params) (map[string]json.RawMessage, error) {
+		bz, err := cdc.MarshalJSON(&p)
+		if err != nil {
+			return nil, err
+		}
+		var m map[string]json.RawMessage
+		return m, json.Unmarshal(bz, &m)
+	}
+
+	old, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var diff []string
+	for name, value := range updated {
+		if string(old[name]) != string(value) {
+			diff = append(diff, fmt.Sprintf("%s: %s -> %s", name, old[name], value))
+		}
+	}
+	sort.Strings(diff)
+	return diff, nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestOverrideParams(t *testing.T) {
+	account := sample.AccAddress()
+	cmd := CmdProposeParams()
+	require.NoError(t, cmd.Flags().Parse([]string{
+		"--" + FlagAuthorizedAccounts, account,
+		"--" + FlagMaxSupply, "5000",
+		"--" + FlagAllowlistEnabled,
+		"--" + FlagMaxRecipientsPerMsg, "0",
+	}))
+
+	before := types.DefaultParams()
+	after, err := overrideParams(before, cmd.Flags())
+	require.NoError(t, err)
+
+	expected := before
+	expected.AuthorizedAccounts = []string{account}
+	expected.MaxSupply = math.NewInt(5000)
+	expected.AllowlistEnabled = true
+	expected.MaxRecipientsPerMsg = 0
+	require.Equal(t, expected, after)
+
+	diff, err := diffParams(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), before, after)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`allowlistEnabled: false -> true`,
+		`authorizedAccounts: [] -> ["` + account + `"]`,
+		`maxRecipientsPerMsg: "1000" -> "0"`,
+		`maxSupply: "1000000000000000" -> "5000"`,
+	}, diff)
+
+	cmd = CmdProposeParams()
+	require.NoError(t, cmd.Flags().Parse([]string{"--" + FlagRemoteControllers, "channel-0:1000,channel-4:0"}))
+	after, err = overrideParams(before, cmd.Flags())
+	require.NoError(t, err)
+	require.Equal(t, []types.RemoteController{
+		{ChannelId: "channel-0", Quota: math.NewInt(1000)},
+		{ChannelId: "channel-4", Quota: math.ZeroInt()},
+	}, after.RemoteControllers)
+
+	cmd = CmdProposeParams()
+	require.NoError(t, cmd.Flags().Parse([]string{"--" + FlagMinimumGasPrices, "0.5uOPT"}))
+	after, err = overrideParams(before, cmd.Flags())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uOPT", math.LegacyNewDecWithPrec(5, 1))), after.MinimumGasPrices)
+
+	cmd = CmdProposeParams()
+	require.NoError(t, cmd.Flags().Parse([]string{"--" + FlagBaseFeeTargetGas, "5000000", "--" + FlagMinBaseFee, "0.025", "--" + FlagBaseFeeBurnRate, "0.5"}))
+	after, err = overrideParams(before, cmd.Flags())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5_000_000), after.BaseFeeTargetGas)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 3), after.MinBaseFee)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), after.BaseFeeBurnRate)
+	require.Equal(t, before.MaxBaseFee, after.MaxBaseFee)
+
+	for _, args := range [][]string{
+		{"--" + FlagMaxSupply, "lots"},
+		{"--" + FlagRemoteControllers, "channel-0"},
+		{"--" + FlagRemoteControllers, "channel-0:lots"},
+		{"--" + FlagMinimumGasPrices, "cheap"},
+		{"--" + FlagMinBaseFee, "cheap"},
+	} {
+		cmd = CmdProposeParams()
+		require.NoError(t, cmd.Flags().Parse(args))
+		_, err = overrideParams(before, cmd.Flags())
+		require.Error(t, err, args)
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetAddressStatus set a specific addressStatus in the store from its index
+func (k Keeper) SetAddressStatus(ctx context.Context, addressStatus types.AddressStatus) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AddressStatusKeyPrefix))
+	b := k.cdc.MustMarshal(&addressStatus)
+	store.Set(types.AddressStatusKey(
+		addressStatus.Address,
+	), b)
+}
+
+// GetAddressStatus returns a addressStatus from its index
+func (k Keeper) GetAddressStatus(
+	ctx context.Context,
+	address string,
+
+) (val types.AddressStatus, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AddressStatusKeyPrefix))
+
+	b := store.Get(types.AddressStatusKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAddressStatus removes a addressStatus from the store
+func (k Keeper) RemoveAddressStatus(
+	ctx context.Context,
+	address string,
+
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AddressStatusKeyPrefix))
+	store.Delete(types.AddressStatusKey(
+		address,
+	))
+}
+
+// GetAllAddressStatus returns all addressStatus
+func (k Keeper) GetAllAddressStatus(ctx context.Context) (list []types.AddressStatus) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AddressStatusKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AddressStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/nullify"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func createNAddressStatus(keeper keeper.Keeper, ctx context.Context, n int) []types.AddressStatus {
+	items := make([]types.AddressStatus, n)
+	for i := range items {
+		items[i].Address = sample.AccAddress()
+		items[i].Status = types.COMPLIANCE_STATUS_ALLOWED
+
+		keeper.SetAddressStatus(ctx, items[i])
+	}
+	return items
+}
+
+func TestAddressStatusGet(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNAddressStatus(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetAddressStatus(ctx,
+			item.Address,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestAddressStatusRemove(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNAddressStatus(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveAddressStatus(ctx,
+			item.Address,
+		)
+		_, found := keeper.GetAddressStatus(ctx,
+			item.Address,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestAddressStatusGetAll(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNAddressStatus(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllAddressStatus(ctx)),
+	)
+}
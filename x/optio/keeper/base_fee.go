@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GetBaseFee returns the base fee per unit of gas of the current block,
+// bounded by the params. Until the first block with the base fee enabled it
+// is the min base fee.
+func (k Keeper) GetBaseFee(ctx context.Context) math.LegacyDec {
+	return k.GetParams(ctx).ClampBaseFee(k.GetStoredBaseFee(ctx))
+}
+
+// GetStoredBaseFee returns the stored base fee per unit of gas, or zero when
+// none is stored.
+func (k Keeper) GetStoredBaseFee(ctx context.Context) math.LegacyDec {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the base fee per unit of gas of the next block. A zero base
+// fee is bounded like an unset one, so it is not stored.
+func (k Keeper) SetBaseFee(ctx context.Context, baseFee math.LegacyDec) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if baseFee.IsNil() || baseFee.IsZero() {
+		store.Delete(types.BaseFeeKey)
+		return
+	}
+
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// UpdateBaseFee adjusts the base fee for the next block to the gas used by
+// the current one.
+func (k Keeper) UpdateBaseFee(ctx context.Context, gasUsed uint64) {
+	params := k.GetParams(ctx)
+	if !params.BaseFeeEnabled() {
+		return
+	}
+
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+}
+
+// BurnBaseFee burns the BaseFeeBurnRate share of the base fee a tx paid to
+// the fee collector and returns the burned amount.
+func (k Keeper) BurnBaseFee(ctx context.Context, required math.Int) (math.Int, error) {
+	params := k.GetParams(ctx)
+	burn := params.BaseFeeBurn(required)
+	if !burn.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, burn))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+	k.AddBaseFeeBurned(ctx, burn)
+	return burn, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestUpdateBaseFee(t *testing.T) {
+	k, ctx := keepertest.OptioKeeper(t)
+
+	// the base fee is disabled by default
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	k.UpdateBaseFee(ctx, 1_000_000)
+	require.Equal(t, params.MinBaseFee, k.GetBaseFee(ctx))
+	require.True(t, k.GetStoredBaseFee(ctx).IsZero())
+	res, err := k.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(params.Denom, math.LegacyZeroDec()), res.BaseFee)
+
+	params.BaseFeeTargetGas = 1000
+	params.MaxBaseFee = math.LegacyNewDec(2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for _, tc := range []struct {
+		gasUsed uint64
+		baseFee string
+	}{
+		{2000, "1.125"},
+		{2000, "1.265625"},
+		{1000, "1.265625"},
+		{1500, "1.344726562500000000"},
+		{0, "1.176635742187500000"},
+		{0, "1.029556274414062500"},
+		{0, "1.000000000000000000"},
+		{10_000, "1.125"},
+	} {
+		k.UpdateBaseFee(ctx, tc.gasUsed)
+		require.Equal(t, math.LegacyMustNewDecFromStr(tc.baseFee), k.GetBaseFee(ctx), tc.gasUsed)
+	}
+
+	// the stored base fee is bounded by the current params
+	params.MaxBaseFee = math.LegacyNewDecWithPrec(11, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, params.MaxBaseFee, k.GetBaseFee(ctx))
+
+	res, err = k.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(params.Denom, params.MaxBaseFee), res.BaseFee)
+
+	_, err = k.BaseFee(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestBurnBaseFee(t *testing.T) {
+	k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+	params := types.DefaultParams()
+	params.BaseFeeTargetGas = 1000
+	params.BaseFeeBurnRate = math.LegacyNewDecWithPrec(75, 2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, bank.MintCoins(ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1000))))
+
+	burned, err := k.BurnBaseFee(ctx, math.NewInt(401))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), burned)
+	require.Equal(t, math.NewInt(700), bank.GetBalance(ctx, feeCollector, params.Denom).Amount)
+	require.Equal(t, math.NewInt(700), bank.GetSupply(ctx, params.Denom).Amount)
+	require.Equal(t, math.NewInt(300), k.GetSupplyRecord(ctx).BaseFeeBurned)
+
+	// nothing is burned at a zero burn rate
+	params.BaseFeeBurnRate = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	burned, err = k.BurnBaseFee(ctx, math.NewInt(400))
+	require.NoError(t, err)
+	require.True(t, burned.IsZero())
+	require.Equal(t, math.NewInt(700), bank.GetBalance(ctx, feeCollector, params.Denom).Amount)
+
+	// the fee collector must hold the burned share
+	params.BaseFeeBurnRate = math.LegacyOneDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.BurnBaseFee(ctx, math.NewInt(701))
+	require.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// CheckRecipients applies the compliance policy to a set of recipient
+// addresses. Denied addresses are always rejected; when the allowlist is
+// enabled, addresses not explicitly allowed are rejected as well. Every flow
+// that pays out optio tokens must call this before moving funds. The returned
+// error lists all offending addresses so callers can fix a batch in one go.
+func (k Keeper) CheckRecipients(ctx context.Context, params types.Params, addresses []string) error {
+	var denied, notAllowed []string
+	for _, address := range addresses {
+		status, _ := k.GetAddressStatus(ctx, address)
+		switch params.RejectionReason(status.Status) {
+		case types.RejectedDenied:
+			denied = append(denied, address)
+		case types.RejectedNotAllowlisted:
+			notAllowed = append(notAllowed, address)
+		}
+	}
+
+	if len(denied) == 0 && len(notAllowed) == 0 {
+		return nil
+	}
+
+	var reasons []string
+	if len(denied) > 0 {
+		reasons = append(reasons, "denied: "+strings.Join(denied, ", "))
+	}
+	if len(notAllowed) > 0 {
+		reasons = append(reasons, "not allowlisted: "+strings.Join(notAllowed, ", "))
+	}
+	return errorsmod.Wrap(types.ErrRecipientRejected, strings.Join(reasons, "; "))
+}
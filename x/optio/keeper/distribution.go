@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GetDistributionCount get the total number of distribution
+func (k Keeper) GetDistributionCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.DistributionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetDistributionCount set the total number of distribution
+func (k Keeper) SetDistributionCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.DistributionCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendDistribution appends a distribution in the store with a new id and update the count
+func (k Keeper) AppendDistribution(ctx context.Context, distribution types.Distribution) uint64 {
+	// Create the distribution
+	count := k.GetDistributionCount(ctx)
+
+	// Set the ID of the appended value
+	distribution.Id = count
+
+	k.SetDistribution(ctx, distribution)
+
+	// Update distribution count
+	k.SetDistributionCount(ctx, count+1)
+
+	return count
+}
+
+// SetDistribution set a specific distribution in the store
+func (k Keeper) SetDistribution(ctx context.Context, distribution types.Distribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributionKey))
+	b := k.cdc.MustMarshal(&distribution)
+	store.Set(GetDistributionIDBytes(distribution.Id), b)
+
+	if distribution.Category != "" {
+		categoryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributionCategoryKey))
+		categoryStore.Set(GetDistributionCategoryKey(distribution.Category, distribution.Id), []byte{})
+	}
+}
+
+// GetDistribution returns a distribution from its id
+func (k Keeper) GetDistribution(ctx context.Context, id uint64) (val types.Distribution, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributionKey))
+	b := store.Get(GetDistributionIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDistribution returns all distribution
+func (k Keeper) GetAllDistribution(ctx context.Context) (list []types.Distribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributionKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Distribution
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDistributionIDBytes returns the byte representation of the ID
+func GetDistributionIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetDistributionCategoryKey returns the category index key of a distribution.
+// Categories cannot contain '/', see types.ValidateCategory.
+func GetDistributionCategoryKey(category string, id uint64) []byte {
+	return append(GetDistributionCategoryPrefix(category), GetDistributionIDBytes(id)...)
+}
+
+// GetDistributionCategoryPrefix returns the index prefix of all distributions
+// with the given category.
+func GetDistributionCategoryPrefix(category string) []byte {
+	return append([]byte(category), '/')
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/nullify"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func createNDistribution(keeper keeper.Keeper, ctx context.Context, n int) []types.Distribution {
+	items := make([]types.Distribution, n)
+	for i := range items {
+		items[i].Amount = math.NewInt(int64(i + 1))
+		items[i].Category = fmt.Sprintf("category-%d", i%2)
+		items[i].Id = keeper.AppendDistribution(ctx, items[i])
+	}
+	return items
+}
+
+func TestDistributionGet(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNDistribution(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetDistribution(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestDistributionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNDistribution(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllDistribution(ctx)),
+	)
+}
+
+func TestDistributionCount(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNDistribution(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetDistributionCount(ctx))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetDistributorStats set a specific distributorStats in the store from its index
+func (k Keeper) SetDistributorStats(ctx context.Context, distributorStats types.DistributorStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributorStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&distributorStats)
+	store.Set(types.DistributorStatsKey(
+		distributorStats.Address,
+	), b)
+}
+
+// GetDistributorStats returns a distributorStats from its index
+func (k Keeper) GetDistributorStats(
+	ctx context.Context,
+	address string,
+
+) (val types.DistributorStats, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributorStatsKeyPrefix))
+
+	b := store.Get(types.DistributorStatsKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDistributorStats returns all distributorStats
+func (k Keeper) GetAllDistributorStats(ctx context.Context) (list []types.DistributorStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DistributorStatsKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DistributorStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddDistributorUsage records a distribution of amount by distributor at the
+// current block height.
+func (k Keeper) AddDistributorUsage(ctx context.Context, distributor string, amount math.Int) {
+	stats, found := k.GetDistributorStats(ctx, distributor)
+	if !found {
+		stats = types.DistributorStats{Address: distributor, TotalDistributed: math.ZeroInt()}
+	}
+	stats.TotalDistributed = stats.TotalDistributed.Add(amount)
+	stats.DistributionCount++
+	stats.LastHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	k.SetDistributorStats(ctx, stats)
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/nullify"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func createNDistributorStats(keeper keeper.Keeper, ctx context.Context, n int) []types.DistributorStats {
+	items := make([]types.DistributorStats, n)
+	for i := range items {
+		items[i].Address = sample.AccAddress()
+		items[i].TotalDistributed = math.NewInt(int64(i + 1))
+		items[i].DistributionCount = uint64(i + 1)
+
+		keeper.SetDistributorStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestDistributorStatsGet(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNDistributorStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetDistributorStats(ctx,
+			item.Address,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestDistributorStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	items := createNDistributorStats(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllDistributorStats(ctx)),
+	)
+}
+
+func TestAddDistributorUsage(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	distributor := sample.AccAddress()
+
+	keeper.AddDistributorUsage(ctx.WithBlockHeight(5), distributor, math.NewInt(10))
+	keeper.AddDistributorUsage(sdk.UnwrapSDKContext(ctx).WithBlockHeight(8), distributor, math.NewInt(15))
+
+	stats, found := keeper.GetDistributorStats(ctx, distributor)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(25), stats.TotalDistributed)
+	require.Equal(t, uint64(2), stats.DistributionCount)
+	require.Equal(t, int64(8), stats.LastHeight)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GetFeeAllowanceUsage returns the fee allowances granted so far.
+func (k Keeper) GetFeeAllowanceUsage(ctx context.Context) (usage types.FeeAllowanceUsage) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.FeeAllowanceUsageKey)
+	if bz == nil {
+		return usage
+	}
+
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// SetFeeAllowanceUsage sets the fee allowances granted so far.
+func (k Keeper) SetFeeAllowanceUsage(ctx context.Context, usage types.FeeAllowanceUsage) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.FeeAllowanceUsageKey, k.cdc.MustMarshal(&usage))
+}
+
+// SetFeeAllowanceGrant set a specific feeAllowanceGrant in the store from its index
+func (k Keeper) SetFeeAllowanceGrant(ctx context.Context, feeAllowanceGrant types.FeeAllowanceGrant) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeAllowanceGrantKeyPrefix))
+	b := k.cdc.MustMarshal(&feeAllowanceGrant)
+	store.Set(types.FeeAllowanceGrantKey(
+		feeAllowanceGrant.Address,
+	), b)
+}
+
+// GetFeeAllowanceGrant returns a feeAllowanceGrant from its index
+func (k Keeper) GetFeeAllowanceGrant(
+	ctx context.Context,
+	address string,
+
+) (val types.FeeAllowanceGrant, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeAllowanceGrantKeyPrefix))
+
+	b := store.Get(types.FeeAllowanceGrantKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFeeAllowanceGrant returns all feeAllowanceGrant
+func (k Keeper) GetAllFeeAllowanceGrant(ctx context.Context) (list []types.FeeAllowanceGrant) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeAllowanceGrantKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeAllowanceGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// FeeAllowanceGranter returns the account paying for recipient fee
+// allowances.
+func FeeAllowanceGranter(params types.Params) sdk.AccAddress {
+	if params.FeeAllowanceSponsor != "" {
+		return sdk.MustAccAddressFromBech32(params.FeeAllowanceSponsor)
+	}
+	return types.FeeAllowanceAddress
+}
+
+// GrantRecipientFeeAllowance grants a first-time recipient a basic fee
+// allowance when allowances are enabled. Recipients that already received
+// one, or already have an allowance from the granter, are skipped, as is
+// every recipient once the budget is used up, the sponsor withdrew its
+// consent or the balance of the granter does not cover the spend limit, so
+// that a distribution never fails because of its allowances.
+func (k Keeper) GrantRecipientFeeAllowance(ctx context.Context, params types.Params, recipient sdk.AccAddress) error {
+	if !params.FeeAllowancesEnabled() {
+		return nil
+	}
+	if _, found := k.GetFeeAllowanceGrant(ctx, recipient.String()); found {
+		return nil
+	}
+
+	granter := FeeAllowanceGranter(params)
+	if granter.Equals(recipient) {
+		return nil
+	}
+	if params.FeeAllowanceSponsor != "" {
+		if _, found := k.GetFeeSponsor(ctx, params.FeeAllowanceSponsor); !found {
+			return nil
+		}
+	}
+	if !params.FeeAllowanceSpendLimit.IsAllLTE(k.bankKeeper.SpendableCoins(ctx, granter)) {
+		return nil
+	}
+
+	usage := k.GetFeeAllowanceUsage(ctx)
+	granted := usage.Granted.Add(params.FeeAllowanceSpendLimit...)
+	if !granted.IsAllLTE(params.FeeAllowanceBudget) {
+		return nil
+	}
+
+	if existing, _ := k.feegrantKeeper.GetAllowance(ctx, granter, recipient); existing != nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	expiration := sdkCtx.BlockTime().Add(params.FeeAllowanceDuration)
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: params.FeeAllowanceSpendLimit,
+		Expiration: &expiration,
+	}
+	if err := k.feegrantKeeper.GrantAllowance(ctx, granter, recipient, allowance); err != nil {
+		return err
+	}
+
+	k.SetFeeAllowanceGrant(ctx, types.FeeAllowanceGrant{
+		Address: recipient.String(),
+		Granter: granter.String(),
+		Height:  sdkCtx.BlockHeight(),
+	})
+	usage.Granted = granted
+	usage.Count++
+	k.SetFeeAllowanceUsage(ctx, usage)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, params.FeeAllowanceSpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, expiration.Format(time.RFC3339)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestDistributeGrantsFeeAllowance(t *testing.T) {
+	k, ctx, bank, feegrantKeeper := keepertest.OptioKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(3)
+
+	distributor := sample.AccAddress()
+	alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	params.FeeAllowanceSpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	params.FeeAllowanceDuration = time.Hour
+	params.FeeAllowanceBudget = sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	distribute := func(recipients ...string) {
+		rs := make([]*types.Recipient, len(recipients))
+		for i, r := range recipients {
+			rs[i] = &types.Recipient{Address: r, Amount: math.NewInt(1)}
+		}
+		_, err := ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(int64(len(rs))), rs))
+		require.NoError(t, err)
+	}
+
+	// nothing is granted while the fee allowance account cannot pay
+	distribute(alice)
+	_, found := k.GetFeeAllowanceGrant(ctx, alice)
+	require.False(t, found)
+
+	// first-time recipients get an allowance from the funded fee allowance
+	// account, repeat recipients do not get a second one
+	fund := func(addr sdk.AccAddress, amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+		require.NoError(t, bank.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins))
+	}
+	fund(types.FeeAllowanceAddress, 1000)
+	distribute(alice, bob)
+	distribute(alice)
+
+	allowance, err := feegrantKeeper.GetAllowance(ctx, types.FeeAllowanceAddress, sdk.MustAccAddressFromBech32(alice))
+	require.NoError(t, err)
+	expiration := now.Add(time.Hour)
+	require.Equal(t, &feegrant.BasicAllowance{SpendLimit: params.FeeAllowanceSpendLimit, Expiration: &expiration}, allowance)
+
+	grant, found := k.GetFeeAllowanceGrant(ctx, bob)
+	require.True(t, found)
+	require.Equal(t, types.FeeAllowanceGrant{Address: bob, Granter: types.FeeAllowanceAddress.String(), Height: 3}, grant)
+	require.Len(t, k.GetAllFeeAllowanceGrant(ctx), 2)
+
+	// the budget is exhausted, the distribution still succeeds
+	distribute(carol)
+	_, err = feegrantKeeper.GetAllowance(ctx, types.FeeAllowanceAddress, sdk.MustAccAddressFromBech32(carol))
+	require.Error(t, err)
+
+	res, err := k.FeeAllowanceBudget(ctx, &types.QueryFeeAllowanceBudgetRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Usage.Count)
+	require.Equal(t, params.FeeAllowanceBudget, res.Usage.Granted)
+	require.True(t, res.Remaining.IsZero())
+	require.Equal(t, types.FeeAllowanceAddress.String(), res.Granter)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), res.GranterBalance)
+
+	// a sponsor has to consent before governance can make it the sponsor
+	sponsor := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	params.FeeAllowanceSponsor = sponsor.String()
+	params.FeeAllowanceBudget = sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrFeeSponsorNotFound)
+
+	_, err = ms.RegisterFeeSponsor(ctx, types.NewMsgRegisterFeeSponsor(sponsor.String()))
+	require.NoError(t, err)
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	// and pays once the budget is raised and it holds the spend limit
+	distribute(carol)
+	_, err = feegrantKeeper.GetAllowance(ctx, sponsor, sdk.MustAccAddressFromBech32(carol))
+	require.Error(t, err)
+	fund(sponsor, 100)
+	distribute(carol)
+	_, err = feegrantKeeper.GetAllowance(ctx, sponsor, sdk.MustAccAddressFromBech32(carol))
+	require.NoError(t, err)
+
+	// no allowance is granted once it withdraws its consent
+	dave := sample.AccAddress()
+	_, err = ms.UnregisterFeeSponsor(ctx, types.NewMsgUnregisterFeeSponsor(sponsor.String()))
+	require.NoError(t, err)
+	params.FeeAllowanceBudget = sdk.NewCoins(sdk.NewInt64Coin("stake", 400))
+	require.NoError(t, k.SetParams(ctx, params))
+	distribute(dave)
+	_, found = k.GetFeeAllowanceGrant(ctx, dave)
+	require.False(t, found)
+
+	_, err = ms.UnregisterFeeSponsor(ctx, types.NewMsgUnregisterFeeSponsor(sponsor.String()))
+	require.ErrorIs(t, err, types.ErrFeeSponsorNotFound)
+}
+
+func TestDistributeWithoutFeeAllowances(t *testing.T) {
+	k, ctx, _, _ := keepertest.OptioKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor, recipient := sample.AccAddress(), sample.AccAddress()
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(1), []*types.Recipient{
+		{Address: recipient, Amount: math.NewInt(1)},
+	}))
+	require.NoError(t, err)
+
+	_, found := k.GetFeeAllowanceGrant(ctx, recipient)
+	require.False(t, found)
+	require.Zero(t, k.GetFeeAllowanceUsage(ctx).Count)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetFeeExemptUsage set a specific feeExemptUsage in the store from its index
+func (k Keeper) SetFeeExemptUsage(ctx context.Context, feeExemptUsage types.FeeExemptUsage) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeExemptUsageKeyPrefix))
+	b := k.cdc.MustMarshal(&feeExemptUsage)
+	store.Set(types.FeeExemptUsageKey(
+		feeExemptUsage.Address,
+	), b)
+}
+
+// GetFeeExemptUsage returns a feeExemptUsage from its index
+func (k Keeper) GetFeeExemptUsage(
+	ctx context.Context,
+	address string,
+
+) (val types.FeeExemptUsage, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeExemptUsageKeyPrefix))
+
+	b := store.Get(types.FeeExemptUsageKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFeeExemptUsage returns all feeExemptUsage
+func (k Keeper) GetAllFeeExemptUsage(ctx context.Context) (list []types.FeeExemptUsage) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeExemptUsageKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeExemptUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetFeeExemptBlockGas returns the gas of the fee exempt txs in the current
+// block.
+func (k Keeper) GetFeeExemptBlockGas(ctx context.Context) types.FeeExemptBlockGas {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	blockGas := k.GetStoredFeeExemptBlockGas(ctx)
+	if blockGas.Height != height {
+		return types.FeeExemptBlockGas{Height: height}
+	}
+	return blockGas
+}
+
+// GetStoredFeeExemptBlockGas returns the stored gas of the fee exempt txs,
+// whichever block it counts for.
+func (k Keeper) GetStoredFeeExemptBlockGas(ctx context.Context) (blockGas types.FeeExemptBlockGas) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.FeeExemptBlockGasKey)
+	if bz == nil {
+		return blockGas
+	}
+
+	k.cdc.MustUnmarshal(bz, &blockGas)
+	return blockGas
+}
+
+// SetFeeExemptBlockGas sets the gas of the fee exempt txs in the current
+// block.
+func (k Keeper) SetFeeExemptBlockGas(ctx context.Context, blockGas types.FeeExemptBlockGas) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.FeeExemptBlockGasKey, k.cdc.MustMarshal(&blockGas))
+}
+
+// currentFeeExemptUsage returns the usage of address in the window open at
+// the block time, starting a new window once the previous one has ended.
+func (k Keeper) currentFeeExemptUsage(ctx context.Context, params types.Params, address string) types.FeeExemptUsage {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	usage, found := k.GetFeeExemptUsage(ctx, address)
+	if !found || usage.Expired(params.FeeExemptWindow, blockTime) {
+		return types.FeeExemptUsage{Address: address, WindowStart: blockTime}
+	}
+	return usage
+}
+
+// UseFeeExemption reports whether a tx with the given signers and gas limit
+// is exempt from minimum gas prices and, if so, counts it against the block
+// gas budget and the window of every signer. A tx is only exempt when every
+// signer is an authorized account with fee exempt txs left in its window and
+// the remaining block budget covers its gas limit.
+func (k Keeper) UseFeeExemption(ctx context.Context, signers []sdk.AccAddress, gas uint64) bool {
+	params := k.GetParams(ctx)
+	if !params.FeeExemptionsEnabled() || len(signers) == 0 {
+		return false
+	}
+
+	// governance can lower the budget below the gas already used
+	blockGas := k.GetFeeExemptBlockGas(ctx)
+	if blockGas.Gas > params.FeeExemptBlockGas || gas > params.FeeExemptBlockGas-blockGas.Gas {
+		return false
+	}
+
+	usages := make([]types.FeeExemptUsage, 0, len(signers))
+	for _, signer := range signers {
+		if !params.IsAuthorized(signer.String()) {
+			return false
+		}
+		usage := k.currentFeeExemptUsage(ctx, params, signer.String())
+		if usage.Count >= params.FeeExemptTxsPerWindow {
+			return false
+		}
+		usages = append(usages, usage)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, usage := range usages {
+		usage.Count++
+		k.SetFeeExemptUsage(ctx, usage)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeExemption,
+				sdk.NewAttribute(types.AttributeKeyAddress, usage.Address),
+				sdk.NewAttribute(types.AttributeKeyGas, strconv.FormatUint(gas, 10)),
+			),
+		)
+	}
+	blockGas.Gas += gas
+	k.SetFeeExemptBlockGas(ctx, blockGas)
+
+	return true
+}
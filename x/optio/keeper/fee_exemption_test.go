@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestUseFeeExemption(t *testing.T) {
+	k, ctx := keepertest.OptioKeeper(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(3)
+
+	distributor := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	operator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// exemptions are disabled by default
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor.String(), operator.String()}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor}, 100))
+
+	params.FeeExemptBlockGas = 300
+	params.FeeExemptTxsPerWindow = 2
+	params.FeeExemptWindow = time.Hour
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// every signer must be authorized, nothing is counted otherwise
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{outsider}, 100))
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor, outsider}, 100))
+	_, found := k.GetFeeExemptUsage(ctx, distributor.String())
+	require.False(t, found)
+
+	require.True(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor}, 100))
+	require.True(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor, operator}, 150))
+	usage, found := k.GetFeeExemptUsage(ctx, distributor.String())
+	require.True(t, found)
+	require.Equal(t, types.FeeExemptUsage{Address: distributor.String(), Count: 2, WindowStart: now}, usage)
+	require.Equal(t, types.FeeExemptBlockGas{Height: 3, Gas: 250}, k.GetFeeExemptBlockGas(ctx))
+
+	// the block budget does not cover the gas limit
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{operator}, 51))
+	require.True(t, k.UseFeeExemption(ctx, []sdk.AccAddress{operator}, 50))
+
+	// the budget is reset in the next block, the window of the distributor
+	// is used up
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(now.Add(time.Minute))
+	require.Equal(t, types.FeeExemptBlockGas{Height: 4}, k.GetFeeExemptBlockGas(ctx))
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor}, 100))
+
+	res, err := k.FeeExemption(ctx, &types.QueryFeeExemptionRequest{Address: distributor.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Remaining)
+
+	// a new window starts once the previous one has ended
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(now.Add(time.Hour))
+	res, err = k.FeeExemption(ctx, &types.QueryFeeExemptionRequest{Address: distributor.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Remaining)
+	require.True(t, k.UseFeeExemption(ctx, []sdk.AccAddress{distributor}, 100))
+	usage, _ = k.GetFeeExemptUsage(ctx, distributor.String())
+	require.Equal(t, types.FeeExemptUsage{Address: distributor.String(), Count: 1, WindowStart: now.Add(time.Hour)}, usage)
+
+	// governance can lower the budget below the gas already used
+	params.FeeExemptBlockGas = 50
+	require.NoError(t, k.SetParams(ctx, params))
+	require.False(t, k.UseFeeExemption(ctx, []sdk.AccAddress{operator}, 0))
+
+	res, err = k.FeeExemption(ctx, &types.QueryFeeExemptionRequest{Address: outsider.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Remaining)
+	_, err = k.FeeExemption(ctx, &types.QueryFeeExemptionRequest{Address: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetFeeSponsor set a specific feeSponsor in the store from its index
+func (k Keeper) SetFeeSponsor(ctx context.Context, feeSponsor types.FeeSponsor) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeSponsorKeyPrefix))
+	b := k.cdc.MustMarshal(&feeSponsor)
+	store.Set(types.FeeSponsorKey(
+		feeSponsor.Address,
+	), b)
+}
+
+// GetFeeSponsor returns a feeSponsor from its index
+func (k Keeper) GetFeeSponsor(
+	ctx context.Context,
+	address string,
+
+) (val types.FeeSponsor, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeSponsorKeyPrefix))
+
+	b := store.Get(types.FeeSponsorKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFeeSponsor removes a feeSponsor from the store
+func (k Keeper) RemoveFeeSponsor(
+	ctx context.Context,
+	address string,
+
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeSponsorKeyPrefix))
+	store.Delete(types.FeeSponsorKey(
+		address,
+	))
+}
+
+// GetAllFeeSponsor returns all feeSponsor
+func (k Keeper) GetAllFeeSponsor(ctx context.Context) (list []types.FeeSponsor) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeeSponsorKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeSponsor
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
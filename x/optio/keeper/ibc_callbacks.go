@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+var _ callbacktypes.ContractKeeper = Keeper{}
+
+// trackPacket records a packet sent by the module as pending. Its outcome is
+// recorded once the IBC callbacks report the acknowledgement or timeout.
+func (k Keeper) trackPacket(ctx context.Context, portID, channelID string, sequence uint64) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	k.SetPacketOutcome(ctx, types.PacketOutcome{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Sequence:   sequence,
+		Status:     types.PACKET_OUTCOME_STATUS_PENDING,
+		SendHeight: height,
+		Height:     height,
+	})
+}
+
+// resolvePacket records the outcome of a pending packet. Packets that are not
+// tracked by the module are ignored.
+func (k Keeper) resolvePacket(ctx context.Context, packet channeltypes.Packet, status types.PacketOutcomeStatus, ackError string) {
+	outcome, found := k.GetPacketOutcome(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found || !outcome.IsPending() {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	outcome.Status = status
+	outcome.Error = ackError
+	outcome.Height = sdkCtx.BlockHeight()
+	k.SetPacketOutcome(ctx, outcome)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketOutcome,
+			sdk.NewAttribute(types.AttributeKeyPort, packet.SourcePort),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeyError, ackError),
+		),
+	)
+}
+
+// authenticateCallback checks that a callback was requested by the module:
+// the packet names the callback address of the module and was sent by the
+// transfer sender or by the owner of the remote treasuries.
+func authenticateCallback(portID, contractAddress, packetSenderAddress string) error {
+	if contractAddress != types.CallbackAddress.String() {
+		return errorsmod.Wrapf(types.ErrInvalidCallback, "unknown callback address %s", contractAddress)
+	}
+
+	switch portID {
+	case transfertypes.PortID:
+		if packetSenderAddress == types.TransferSenderAddress.String() {
+			return nil
+		}
+	case types.RemoteTreasuryPortID():
+		if packetSenderAddress == types.RemoteTreasuryOwner {
+			return nil
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidCallback, "unsupported port %s", portID)
+	}
+	return errorsmod.Wrapf(types.ErrInvalidCallback, "packet sender %s on %s is not %s", packetSenderAddress, portID, types.ModuleName)
+}
+
+// IBCSendPacketCallback implements the ContractKeeper interface. Other
+// senders may not request the callbacks of the module, so their packets are
+// rejected.
+func (k Keeper) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData []byte,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return authenticateCallback(sourcePort, contractAddress, packetSenderAddress)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ContractKeeper interface.
+// It runs after the underlying application handled the acknowledgement and
+// records the outcome of the packet and the result of a remote distribution.
+// The refunds of failed transfers are burned by the optio IBC middleware
+// instead, since the callbacks middleware drops the errors of a callback.
+func (k Keeper) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	if err := authenticateCallback(packet.SourcePort, contractAddress, packetSenderAddress); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	if packet.SourcePort != transfertypes.PortID {
+		k.OnRemoteDistributionAcknowledged(cachedCtx, packet.SourceChannel, packet.Sequence, ack.Success(), ack.GetError())
+	}
+
+	status := types.PACKET_OUTCOME_STATUS_ACKNOWLEDGED
+	if !ack.Success() {
+		status = types.PACKET_OUTCOME_STATUS_FAILED
+	}
+	k.resolvePacket(cachedCtx, packet, status, ack.GetError())
+	return nil
+}
+
+// IBCOnTimeoutPacketCallback implements the ContractKeeper interface. It runs
+// after the underlying application refunded the packet. The refund of a
+// tracked transfer is burned by the optio IBC middleware.
+func (k Keeper) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	if err := authenticateCallback(packet.SourcePort, contractAddress, packetSenderAddress); err != nil {
+		return err
+	}
+
+	if packet.SourcePort != transfertypes.PortID {
+		k.OnRemoteDistributionTimeout(cachedCtx, packet.SourceChannel, packet.Sequence)
+	}
+
+	k.resolvePacket(cachedCtx, packet, types.PACKET_OUTCOME_STATUS_TIMEOUT, "")
+	return nil
+}
+
+// IBCReceivePacketCallback implements the ContractKeeper interface. The
+// module does not act on the packets it receives through callbacks.
+func (k Keeper) IBCReceivePacketCallback(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidCallback, "destination callbacks are not supported")
+}
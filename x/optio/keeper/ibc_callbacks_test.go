@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestIBCCallbacks(t *testing.T) {
+	distributor := sample.AccAddress()
+	callback, sender := types.CallbackAddress.String(), types.TransferSenderAddress.String()
+
+	setup := func(t *testing.T, transfers int) (keeper.Keeper, sdk.Context, *keepertest.TransferKeeper) {
+		k, ctx, _, transfer := keepertest.OptioKeeperWithTransfer(t)
+		params := types.DefaultParams()
+		params.AuthorizedAccounts = []string{distributor}
+		require.NoError(t, k.SetParams(ctx, params))
+
+		ms := keeper.NewMsgServerImpl(k)
+		for i := 0; i < transfers; i++ {
+			_, err := ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(10), []*types.Recipient{
+				{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(10)},
+			}))
+			require.NoError(t, err)
+		}
+		return k, ctx, transfer
+	}
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{Sequence: sequence, SourcePort: transfertypes.PortID, SourceChannel: "channel-0"}
+	}
+	ack := func(err error) []byte {
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err).Acknowledgement()
+		}
+		return channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	}
+	pending := func(t *testing.T, k keeper.Keeper, ctx sdk.Context) []uint64 {
+		res, err := k.PendingPayouts(ctx, &types.QueryPendingPayoutsRequest{})
+		require.NoError(t, err)
+		sequences := []uint64{}
+		for _, outcome := range res.PacketOutcome {
+			sequences = append(sequences, outcome.Sequence)
+		}
+		return sequences
+	}
+
+	t.Run("send callback authenticates the module", func(t *testing.T) {
+		k, ctx, _ := setup(t, 0)
+		send := func(port, contract, packetSender string) error {
+			return k.IBCSendPacketCallback(ctx, port, "channel-0", clienttypes.ZeroHeight(), 1, nil, contract, packetSender)
+		}
+
+		require.NoError(t, send(transfertypes.PortID, callback, sender))
+		require.NoError(t, send(types.RemoteTreasuryPortID(), callback, types.RemoteTreasuryOwner))
+		require.ErrorIs(t, send(transfertypes.PortID, callback, sample.AccAddress()), types.ErrInvalidCallback)
+		require.ErrorIs(t, send(transfertypes.PortID, sample.AccAddress(), sender), types.ErrInvalidCallback)
+		require.ErrorIs(t, send(types.RemoteTreasuryPortID(), callback, sender), types.ErrInvalidCallback)
+		require.ErrorIs(t, send(types.PortID, callback, sender), types.ErrInvalidCallback)
+	})
+
+	t.Run("records the outcome of transfers", func(t *testing.T) {
+		k, ctx, transfer := setup(t, 3)
+		require.Equal(t, []uint64{1, 2, 3}, pending(t, k, ctx))
+
+		// the optio IBC middleware updates the transfers ahead of the callbacks
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+		require.NoError(t, k.OnTransferAcknowledged(ctx, "channel-0", 1, true))
+		require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet(1), ack(nil), nil, callback, sender))
+		require.NoError(t, transfer.Refund(transfer.Sent[1]))
+		require.NoError(t, k.OnTransferAcknowledged(ctx, "channel-0", 2, false))
+		require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet(2), ack(errors.New("invalid receiver")), nil, callback, sender))
+		require.Equal(t, []uint64{3}, pending(t, k, ctx))
+
+		outcome, found := k.GetPacketOutcome(ctx, transfertypes.PortID, "channel-0", 1)
+		require.True(t, found)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_ACKNOWLEDGED, outcome.Status)
+		require.Equal(t, ctx.BlockHeight(), outcome.Height)
+		require.Equal(t, ctx.BlockHeight()-5, outcome.SendHeight)
+		transferPacket, _ := k.GetTransferPacket(ctx, "channel-0", 1)
+		require.Equal(t, types.TRANSFER_STATUS_COMPLETED, transferPacket.Status)
+
+		outcome, _ = k.GetPacketOutcome(ctx, transfertypes.PortID, "channel-0", 2)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_FAILED, outcome.Status)
+		require.NotEmpty(t, outcome.Error)
+		transferPacket, _ = k.GetTransferPacket(ctx, "channel-0", 2)
+		require.Equal(t, types.TRANSFER_STATUS_REFUNDED_ERROR, transferPacket.Status)
+
+		require.NoError(t, transfer.Refund(transfer.Sent[2]))
+		require.NoError(t, k.OnTransferTimeout(ctx, "channel-0", 3))
+		require.NoError(t, k.IBCOnTimeoutPacketCallback(ctx, packet(3), nil, callback, sender))
+		outcome, _ = k.GetPacketOutcome(ctx, transfertypes.PortID, "channel-0", 3)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_TIMEOUT, outcome.Status)
+		require.Empty(t, pending(t, k, ctx))
+		require.Equal(t, math.NewInt(20), k.GetSupplyRecord(ctx).Burned)
+
+		// a second callback for the same packet is a no-op
+		require.NoError(t, k.IBCOnTimeoutPacketCallback(ctx, packet(1), nil, callback, sender))
+		outcome, _ = k.GetPacketOutcome(ctx, transfertypes.PortID, "channel-0", 1)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_ACKNOWLEDGED, outcome.Status)
+	})
+
+	t.Run("rejects callbacks of other senders", func(t *testing.T) {
+		k, ctx, _ := setup(t, 1)
+
+		err := k.IBCOnAcknowledgementPacketCallback(ctx, packet(1), ack(nil), nil, callback, sample.AccAddress())
+		require.ErrorIs(t, err, types.ErrInvalidCallback)
+		err = k.IBCOnTimeoutPacketCallback(ctx, packet(1), nil, sample.AccAddress(), sender)
+		require.ErrorIs(t, err, types.ErrInvalidCallback)
+		require.Equal(t, []uint64{1}, pending(t, k, ctx))
+
+		err = k.IBCReceivePacketCallback(ctx, packet(1), channeltypes.NewResultAcknowledgement([]byte{1}), callback)
+		require.ErrorIs(t, err, types.ErrInvalidCallback)
+	})
+
+	t.Run("paginates the pending payouts", func(t *testing.T) {
+		k, ctx, _ := setup(t, 5)
+		require.NoError(t, k.IBCOnAcknowledgementPacketCallback(ctx, packet(2), ack(nil), nil, callback, sender))
+
+		var sequences []uint64
+		var next []byte
+		for {
+			res, err := k.PendingPayouts(ctx, &types.QueryPendingPayoutsRequest{
+				Pagination: &query.PageRequest{Key: next, Limit: 2, CountTotal: next == nil},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.PacketOutcome), 2)
+			if next == nil {
+				require.Equal(t, uint64(4), res.Pagination.Total)
+			}
+			for _, outcome := range res.PacketOutcome {
+				require.True(t, outcome.IsPending())
+				sequences = append(sequences, outcome.Sequence)
+			}
+			if next = res.Pagination.NextKey; next == nil {
+				break
+			}
+		}
+		require.Equal(t, []uint64{1, 3, 4, 5}, sequences)
+
+		_, err := k.PendingPayouts(ctx, nil)
+		require.Error(t, err)
+	})
+}
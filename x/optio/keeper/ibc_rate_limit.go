@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// currentRateLimit returns the rate limit of a channel with its window rolled
+// forward to the block time. A bucket opened at the block time records the
+// current supply of the module denom.
+func (k Keeper) currentRateLimit(ctx context.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID)
+	if !found {
+		return rateLimit, false
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return rateLimit.Advance(blockTime, k.bankKeeper.GetSupply(ctx, denom).Amount), true
+}
+
+// RateLimitSend counts an ICS-20 transfer sent over a channel in its
+// outflow. Only transfers of the module denom over rate limited channels are
+// counted; the others are let through. The transfer is tracked until it is
+// acknowledged, so that a refund reverts the outflow.
+func (k Keeper) RateLimitSend(ctx context.Context, channelID string, sequence uint64, denom string, amount math.Int) error {
+	if denom != k.GetParams(ctx).Denom {
+		return nil
+	}
+	rateLimit, found := k.currentRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit, err := rateLimit.AddOutflow(amount)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrRateLimitExceeded, err.Error())
+	}
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetRateLimitPendingPacket(ctx, types.RateLimitPendingPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		Amount:      amount,
+		BucketStart: rateLimit.CurrentBucketStart(),
+	})
+	return nil
+}
+
+// RateLimitRecv counts an ICS-20 transfer received over a channel in its
+// inflow. denom is the denom credited on this chain; only the module denom is
+// rate limited.
+func (k Keeper) RateLimitRecv(ctx context.Context, channelID, denom string, amount math.Int) error {
+	if denom != k.GetParams(ctx).Denom {
+		return nil
+	}
+	rateLimit, found := k.currentRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit, err := rateLimit.AddInflow(amount)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrRateLimitExceeded, err.Error())
+	}
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// OnRateLimitedPacketAcknowledged stops tracking a transfer counted in the
+// outflow of its channel. The outflow is reverted for error acknowledgements,
+// which refund the sender, unless the bucket it was counted in has left the
+// window since.
+func (k Keeper) OnRateLimitedPacketAcknowledged(ctx context.Context, channelID string, sequence uint64, success bool) {
+	packet, found := k.GetRateLimitPendingPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemoveRateLimitPendingPacket(ctx, channelID, sequence)
+	if success {
+		return
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, channelID)
+	if !found {
+		return
+	}
+	k.SetRateLimit(ctx, rateLimit.RevertOutflow(packet.BucketStart, packet.Amount))
+}
+
+// OnRateLimitedPacketTimeout reverts the outflow of a transfer that timed
+// out, see OnRateLimitedPacketAcknowledged.
+func (k Keeper) OnRateLimitedPacketTimeout(ctx context.Context, channelID string, sequence uint64) {
+	k.OnRateLimitedPacketAcknowledged(ctx, channelID, sequence, false)
+}
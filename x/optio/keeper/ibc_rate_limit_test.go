@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestRateLimit(t *testing.T) {
+	const channel = "channel-0"
+
+	// the supply is 1000, 10% caps the net flow at 100
+	setup := func(t *testing.T, quota types.RateLimitQuota) (keeper.Keeper, sdk.Context, string) {
+		k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+		denom := k.GetParams(ctx).Denom
+		require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+		_, err := keeper.NewMsgServerImpl(k).SetRateLimit(ctx, types.NewMsgSetRateLimit(k.GetAuthority(), channel, quota))
+		require.NoError(t, err)
+		return k, ctx, denom
+	}
+	percent := types.RateLimitQuota{MaxPercent: math.LegacyNewDec(10), MaxAmount: math.ZeroInt(), Window: time.Hour}
+	flow := func(k keeper.Keeper, ctx sdk.Context) types.RateLimitFlow {
+		rateLimit, found := k.GetRateLimit(ctx, channel)
+		require.True(t, found)
+		return rateLimit.Flow
+	}
+
+	t.Run("caps the net outflow", func(t *testing.T) {
+		k, ctx, denom := setup(t, percent)
+		require.Equal(t, math.NewInt(1000), flow(k, ctx).Supply)
+
+		require.NoError(t, k.RateLimitSend(ctx, channel, 1, denom, math.NewInt(60)))
+		require.ErrorIs(t, k.RateLimitSend(ctx, channel, 2, denom, math.NewInt(41)), types.ErrRateLimitExceeded)
+
+		// inflows make room for outflows
+		require.NoError(t, k.RateLimitRecv(ctx, channel, denom, math.NewInt(20)))
+		require.NoError(t, k.RateLimitSend(ctx, channel, 2, denom, math.NewInt(60)))
+		require.Equal(t, math.NewInt(120), flow(k, ctx).Outflow)
+		require.Equal(t, math.NewInt(20), flow(k, ctx).Inflow)
+	})
+
+	t.Run("caps the net inflow", func(t *testing.T) {
+		k, ctx, denom := setup(t, percent)
+
+		require.NoError(t, k.RateLimitRecv(ctx, channel, denom, math.NewInt(100)))
+		require.ErrorIs(t, k.RateLimitRecv(ctx, channel, denom, math.NewInt(1)), types.ErrRateLimitExceeded)
+	})
+
+	t.Run("lower cap applies", func(t *testing.T) {
+		quota := percent
+		quota.MaxAmount = math.NewInt(50)
+		k, ctx, denom := setup(t, quota)
+
+		require.ErrorIs(t, k.RateLimitSend(ctx, channel, 1, denom, math.NewInt(51)), types.ErrRateLimitExceeded)
+		require.NoError(t, k.RateLimitSend(ctx, channel, 1, denom, math.NewInt(50)))
+	})
+
+	t.Run("other denoms and channels are not limited", func(t *testing.T) {
+		k, ctx, denom := setup(t, percent)
+
+		require.NoError(t, k.RateLimitSend(ctx, channel, 1, "stake", math.NewInt(5000)))
+		require.NoError(t, k.RateLimitRecv(ctx, channel, "stake", math.NewInt(5000)))
+		require.NoError(t, k.RateLimitSend(ctx, "channel-1", 1, denom, math.NewInt(5000)))
+		require.True(t, flow(k, ctx).Outflow.IsZero())
+		require.Empty(t, k.GetAllRateLimitPendingPacket(ctx))
+	})
+
+	t.Run("rolling window", func(t *testing.T) {
+		k, ctx, denom := setup(t, percent)
+		start := ctx.BlockTime()
+		require.NoError(t, k.RateLimitSend(ctx, channel, 1, denom, math.NewInt(100)))
+
+		// a fixed window started with the first transfer would reset after an
+		// hour, the rolling window still counts it
+		bucket := start.Truncate(percent.BucketDuration())
+		for _, blockTime := range []time.Time{bucket.Add(time.Hour - time.Second), bucket.Add(time.Hour + time.Second)} {
+			ctx = ctx.WithBlockTime(blockTime)
+			require.ErrorIs(t, k.RateLimitSend(ctx, channel, 2, denom, math.NewInt(1)), types.ErrRateLimitExceeded)
+		}
+		require.Equal(t, math.NewInt(100), flow(k, ctx).Outflow)
+		require.Equal(t, bucket, flow(k, ctx).WindowStart)
+
+		// the bucket of the first transfer leaves the window a full window
+		// after it ended
+		ctx = ctx.WithBlockTime(bucket.Add(time.Hour + percent.BucketDuration()))
+		require.NoError(t, k.RateLimitSend(ctx, channel, 2, denom, math.NewInt(100)))
+		require.Equal(t, math.NewInt(100), flow(k, ctx).Outflow)
+		require.Equal(t, math.NewInt(1000), flow(k, ctx).Supply)
+
+		// the refund of a packet of a bucket out of the window is not reverted
+		k.OnRateLimitedPacketTimeout(ctx, channel, 1)
+		require.Equal(t, math.NewInt(100), flow(k, ctx).Outflow)
+		k.OnRateLimitedPacketTimeout(ctx, channel, 2)
+		require.True(t, flow(k, ctx).Outflow.IsZero())
+	})
+
+	t.Run("refunds revert the outflow", func(t *testing.T) {
+		k, ctx, denom := setup(t, percent)
+		require.NoError(t, k.RateLimitSend(ctx, channel, 1, denom, math.NewInt(40)))
+		require.NoError(t, k.RateLimitSend(ctx, channel, 2, denom, math.NewInt(30)))
+		require.NoError(t, k.RateLimitSend(ctx, channel, 3, denom, math.NewInt(20)))
+		require.Len(t, k.GetAllRateLimitPendingPacket(ctx), 3)
+
+		k.OnRateLimitedPacketAcknowledged(ctx, channel, 1, true)
+		k.OnRateLimitedPacketAcknowledged(ctx, channel, 2, false)
+		k.OnRateLimitedPacketTimeout(ctx, channel, 3)
+		require.Equal(t, math.NewInt(40), flow(k, ctx).Outflow)
+		require.Empty(t, k.GetAllRateLimitPendingPacket(ctx))
+
+		// a second callback for the same packet is a no-op
+		k.OnRateLimitedPacketTimeout(ctx, channel, 3)
+		require.Equal(t, math.NewInt(40), flow(k, ctx).Outflow)
+	})
+}
+
+func TestMsgRateLimit(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	quota := types.RateLimitQuota{MaxPercent: math.LegacyZeroDec(), MaxAmount: math.NewInt(100), Window: time.Hour}
+
+	_, err := ms.SetRateLimit(ctx, types.NewMsgSetRateLimit("invalid", "channel-0", quota))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(k.GetAuthority(), "channel-0", quota))
+	require.NoError(t, err)
+	rateLimit, found := k.GetRateLimit(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, quota, rateLimit.Quota)
+
+	// updating the quota resets the flow
+	denom := k.GetParams(ctx).Denom
+	require.NoError(t, k.RateLimitSend(ctx, "channel-0", 1, denom, math.NewInt(60)))
+	quota.MaxAmount = math.NewInt(80)
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(k.GetAuthority(), "channel-0", quota))
+	require.NoError(t, err)
+	require.NoError(t, k.RateLimitSend(ctx, "channel-0", 2, denom, math.NewInt(80)))
+
+	_, err = ms.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(k.GetAuthority(), "channel-0"))
+	require.NoError(t, err)
+	_, found = k.GetRateLimit(ctx, "channel-0")
+	require.False(t, found)
+
+	_, err = ms.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(k.GetAuthority(), "channel-0"))
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// RegisterInvariants registers all optio invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reserved-transfers", ReservedTransfersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "distribution-totals", DistributionTotalsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the optio module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			SupplyInvariant(k),
+			MaxSupplyInvariant(k),
+			ReservedTransfersInvariant(k),
+			DistributionTotalsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// SupplyInvariant checks the supply record against the flows of the
+// module: the minted amount equals the distribution history and the burned
+// amount the refunded transfers. The bank supply of Params.Denom is not
+// compared, the mint, staking and gov modules mint and burn the same denom
+// when it is the bond denom.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		record := k.GetSupplyRecord(ctx)
+		minted, burned := types.ModuleFlows(k.GetAllDistribution(ctx), k.GetAllTransferPacket(ctx))
+
+		broken := !record.Minted.Equal(minted) || !record.Burned.Equal(burned)
+		return sdk.FormatInvariant(
+			types.ModuleName, "supply",
+			fmt.Sprintf("\tminted %s, distributed %s\n\tburned %s, refunded transfers %s\n",
+				record.Minted, minted, record.Burned, burned),
+		), broken
+	}
+}
+
+// MaxSupplyInvariant checks that the recorded supply never exceeds
+// Params.MaxSupply.
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		supply := k.GetSupplyRecord(ctx).Supply()
+
+		broken := supply.GT(params.MaxSupply)
+		return sdk.FormatInvariant(
+			types.ModuleName, "max-supply",
+			fmt.Sprintf("\tsupply %s, max supply %s\n", supply, params.MaxSupply),
+		), broken
+	}
+}
+
+// ReservedTransfersInvariant checks that the amounts reserved for pending
+// transfers to cross-chain recipients are covered by the ICS-20 escrow of
+// their channels, so that a timeout or an error acknowledgement can refund
+// and burn them.
+func ReservedTransfersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reserved := make(map[string]sdk.Coins)
+		for _, packet := range k.GetAllTransferPacket(ctx) {
+			if packet.IsPending() {
+				reserved[packet.Channel] = reserved[packet.Channel].Add(packet.Token)
+			}
+		}
+
+		channels := make([]string, 0, len(reserved))
+		for channel := range reserved {
+			channels = append(channels, channel)
+		}
+		sort.Strings(channels)
+
+		var (
+			msg    string
+			broken bool
+		)
+		for _, channel := range channels {
+			escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, channel)
+			for _, coin := range reserved[channel] {
+				balance := k.bankKeeper.GetBalance(ctx, escrow, coin.Denom)
+				if balance.Amount.LT(coin.Amount) {
+					broken = true
+					msg += fmt.Sprintf("\tpending transfers over %s reserve %s, escrow holds %s\n", channel, coin, balance)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reserved-transfers", msg), broken
+	}
+}
+
+// DistributionTotalsInvariant checks that every distribution in the history
+// pays out its amount to its recipients and that the distributor stats sum
+// up the history.
+func DistributionTotalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		distributions := k.GetAllDistribution(ctx)
+		for _, distribution := range distributions {
+			if err := distribution.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tdistribution %d: %s\n", distribution.Id, err)
+			}
+		}
+
+		_, perDistributor := types.DistributionTotals(distributions)
+		if err := types.CheckDistributorStats(k.GetAllDistributorStats(ctx), perDistributor); err != nil {
+			broken = true
+			msg += fmt.Sprintf("\t%s\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "distribution-totals", msg), broken
+	}
+}
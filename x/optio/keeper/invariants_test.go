@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestInvariants(t *testing.T) {
+	pendingTransfer := func(params types.Params, amount int64) types.TransferPacket {
+		return types.TransferPacket{
+			Channel:  "channel-0",
+			Sequence: 1,
+			Receiver: "cosmos1receiver",
+			Token:    sdk.NewInt64Coin(params.Denom, amount),
+			Status:   types.TRANSFER_STATUS_PENDING,
+		}
+	}
+
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.BankKeeper, types.Params) {
+		k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+		ms := keeper.NewMsgServerImpl(k)
+
+		distributor := sample.AccAddress()
+		params := types.DefaultParams()
+		params.AuthorizedAccounts = []string{distributor}
+		params.MaxSupply = math.NewInt(1000)
+		require.NoError(t, k.SetParams(ctx, params))
+
+		for _, amount := range []int64{10, 20} {
+			_, err := ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(amount), []*types.Recipient{
+				{Address: sample.AccAddress(), Amount: math.NewInt(amount)},
+			}))
+			require.NoError(t, err)
+		}
+		return k, ctx, bank, params
+	}
+
+	testCases := []struct {
+		name   string
+		breaks func(k keeper.Keeper, ctx sdk.Context, bank *keepertest.BankKeeper, params types.Params)
+		broken func(keeper.Keeper) sdk.Invariant
+	}{
+		{
+			name:   "intact",
+			breaks: func(keeper.Keeper, sdk.Context, *keepertest.BankKeeper, types.Params) {},
+		},
+		{
+			// the mint, staking and gov modules mint and burn the bond denom
+			name: "minted and burned outside the module",
+			breaks: func(_ keeper.Keeper, ctx sdk.Context, bank *keepertest.BankKeeper, params types.Params) {
+				require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 100))))
+				require.NoError(t, bank.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 40))))
+			},
+		},
+		{
+			name: "minted without a distribution",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, _ types.Params) {
+				k.AddMinted(ctx, math.NewInt(1))
+			},
+			broken: keeper.SupplyInvariant,
+		},
+		{
+			name: "burned without a refund",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, _ types.Params) {
+				k.AddBurned(ctx, math.NewInt(1))
+			},
+			broken: keeper.SupplyInvariant,
+		},
+		{
+			name: "pending transfer covered by the escrow",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, bank *keepertest.BankKeeper, params types.Params) {
+				k.SetTransferPacket(ctx, pendingTransfer(params, 5))
+				escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+				require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 5))))
+				require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrow, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 5))))
+			},
+		},
+		{
+			name: "pending transfer above the escrow",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, params types.Params) {
+				k.SetTransferPacket(ctx, pendingTransfer(params, 5))
+			},
+			broken: keeper.ReservedTransfersInvariant,
+		},
+		{
+			name: "max supply lowered below supply",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, params types.Params) {
+				params.MaxSupply = math.NewInt(29)
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			broken: keeper.MaxSupplyInvariant,
+		},
+		{
+			name: "stats out of sync with history",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, params types.Params) {
+				k.AddDistributorUsage(ctx, params.AuthorizedAccounts[0], math.NewInt(1))
+			},
+			broken: keeper.DistributionTotalsInvariant,
+		},
+		{
+			name: "recipients do not sum up",
+			breaks: func(k keeper.Keeper, ctx sdk.Context, _ *keepertest.BankKeeper, _ types.Params) {
+				distribution, found := k.GetDistribution(ctx, 0)
+				require.True(t, found)
+				distribution.Recipients[0].Amount = math.NewInt(9)
+				k.SetDistribution(ctx, distribution)
+			},
+			broken: keeper.DistributionTotalsInvariant,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, bank, params := setup(t)
+			tc.breaks(k, ctx, bank, params)
+
+			_, stop := keeper.AllInvariants(k)(ctx)
+			require.Equal(t, tc.broken != nil, stop)
+			if tc.broken != nil {
+				res, stop := tc.broken(k)(ctx)
+				require.True(t, stop, res)
+			}
+		})
+	}
+}
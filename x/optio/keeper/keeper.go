@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		bankKeeper     types.BankKeeper
+		feegrantKeeper types.FeegrantKeeper
+		// transferKeeperFn returns the IBC transfer keeper, which is created
+		// after the module. It is nil when the app doesn't wire IBC.
+		transferKeeperFn types.TransferKeeperFn
+
+		// the IBC keeper and the capability scoped keepers of the optio IBC
+		// application, nil when the app doesn't wire IBC.
+		ibcKeeperFn        func() *ibckeeper.Keeper
+		capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper
+
+		// icaControllerKeeperFn returns the interchain accounts controller
+		// keeper of the remote treasuries, nil when the app doesn't wire IBC.
+		icaControllerKeeperFn types.ICAControllerKeeperFn
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+
+	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	transferKeeperFn types.TransferKeeperFn,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
+	icaControllerKeeperFn types.ICAControllerKeeperFn,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+
+		bankKeeper:       bankKeeper,
+		feegrantKeeper:   feegrantKeeper,
+		transferKeeperFn: transferKeeperFn,
+
+		ibcKeeperFn:        ibcKeeperFn,
+		capabilityScopedFn: capabilityScopedFn,
+
+		icaControllerKeeperFn: icaControllerKeeperFn,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// transferKeeper returns the IBC transfer keeper, or nil if it isn't wired.
+func (k Keeper) transferKeeper() types.TransferKeeper {
+	if k.transferKeeperFn == nil {
+		return nil
+	}
+	return k.transferKeeperFn()
+}
+
+// icaControllerKeeper returns the interchain accounts controller keeper, or
+// nil if it isn't wired.
+func (k Keeper) icaControllerKeeper() types.ICAControllerKeeper {
+	if k.icaControllerKeeperFn == nil {
+		return nil
+	}
+	return k.icaControllerKeeperFn()
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ShouldBound checks if the IBC app module can be bound to the desired port
+func (k Keeper) ShouldBound(ctx sdk.Context, portID string) bool {
+	scopedKeeper := k.ScopedKeeper()
+	if scopedKeeper == nil {
+		return false
+	}
+	_, ok := scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return !ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	portCap := k.ibcKeeperFn().PortKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the IBC app module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the IBC app module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.ScopedKeeper().AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the IBC app module to claim a capability that core IBC
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.ScopedKeeper().ClaimCapability(ctx, cap, name)
+}
+
+// ScopedKeeper returns the capability scoped keeper of the module, or nil if
+// the app doesn't wire IBC.
+func (k Keeper) ScopedKeeper() types.ScopedKeeper {
+	if k.capabilityScopedFn == nil {
+		return nil
+	}
+	return k.capabilityScopedFn(types.ModuleName)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// FanOutTransfer pays the token of an ICS-20 transfer received by the memo
+// hook address out to the recipients listed in its memo. The recipients are
+// subject to the compliance policy and the recipient cap of the params. Any
+// error fails the packet, which reverts the transfer and refunds the sender.
+func (k Keeper) FanOutTransfer(ctx context.Context, channelID, sender string, token sdk.Coin, hook types.MemoHook) error {
+	params := k.GetParams(ctx)
+	if err := hook.Validate(token.Amount, params.MaxRecipientsPerMsg); err != nil {
+		return err
+	}
+	if err := k.CheckRecipients(ctx, params, hook.Addresses()); err != nil {
+		return err
+	}
+
+	// the module sends the payouts so that blocked addresses are refused
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.MemoHookAddress, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, recipient := range hook.Distribute {
+		amount, _ := recipient.Int()
+		coin := sdk.NewCoin(token.Denom, amount)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(recipient.Address), sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMemoHook,
+				sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+				sdk.NewAttribute(types.AttributeKeySender, sender),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestFanOutTransfer(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	token := sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 100)
+	hook := types.MemoHook{Distribute: []types.MemoHookRecipient{{Address: alice, Amount: "60"}, {Address: bob, Amount: "40"}}}
+
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.BankKeeper) {
+		k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+		// the transfer application credited the memo hook address
+		require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)))
+		require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.MemoHookAddress, sdk.NewCoins(token)))
+		return k, ctx, bank
+	}
+
+	t.Run("pays the recipients", func(t *testing.T) {
+		k, ctx, bank := setup(t)
+		require.NoError(t, k.FanOutTransfer(ctx, "channel-0", "cosmos1sender", token, hook))
+
+		require.Equal(t, math.NewInt(60), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(alice), token.Denom).Amount)
+		require.Equal(t, math.NewInt(40), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(bob), token.Denom).Amount)
+		require.True(t, bank.GetBalance(ctx, types.MemoHookAddress, token.Denom).IsZero())
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+		require.Equal(t, types.EventTypeMemoHook, events[0].Type)
+	})
+
+	t.Run("invalid instructions", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+		invalid := types.MemoHook{Distribute: []types.MemoHookRecipient{{Address: alice, Amount: "60"}}}
+		require.ErrorIs(t, k.FanOutTransfer(ctx, "channel-0", "cosmos1sender", token, invalid), types.ErrInvalidAmount)
+	})
+
+	t.Run("compliance policy", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+		params := k.GetParams(ctx)
+		params.AllowlistEnabled = true
+		require.NoError(t, k.SetParams(ctx, params))
+		k.SetAddressStatus(ctx, types.AddressStatus{Address: bob, Status: types.COMPLIANCE_STATUS_ALLOWED})
+
+		err := k.FanOutTransfer(ctx, "channel-0", "cosmos1sender", token, hook)
+		require.ErrorIs(t, err, types.ErrRecipientRejected)
+		require.ErrorContains(t, err, alice)
+		require.NotContains(t, err.Error(), bob)
+	})
+}
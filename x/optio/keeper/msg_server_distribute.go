@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) Distribute(goCtx context.Context, msg *types.MsgDistribute) (*types.MsgDistributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.IsAuthorized(msg.FromAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s", msg.FromAddress)
+	}
+
+	id, err := k.executeDistribution(ctx, params, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDistributeResponse{DistributionId: id}, nil
+}
+
+// executeDistribution mints and pays out a distribution made by an
+// authorized distributor, either a local account or a remote controller.
+func (k Keeper) executeDistribution(ctx sdk.Context, params types.Params, msg *types.MsgDistribute) (uint64, error) {
+	numRecipients := uint64(len(msg.Recipients))
+	if params.MaxRecipientsPerMsg != 0 && numRecipients > params.MaxRecipientsPerMsg {
+		return 0, errorsmod.Wrapf(types.ErrTooManyRecipients, "%d recipients, max %d per message", numRecipients, params.MaxRecipientsPerMsg)
+	}
+
+	// charge per recipient up front so fees scale linearly with batch size
+	gas, err := params.DistributeGas(numRecipients)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrTooManyRecipients, err.Error())
+	}
+	ctx.GasMeter().ConsumeGas(gas, "optio distribute recipients")
+
+	if err := msg.ValidateMetadataLength(params); err != nil {
+		return 0, err
+	}
+
+	if err := k.CheckRecipients(ctx, params, msg.RecipientAddresses()); err != nil {
+		return 0, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.Denom)
+	if supply.Amount.Add(msg.Amount).GT(params.MaxSupply) {
+		return 0, errorsmod.Wrapf(
+			types.ErrMaxSupplyExceeded,
+			"current supply %s plus %s exceeds max supply %s", supply.Amount, msg.Amount, params.MaxSupply,
+		)
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(params.Denom, msg.Amount))); err != nil {
+		return 0, err
+	}
+	k.AddMinted(ctx, msg.Amount)
+
+	id := k.AppendDistribution(ctx, types.Distribution{
+		Distributor: msg.FromAddress,
+		Amount:      msg.Amount,
+		Recipients:  types.RecipientValues(msg.Recipients),
+		Category:    msg.Category,
+		Memo:        msg.Memo,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+	})
+	k.AddDistributorUsage(ctx, msg.FromAddress, msg.Amount)
+
+	for _, recipient := range msg.Recipients {
+		coins := sdk.NewCoins(sdk.NewCoin(params.Denom, recipient.Amount))
+		if recipient.IsCrossChain() {
+			if _, err := k.SendTransfer(ctx, params, id, *recipient); err != nil {
+				return 0, err
+			}
+		} else {
+			addr, err := sdk.AccAddressFromBech32(recipient.Address)
+			if err != nil {
+				return 0, err
+			}
+
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+				return 0, err
+			}
+			if err := k.GrantRecipientFeeAllowance(ctx, params, addr); err != nil {
+				return 0, err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistribute,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDistributor, msg.FromAddress),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Account()),
+				sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+				sdk.NewAttribute(types.AttributeKeyReference, recipient.Reference),
+				sdk.NewAttribute(types.AttributeKeyCategory, msg.Category),
+			),
+		)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMsgDistribute(t *testing.T) {
+	k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor := sample.AccAddress()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+
+	// amounts beyond uint64 must round-trip without overflow
+	big, ok := math.NewIntFromString("50000000000000000000000000")
+	require.True(t, ok)
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	params.MaxSupply = big.MulRaw(2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgDistribute
+		expErr error
+	}{
+		{
+			name: "unauthorized",
+			msg: types.NewMsgDistribute(alice, math.NewInt(10), []*types.Recipient{
+				{Address: bob, Amount: math.NewInt(10)},
+			}),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name: "large distribution",
+			msg: &types.MsgDistribute{
+				FromAddress: distributor,
+				Amount:      big.AddRaw(5),
+				Recipients: []*types.Recipient{
+					{Address: alice, Amount: big, Reference: "INV-001"},
+					{Address: bob, Amount: math.NewInt(5), Reference: "INV-002"},
+				},
+				Category: "payroll",
+				Memo:     "October payroll",
+			},
+		},
+		{
+			name: "reference too long",
+			msg: types.NewMsgDistribute(distributor, math.NewInt(1), []*types.Recipient{
+				{Address: alice, Amount: math.NewInt(1), Reference: strings.Repeat("x", int(params.MaxReferenceLength)+1)},
+			}),
+			expErr: types.ErrInvalidMetadata,
+		},
+		{
+			name: "exceeds max supply",
+			msg: types.NewMsgDistribute(distributor, big, []*types.Recipient{
+				{Address: alice, Amount: big},
+			}),
+			expErr: types.ErrMaxSupplyExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.Distribute(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	require.Equal(t, big, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(alice), params.Denom).Amount)
+	require.Equal(t, math.NewInt(5), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(bob), params.Denom).Amount)
+	require.Equal(t, big.AddRaw(5), bank.GetSupply(ctx, params.Denom).Amount)
+}
+
+func TestMsgDistributeHistory(t *testing.T) {
+	k, ctx, _ := keepertest.OptioKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	msg := &types.MsgDistribute{
+		FromAddress: distributor,
+		Amount:      math.NewInt(100),
+		Recipients:  []*types.Recipient{{Address: recipient, Amount: math.NewInt(100), Reference: "INV-42"}},
+		Category:    "grants",
+		Memo:        "Q3 grant",
+	}
+	res, err := ms.Distribute(ctx, msg)
+	require.NoError(t, err)
+
+	distribution, found := k.GetDistribution(ctx, res.DistributionId)
+	require.True(t, found)
+	require.Equal(t, distributor, distribution.Distributor)
+	require.Equal(t, "grants", distribution.Category)
+	require.Equal(t, "Q3 grant", distribution.Memo)
+	require.Equal(t, types.RecipientValues(msg.Recipients), distribution.Recipients)
+
+	stats, found := k.GetDistributorStats(ctx, distributor)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), stats.TotalDistributed)
+	require.Equal(t, uint64(1), stats.DistributionCount)
+}
+
+func TestMsgDistributeRecipientLimits(t *testing.T) {
+	k, ctx, _ := keepertest.OptioKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	params.MaxRecipientsPerMsg = 3
+	params.GasPerRecipient = 1000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	recipients := func(n int) []*types.Recipient {
+		rs := make([]*types.Recipient, n)
+		for i := range rs {
+			rs[i] = &types.Recipient{Address: sample.AccAddress(), Amount: math.NewInt(1)}
+		}
+		return rs
+	}
+
+	_, err := ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(4), recipients(4)))
+	require.ErrorIs(t, err, types.ErrTooManyRecipients)
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(1), recipients(1)))
+	require.NoError(t, err)
+	single := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = ms.Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(3), recipients(3)))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-single, 2*params.GasPerRecipient)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) RegisterFeeSponsor(goCtx context.Context, msg *types.MsgRegisterFeeSponsor) (*types.MsgRegisterFeeSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetFeeSponsor(ctx, types.FeeSponsor{Address: msg.Sponsor, Height: ctx.BlockHeight()})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeSponsor,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Sponsor),
+		),
+	)
+
+	return &types.MsgRegisterFeeSponsorResponse{}, nil
+}
+
+func (k msgServer) UnregisterFeeSponsor(goCtx context.Context, msg *types.MsgUnregisterFeeSponsor) (*types.MsgUnregisterFeeSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetFeeSponsor(ctx, msg.Sponsor); !found {
+		return nil, errorsmod.Wrap(types.ErrFeeSponsorNotFound, msg.Sponsor)
+	}
+	// the allowances granted so far stay valid, the sponsor can revoke them
+	// with the feegrant module
+	k.RemoveFeeSponsor(ctx, msg.Sponsor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeSponsor,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyRemoved, "true"),
+		),
+	)
+
+	return &types.MsgUnregisterFeeSponsorResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the flow starts over with the new quota
+	supply := k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).Denom).Amount
+	rateLimit := types.NewRateLimit(msg.ChannelId, msg.Quota).Advance(ctx.BlockTime(), supply)
+	k.Keeper.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyMaxPercent, msg.Quota.MaxPercent.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, msg.Quota.MaxAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyWindow, msg.Quota.Window.String()),
+		),
+	)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+func (k msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.ChannelId); !found {
+		return nil, errorsmod.Wrap(types.ErrRateLimitNotFound, msg.ChannelId)
+	}
+	k.Keeper.RemoveRateLimit(ctx, msg.ChannelId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyRemoved, "true"),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) RegisterRemoteTreasury(goCtx context.Context, msg *types.MsgRegisterRemoteTreasury) (*types.MsgRegisterRemoteTreasuryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.FromAddress != k.GetAuthority() && !k.GetParams(ctx).IsAuthorized(msg.FromAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s", msg.FromAddress)
+	}
+	if err := k.Keeper.RegisterRemoteTreasury(ctx, msg.ConnectionId, msg.Version); err != nil {
+		return nil, err
+	}
+
+	portID := types.RemoteTreasuryPortID()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoteTreasury,
+			sdk.NewAttribute(types.AttributeKeyConnection, msg.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyPort, portID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress),
+		),
+	)
+
+	return &types.MsgRegisterRemoteTreasuryResponse{PortId: portID}, nil
+}
+
+func (k msgServer) RemoteDistribute(goCtx context.Context, msg *types.MsgRemoteDistribute) (*types.MsgRemoteDistributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if msg.FromAddress != k.GetAuthority() && !params.IsAuthorized(msg.FromAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s", msg.FromAddress)
+	}
+	if uint64(len(msg.Memo)) > params.MaxMemoLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "memo longer than %d bytes", params.MaxMemoLength)
+	}
+	if params.MaxRecipientsPerMsg != 0 && uint64(len(msg.Outputs)) > params.MaxRecipientsPerMsg {
+		return nil, errorsmod.Wrapf(types.ErrTooManyRecipients, "%d outputs, max %d", len(msg.Outputs), params.MaxRecipientsPerMsg)
+	}
+
+	distribution, err := k.SendRemoteDistribution(ctx, params, msg.FromAddress, msg.ConnectionId, msg.Outputs, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoteDistributeResponse{ChannelId: distribution.ChannelId, Sequence: distribution.Sequence}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) SendDistribution(goCtx context.Context, msg *types.MsgSendDistribution) (*types.MsgSendDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.IsAuthorized(msg.FromAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s", msg.FromAddress)
+	}
+	if !params.CrossChainEnabled() {
+		return nil, errorsmod.Wrapf(types.ErrCrossChainDisabled, "cannot send a distribution over %s", msg.ChannelId)
+	}
+
+	// the packet times out like the ICS-20 transfers to cross-chain recipients
+	timeout := ctx.BlockTime().Add(params.TransferTimeout)
+	packetData := msg.PacketData()
+	sequence, err := k.TransmitDistributionPacket(
+		ctx,
+		packetData,
+		k.GetPort(ctx),
+		msg.ChannelId,
+		clienttypes.ZeroHeight(),
+		uint64(timeout.UnixNano()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionPacket,
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, packetData.Amount.String()),
+		),
+	)
+
+	return &types.MsgSendDistributionResponse{Sequence: sequence}, nil
+}
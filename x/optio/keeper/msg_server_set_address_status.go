@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) SetAddressStatus(goCtx context.Context, msg *types.MsgSetAddressStatus) (*types.MsgSetAddressStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsComplianceAccount(msg.FromAddress) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a compliance account", msg.FromAddress)
+	}
+
+	for _, status := range msg.Statuses {
+		if status.Status == types.COMPLIANCE_STATUS_UNSPECIFIED {
+			k.RemoveAddressStatus(ctx, status.Address)
+		} else {
+			k.Keeper.SetAddressStatus(ctx, status)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetAddressStatus,
+				sdk.NewAttribute(types.AttributeKeyAddress, status.Address),
+				sdk.NewAttribute(types.AttributeKeyStatus, status.Status.String()),
+			),
+		)
+	}
+
+	return &types.MsgSetAddressStatusResponse{}, nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMsgSetAddressStatus(t *testing.T) {
+	k, ctx := keepertest.OptioKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	officer := sample.AccAddress()
+	address := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.ComplianceAccounts = []string{officer}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := ms.SetAddressStatus(ctx, types.NewMsgSetAddressStatus(sample.AccAddress(), []types.AddressStatus{
+		{Address: address, Status: types.COMPLIANCE_STATUS_DENIED},
+	}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.SetAddressStatus(ctx, types.NewMsgSetAddressStatus(officer, []types.AddressStatus{
+		{Address: address, Status: types.COMPLIANCE_STATUS_DENIED},
+	}))
+	require.NoError(t, err)
+	status, found := k.GetAddressStatus(ctx, address)
+	require.True(t, found)
+	require.Equal(t, types.COMPLIANCE_STATUS_DENIED, status.Status)
+
+	_, err = ms.SetAddressStatus(ctx, types.NewMsgSetAddressStatus(officer, []types.AddressStatus{
+		{Address: address, Status: types.COMPLIANCE_STATUS_UNSPECIFIED},
+	}))
+	require.NoError(t, err)
+	_, found = k.GetAddressStatus(ctx, address)
+	require.False(t, found)
+}
+
+func TestMsgDistributeCompliance(t *testing.T) {
+	k, ctx := keepertest.OptioKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor := sample.AccAddress()
+	allowed := sample.AccAddress()
+	denied := sample.AccAddress()
+	unknown := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetAddressStatus(ctx, types.AddressStatus{Address: allowed, Status: types.COMPLIANCE_STATUS_ALLOWED})
+	k.SetAddressStatus(ctx, types.AddressStatus{Address: denied, Status: types.COMPLIANCE_STATUS_DENIED})
+
+	distribute := func(recipients ...string) error {
+		msg := types.NewMsgDistribute(distributor, math.NewInt(int64(len(recipients))), nil)
+		for _, recipient := range recipients {
+			msg.Recipients = append(msg.Recipients, &types.Recipient{Address: recipient, Amount: math.OneInt()})
+		}
+		_, err := ms.Distribute(ctx, msg)
+		return err
+	}
+
+	// denylist only
+	require.NoError(t, distribute(allowed, unknown))
+	err := distribute(allowed, denied, unknown)
+	require.ErrorIs(t, err, types.ErrRecipientRejected)
+	require.Contains(t, err.Error(), "denied: "+denied)
+	require.NotContains(t, err.Error(), unknown)
+
+	// allowlist mode
+	params.AllowlistEnabled = true
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, distribute(allowed))
+	err = distribute(allowed, denied, unknown)
+	require.ErrorIs(t, err, types.ErrRecipientRejected)
+	require.Contains(t, err.Error(), "denied: "+denied)
+	require.Contains(t, err.Error(), "not allowlisted: "+unknown)
+}
+
+func TestMsgDistributeCrossChainCompliance(t *testing.T) {
+	k, ctx, _, transfer := keepertest.OptioKeeperWithTransfer(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	distributor := sample.AccAddress()
+	officer := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor}
+	params.ComplianceAccounts = []string{officer}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the statuses of foreign receivers are set like those of local addresses
+	msg := types.NewMsgSetAddressStatus(officer, []types.AddressStatus{
+		{Address: "osmo1allowed", Status: types.COMPLIANCE_STATUS_ALLOWED},
+		{Address: "osmo1denied", Status: types.COMPLIANCE_STATUS_DENIED},
+	})
+	require.NoError(t, msg.ValidateBasic())
+	_, err := ms.SetAddressStatus(ctx, msg)
+	require.NoError(t, err)
+
+	distribute := func(receivers ...string) error {
+		msg := types.NewMsgDistribute(distributor, math.NewInt(int64(len(receivers))), nil)
+		for _, receiver := range receivers {
+			msg.Recipients = append(msg.Recipients, &types.Recipient{SourceChannel: "channel-0", Receiver: receiver, Amount: math.OneInt()})
+		}
+		_, err := ms.Distribute(ctx, msg)
+		return err
+	}
+
+	// denylist only
+	require.NoError(t, distribute("osmo1allowed", "osmo1unknown"))
+	err = distribute("osmo1allowed", "osmo1denied")
+	require.ErrorIs(t, err, types.ErrRecipientRejected)
+	require.Contains(t, err.Error(), "denied: osmo1denied")
+
+	// allowlist mode
+	params.AllowlistEnabled = true
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, distribute("osmo1allowed"))
+	err = distribute("osmo1allowed", "osmo1unknown")
+	require.ErrorIs(t, err, types.ErrRecipientRejected)
+	require.Contains(t, err.Error(), "not allowlisted: osmo1unknown")
+
+	require.Len(t, transfer.Sent, 3)
+}
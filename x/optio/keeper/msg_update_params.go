@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// lowering the max supply below the current supply would break the
+	// max-supply invariant
+	if supply := k.GetSupplyRecord(ctx).Supply(); !req.Params.MaxSupply.IsNil() && supply.GT(req.Params.MaxSupply) {
+		return nil, errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "current supply %s exceeds max supply %s", supply, req.Params.MaxSupply)
+	}
+	// a sponsor pays for the allowances with its own balance and has to
+	// consent first
+	if sponsor := req.Params.FeeAllowanceSponsor; sponsor != "" {
+		if _, found := k.GetFeeSponsor(ctx, sponsor); !found {
+			return nil, errorsmod.Wrap(types.ErrFeeSponsorNotFound, sponsor)
+		}
+	}
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// TransmitDistributionPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitDistributionPacket(
+	ctx sdk.Context,
+	packetData types.DistributionPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	scopedKeeper := k.ScopedKeeper()
+	if scopedKeeper == nil || k.ibcKeeperFn == nil {
+		return 0, errorsmod.Wrap(types.ErrCrossChainDisabled, "IBC is not wired")
+	}
+
+	channelCap, ok := scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "cannot marshal the packet: %s", err)
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvDistributionPacket executes a distribution sent by the controller
+// chain of an approved channel as a MsgDistribute of the channel's remote
+// distributor. Recipients rejected by the compliance policy are skipped and
+// reported in the acknowledgement, the others are paid. The distribution
+// fails as a whole if it would exceed the channel quota.
+func (k Keeper) OnRecvDistributionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DistributionPacketData) (packetAck types.DistributionPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	params := k.GetParams(ctx)
+	controller, found := params.RemoteController(packet.DestinationChannel)
+	if !found {
+		return packetAck, errorsmod.Wrapf(types.ErrUnknownController, "%s", packet.DestinationChannel)
+	}
+
+	distributor := types.RemoteDistributorAddress(packet.DestinationChannel).String()
+	msg := data.MsgDistribute(distributor)
+	if err := msg.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	accepted := make([]*types.Recipient, 0, len(msg.Recipients))
+	amount := math.ZeroInt()
+	for _, recipient := range msg.Recipients {
+		result := types.RecipientResult{Recipient: recipient.Account(), Amount: recipient.Amount}
+		if err := k.CheckRecipients(ctx, params, []string{recipient.Account()}); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+			accepted = append(accepted, recipient)
+			amount = amount.Add(recipient.Amount)
+		}
+		packetAck.Results = append(packetAck.Results, result)
+	}
+	if len(accepted) == 0 {
+		return packetAck, errorsmod.Wrap(types.ErrRecipientRejected, "no recipient can be paid")
+	}
+	msg.Recipients = accepted
+	msg.Amount = amount
+
+	used := math.ZeroInt()
+	if stats, found := k.GetDistributorStats(ctx, distributor); found {
+		used = stats.TotalDistributed
+	}
+	if used.Add(amount).GT(controller.Quota) {
+		return packetAck, errorsmod.Wrapf(
+			types.ErrQuotaExceeded,
+			"%s distributed over %s plus %s exceeds quota %s", used, packet.DestinationChannel, amount, controller.Quota,
+		)
+	}
+
+	packetAck.DistributionId, err = k.executeDistribution(ctx, params, msg)
+	if err != nil {
+		return packetAck, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionPacket,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(packetAck.DistributionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementDistributionPacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain. Nothing is minted
+// on the controller chain, so the results are only reported as events.
+func (k Keeper) OnAcknowledgementDistributionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DistributionPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributionPacket,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+				sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(false)),
+				sdk.NewAttribute(types.AttributeKeyError, dispatchedAck.Error),
+			),
+		)
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.DistributionPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		events := sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDistributionPacket,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+				sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(true)),
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(packetAck.DistributionId, 10)),
+			),
+		}
+		for _, result := range packetAck.Results {
+			events = append(events, sdk.NewEvent(
+				types.EventTypeDistributionResult,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, result.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, result.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(result.Success)),
+				sdk.NewAttribute(types.AttributeKeyError, result.Error),
+			))
+		}
+		ctx.EventManager().EmitEvents(events)
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutDistributionPacket responds to the case where a packet has not
+// been transmitted because of a timeout. The distribution was not executed.
+func (k Keeper) OnTimeoutDistributionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DistributionPacketData) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionPacket,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyTimeout, strconv.FormatBool(true)),
+		),
+	)
+	return nil
+}
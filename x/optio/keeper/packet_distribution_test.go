@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestOnRecvDistributionPacket(t *testing.T) {
+	controller := sample.AccAddress()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+	remote := types.RemoteDistributorAddress("channel-1").String()
+
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.BankKeeper) {
+		k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+		params := types.DefaultParams()
+		params.RemoteControllers = []types.RemoteController{{ChannelId: "channel-1", Quota: math.NewInt(100)}}
+		require.NoError(t, k.SetParams(ctx, params))
+		return k, ctx, bank
+	}
+	packet := channeltypes.Packet{Sequence: 1, DestinationChannel: "channel-1"}
+	data := func(amounts ...int64) types.DistributionPacketData {
+		data := types.DistributionPacketData{Sender: controller, Amount: math.ZeroInt(), Category: "payroll"}
+		for i, amount := range amounts {
+			address := alice
+			if i%2 == 1 {
+				address = bob
+			}
+			data.Recipients = append(data.Recipients, &types.Recipient{Address: address, Amount: math.NewInt(amount)})
+			data.Amount = data.Amount.AddRaw(amount)
+		}
+		return data
+	}
+
+	t.Run("pays the recipients", func(t *testing.T) {
+		k, ctx, bank := setup(t)
+
+		ack, err := k.OnRecvDistributionPacket(ctx, packet, data(30, 20))
+		require.NoError(t, err)
+		require.Equal(t, types.DistributionPacketAck{
+			DistributionId: 0,
+			Results: []types.RecipientResult{
+				{Recipient: alice, Amount: math.NewInt(30), Success: true},
+				{Recipient: bob, Amount: math.NewInt(20), Success: true},
+			},
+		}, ack)
+
+		distribution, found := k.GetDistribution(ctx, ack.DistributionId)
+		require.True(t, found)
+		require.Equal(t, remote, distribution.Distributor)
+		require.Equal(t, "payroll", distribution.Category)
+		require.Equal(t, math.NewInt(30), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(alice), types.DefaultDenom).Amount)
+		require.Equal(t, math.NewInt(20), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(bob), types.DefaultDenom).Amount)
+
+		stats, _ := k.GetDistributorStats(ctx, remote)
+		require.Equal(t, math.NewInt(50), stats.TotalDistributed)
+	})
+
+	t.Run("skips rejected recipients", func(t *testing.T) {
+		k, ctx, bank := setup(t)
+		k.SetAddressStatus(ctx, types.AddressStatus{Address: bob, Status: types.COMPLIANCE_STATUS_DENIED})
+
+		ack, err := k.OnRecvDistributionPacket(ctx, packet, data(30, 20))
+		require.NoError(t, err)
+		require.True(t, ack.Results[0].Success)
+		require.False(t, ack.Results[1].Success)
+		require.Contains(t, ack.Results[1].Error, types.ErrRecipientRejected.Error())
+
+		distribution, _ := k.GetDistribution(ctx, ack.DistributionId)
+		require.Equal(t, math.NewInt(30), distribution.Amount)
+		require.Len(t, distribution.Recipients, 1)
+		require.True(t, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(bob), types.DefaultDenom).IsZero())
+		require.Equal(t, math.NewInt(30), bank.GetSupply(ctx, types.DefaultDenom).Amount)
+	})
+
+	t.Run("enforces the channel quota", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+
+		_, err := k.OnRecvDistributionPacket(ctx, packet, data(60, 30))
+		require.NoError(t, err)
+		_, err = k.OnRecvDistributionPacket(ctx, packet, data(10, 1))
+		require.ErrorIs(t, err, types.ErrQuotaExceeded)
+		_, err = k.OnRecvDistributionPacket(ctx, packet, data(10))
+		require.NoError(t, err)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		packet channeltypes.Packet
+		data   func(k keeper.Keeper, ctx sdk.Context) types.DistributionPacketData
+		expErr error
+	}{
+		{
+			name:   "unknown channel",
+			packet: channeltypes.Packet{Sequence: 1, DestinationChannel: "channel-2"},
+			data:   func(keeper.Keeper, sdk.Context) types.DistributionPacketData { return data(10) },
+			expErr: types.ErrUnknownController,
+		},
+		{
+			name:   "missing sender",
+			packet: packet,
+			data: func(keeper.Keeper, sdk.Context) types.DistributionPacketData {
+				d := data(10)
+				d.Sender = ""
+				return d
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "amounts do not sum up",
+			packet: packet,
+			data: func(keeper.Keeper, sdk.Context) types.DistributionPacketData {
+				d := data(10)
+				d.Amount = math.NewInt(11)
+				return d
+			},
+			expErr: types.ErrInvalidAmount,
+		},
+		{
+			name:   "all recipients rejected",
+			packet: packet,
+			data: func(k keeper.Keeper, ctx sdk.Context) types.DistributionPacketData {
+				k.SetAddressStatus(ctx, types.AddressStatus{Address: alice, Status: types.COMPLIANCE_STATUS_DENIED})
+				return data(10)
+			},
+			expErr: types.ErrRecipientRejected,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, _ := setup(t)
+			_, err := k.OnRecvDistributionPacket(ctx, tc.packet, tc.data(k, ctx))
+			require.ErrorIs(t, err, tc.expErr)
+			require.Zero(t, k.GetDistributionCount(ctx))
+		})
+	}
+}
+
+func TestOnAcknowledgementDistributionPacket(t *testing.T) {
+	k, ctx := keepertest.OptioKeeper(t)
+	alice := sample.AccAddress()
+	packet := channeltypes.Packet{Sequence: 3, SourceChannel: "channel-0"}
+	data := types.DistributionPacketData{Sender: sample.AccAddress()}
+
+	bz, err := types.ModuleCdc.MarshalJSON(&types.DistributionPacketAck{
+		DistributionId: 7,
+		Results: []types.RecipientResult{
+			{Recipient: alice, Amount: math.NewInt(10), Success: true},
+			{Recipient: alice, Amount: math.NewInt(5), Error: "rejected"},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.OnAcknowledgementDistributionPacket(ctx, packet, data, channeltypes.NewResultAcknowledgement(bz)))
+	var results []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDistributionResult {
+			results = append(results, event)
+		}
+	}
+	require.Len(t, results, 2)
+	success, _ := results[1].GetAttribute(types.AttributeKeySuccess)
+	require.Equal(t, "false", success.Value)
+
+	require.NoError(t, k.OnAcknowledgementDistributionPacket(ctx, packet, data, channeltypes.NewErrorAcknowledgement(types.ErrQuotaExceeded)))
+	require.Error(t, k.OnAcknowledgementDistributionPacket(ctx, packet, data, channeltypes.NewResultAcknowledgement([]byte("not json"))))
+}
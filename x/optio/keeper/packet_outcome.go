@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetPacketOutcome set a specific packetOutcome in the store from its index
+// and keeps the index of the pending outcomes in sync.
+func (k Keeper) SetPacketOutcome(ctx context.Context, packetOutcome types.PacketOutcome) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PacketOutcomeKeyPrefix))
+	key := types.PacketOutcomeKey(
+		packetOutcome.PortId,
+		packetOutcome.ChannelId,
+		packetOutcome.Sequence,
+	)
+	b := k.cdc.MustMarshal(&packetOutcome)
+	store.Set(key, b)
+
+	pendingStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PacketOutcomePendingKeyPrefix))
+	if packetOutcome.IsPending() {
+		pendingStore.Set(key, []byte{})
+	} else {
+		pendingStore.Delete(key)
+	}
+}
+
+// GetPacketOutcome returns a packetOutcome from its index
+func (k Keeper) GetPacketOutcome(
+	ctx context.Context,
+	portID string,
+	channelID string,
+	sequence uint64,
+
+) (val types.PacketOutcome, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PacketOutcomeKeyPrefix))
+
+	b := store.Get(types.PacketOutcomeKey(
+		portID,
+		channelID,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPacketOutcome returns all packetOutcome
+func (k Keeper) GetAllPacketOutcome(ctx context.Context) (list []types.PacketOutcome) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PacketOutcomeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PacketOutcome
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
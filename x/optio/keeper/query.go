@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) AddressStatusAll(ctx context.Context, req *types.QueryAllAddressStatusRequest) (*types.QueryAllAddressStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var addressStatuss []types.AddressStatus
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	addressStatusStore := prefix.NewStore(store, types.KeyPrefix(types.AddressStatusKeyPrefix))
+
+	pageRes, err := query.Paginate(addressStatusStore, req.Pagination, func(key []byte, value []byte) error {
+		var addressStatus types.AddressStatus
+		if err := k.cdc.Unmarshal(value, &addressStatus); err != nil {
+			return err
+		}
+
+		addressStatuss = append(addressStatuss, addressStatus)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAddressStatusResponse{AddressStatus: addressStatuss, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AddressStatus(ctx context.Context, req *types.QueryGetAddressStatusRequest) (*types.QueryGetAddressStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetAddressStatus(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		// addresses without an entry are reported as unspecified
+		val = types.AddressStatus{Address: req.Address, Status: types.COMPLIANCE_STATUS_UNSPECIFIED}
+	}
+
+	return &types.QueryGetAddressStatusResponse{AddressStatus: val}, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) AuthorizedAccounts(ctx context.Context, req *types.QueryAuthorizedAccountsRequest) (*types.QueryAuthorizedAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params := k.GetParams(ctx)
+
+	// the accounts live in params rather than in a store, so paginate over
+	// the sorted address list, using the next address as key
+	addresses := append(append([]string{}, params.AuthorizedAccounts...), params.ComplianceAccounts...)
+	sort.Strings(addresses)
+	addresses = compactStrings(addresses)
+
+	page, pageRes, err := paginateStrings(addresses, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	headroom := params.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+	if headroom.IsNegative() {
+		headroom = math.ZeroInt()
+	}
+
+	accounts := make([]types.AuthorizedAccount, 0, len(page))
+	for _, address := range page {
+		account := types.AuthorizedAccount{
+			Address:          address,
+			Roles:            params.Roles(address),
+			TotalDistributed: math.ZeroInt(),
+			RemainingQuota:   math.ZeroInt(),
+		}
+		if stats, found := k.GetDistributorStats(ctx, address); found {
+			account.TotalDistributed = stats.TotalDistributed
+			account.DistributionCount = stats.DistributionCount
+			account.LastHeight = stats.LastHeight
+		}
+		if params.IsAuthorized(address) {
+			account.RemainingQuota = headroom
+		}
+		accounts = append(accounts, account)
+	}
+
+	return &types.QueryAuthorizedAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func compactStrings(sorted []string) []string {
+	out := sorted[:0]
+	for i, s := range sorted {
+		if i == 0 || s != sorted[i-1] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// paginateStrings applies a PageRequest to a sorted list held in memory.
+func paginateStrings(sorted []string, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.New("paginate: invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, nil, errors.New("paginate: reverse is not supported")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		start = uint64(sort.SearchStrings(sorted, string(pageReq.Key)))
+	}
+	if start > uint64(len(sorted)) {
+		start = uint64(len(sorted))
+	}
+	end := start + limit
+	if end > uint64(len(sorted)) {
+		end = uint64(len(sorted))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(sorted)) {
+		pageRes.NextKey = []byte(sorted[end])
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(sorted))
+	}
+	return sorted[start:end], pageRes, nil
+}
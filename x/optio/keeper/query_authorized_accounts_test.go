@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestAuthorizedAccountsQuery(t *testing.T) {
+	keeper, ctx, bank := keepertest.OptioKeeperWithBank(t)
+
+	distributor, compliance, both := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{distributor, both}
+	params.ComplianceAccounts = []string{compliance, both}
+	params.MaxSupply = math.NewInt(1000)
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 300))))
+	keeper.AddDistributorUsage(ctx.WithBlockHeight(7), distributor, math.NewInt(300))
+
+	res, err := keeper.AuthorizedAccounts(ctx, &types.QueryAuthorizedAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 3)
+
+	accounts := make(map[string]types.AuthorizedAccount)
+	for _, account := range res.Accounts {
+		accounts[account.Address] = account
+	}
+	require.Equal(t, types.AuthorizedAccount{
+		Address:           distributor,
+		Roles:             []string{types.RoleDistributor},
+		TotalDistributed:  math.NewInt(300),
+		DistributionCount: 1,
+		LastHeight:        7,
+		RemainingQuota:    math.NewInt(700),
+	}, accounts[distributor])
+	require.Equal(t, []string{types.RoleCompliance}, accounts[compliance].Roles)
+	require.True(t, accounts[compliance].RemainingQuota.IsZero())
+	require.Equal(t, []string{types.RoleDistributor, types.RoleCompliance}, accounts[both].Roles)
+
+	_, err = keeper.AuthorizedAccounts(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestAuthorizedAccountsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+
+	params := types.DefaultParams()
+	for i := 0; i < 5; i++ {
+		params.AuthorizedAccounts = append(params.AuthorizedAccounts, sample.AccAddress())
+	}
+	require.NoError(t, keeper.SetParams(ctx, params))
+	expected := append([]string{}, params.AuthorizedAccounts...)
+	sort.Strings(expected)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAuthorizedAccountsRequest {
+		return &types.QueryAuthorizedAccountsRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	addresses := func(accounts []types.AuthorizedAccount) []string {
+		var out []string
+		for _, account := range accounts {
+			out = append(out, account.Address)
+		}
+		return out
+	}
+
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(expected); i += step {
+			resp, err := keeper.AuthorizedAccounts(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.Equal(t, expected[i:min(i+step, len(expected))], addresses(resp.Accounts))
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var got []string
+		for i := 0; i < len(expected); i += step {
+			resp, err := keeper.AuthorizedAccounts(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			got = append(got, addresses(resp.Accounts)...)
+			next = resp.Pagination.NextKey
+		}
+		require.Equal(t, expected, got)
+		require.Nil(t, next)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AuthorizedAccounts(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(expected), int(resp.Pagination.Total))
+		require.Equal(t, expected, addresses(resp.Accounts))
+	})
+}
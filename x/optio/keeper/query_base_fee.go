@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params := k.GetParams(ctx)
+	baseFee := math.LegacyZeroDec()
+	if params.BaseFeeEnabled() {
+		baseFee = k.GetBaseFee(ctx)
+	}
+
+	return &types.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(params.Denom, baseFee)}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) DistributeGas(goCtx context.Context, req *types.QueryDistributeGasRequest) (*types.QueryDistributeGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	gas, err := params.DistributeGas(req.NumRecipients)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDistributeGasResponse{
+		Gas:                 gas,
+		GasPerRecipient:     params.GasPerRecipient,
+		MaxRecipientsPerMsg: params.MaxRecipientsPerMsg,
+		NumMessages:         params.NumDistributeMsgs(req.NumRecipients),
+	}, nil
+}
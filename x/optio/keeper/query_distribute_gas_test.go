@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestDistributeGasQuery(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	params := types.DefaultParams()
+	params.MaxRecipientsPerMsg = 100
+	params.GasPerRecipient = 500
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryDistributeGasRequest
+		response *types.QueryDistributeGasResponse
+	}{
+		{
+			desc:     "Zero",
+			request:  &types.QueryDistributeGasRequest{NumRecipients: 0},
+			response: &types.QueryDistributeGasResponse{Gas: 0, GasPerRecipient: 500, MaxRecipientsPerMsg: 100, NumMessages: 0},
+		},
+		{
+			desc:     "SingleMessage",
+			request:  &types.QueryDistributeGasRequest{NumRecipients: 100},
+			response: &types.QueryDistributeGasResponse{Gas: 50000, GasPerRecipient: 500, MaxRecipientsPerMsg: 100, NumMessages: 1},
+		},
+		{
+			desc:     "Chunked",
+			request:  &types.QueryDistributeGasRequest{NumRecipients: 250},
+			response: &types.QueryDistributeGasResponse{Gas: 125000, GasPerRecipient: 500, MaxRecipientsPerMsg: 100, NumMessages: 3},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.DistributeGas(ctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+
+	_, err := keeper.DistributeGas(ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) DistributionAll(ctx context.Context, req *types.QueryAllDistributionRequest) (*types.QueryAllDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateCategory(req.Category); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var distributions []types.Distribution
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var (
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Category == "" {
+		distributionStore := prefix.NewStore(store, types.KeyPrefix(types.DistributionKey))
+		pageRes, err = query.Paginate(distributionStore, req.Pagination, func(key []byte, value []byte) error {
+			var distribution types.Distribution
+			if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+				return err
+			}
+
+			distributions = append(distributions, distribution)
+			return nil
+		})
+	} else {
+		categoryStore := prefix.NewStore(store, append(types.KeyPrefix(types.DistributionCategoryKey), GetDistributionCategoryPrefix(req.Category)...))
+		pageRes, err = query.Paginate(categoryStore, req.Pagination, func(key []byte, _ []byte) error {
+			distribution, found := k.GetDistribution(ctx, binary.BigEndian.Uint64(key))
+			if !found {
+				return sdkerrors.ErrKeyNotFound
+			}
+
+			distributions = append(distributions, distribution)
+			return nil
+		})
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDistributionResponse{Distribution: distributions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Distribution(ctx context.Context, req *types.QueryGetDistributionRequest) (*types.QueryGetDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	distribution, found := k.GetDistribution(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetDistributionResponse{Distribution: distribution}, nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/nullify"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestDistributionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	msgs := createNDistribution(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetDistributionRequest
+		response *types.QueryGetDistributionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetDistributionRequest{Id: msgs[0].Id},
+			response: &types.QueryGetDistributionResponse{Distribution: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetDistributionRequest{Id: msgs[1].Id},
+			response: &types.QueryGetDistributionResponse{Distribution: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetDistributionRequest{Id: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Distribution(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestDistributionQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.OptioKeeper(t)
+	msgs := createNDistribution(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllDistributionRequest {
+		return &types.QueryAllDistributionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.DistributionAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Distribution), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Distribution),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.DistributionAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Distribution), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Distribution),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.DistributionAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Distribution),
+		)
+	})
+	t.Run("ByCategory", func(t *testing.T) {
+		req := request(nil, 0, 0, true)
+		req.Category = "category-1"
+		resp, err := keeper.DistributionAll(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 2, int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill([]types.Distribution{msgs[1], msgs[3]}),
+			nullify.Fill(resp.Distribution),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.DistributionAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) FeeAllowanceBudget(ctx context.Context, req *types.QueryFeeAllowanceBudgetRequest) (*types.QueryFeeAllowanceBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params := k.GetParams(ctx)
+	usage := k.GetFeeAllowanceUsage(ctx)
+
+	// governance can lower the budget below what was already granted
+	remaining := sdk.NewCoins()
+	for _, coin := range params.FeeAllowanceBudget {
+		if left := coin.Amount.Sub(usage.Granted.AmountOf(coin.Denom)); left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+		}
+	}
+
+	granter := FeeAllowanceGranter(params)
+	return &types.QueryFeeAllowanceBudgetResponse{
+		Budget:         params.FeeAllowanceBudget,
+		Usage:          usage,
+		Remaining:      remaining,
+		Granter:        granter.String(),
+		GranterBalance: k.bankKeeper.SpendableCoins(ctx, granter),
+	}, nil
+}
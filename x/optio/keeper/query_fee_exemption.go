@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) FeeExemption(ctx context.Context, req *types.QueryFeeExemptionRequest) (*types.QueryFeeExemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	usage := k.currentFeeExemptUsage(ctx, params, req.Address)
+
+	var remaining uint64
+	if params.FeeExemptionsEnabled() && params.IsAuthorized(req.Address) && usage.Count < params.FeeExemptTxsPerWindow {
+		remaining = params.FeeExemptTxsPerWindow - usage.Count
+	}
+
+	return &types.QueryFeeExemptionResponse{
+		Usage:     usage,
+		Remaining: remaining,
+	}, nil
+}
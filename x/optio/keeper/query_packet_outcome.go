@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) PacketOutcomeAll(ctx context.Context, req *types.QueryAllPacketOutcomeRequest) (*types.QueryAllPacketOutcomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var packetOutcomes []types.PacketOutcome
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	packetOutcomeStore := prefix.NewStore(store, types.KeyPrefix(types.PacketOutcomeKeyPrefix))
+
+	pageRes, err := query.Paginate(packetOutcomeStore, req.Pagination, func(key []byte, value []byte) error {
+		var packetOutcome types.PacketOutcome
+		if err := k.cdc.Unmarshal(value, &packetOutcome); err != nil {
+			return err
+		}
+
+		packetOutcomes = append(packetOutcomes, packetOutcome)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPacketOutcomeResponse{PacketOutcome: packetOutcomes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PacketOutcome(ctx context.Context, req *types.QueryGetPacketOutcomeRequest) (*types.QueryGetPacketOutcomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPacketOutcome(
+		ctx,
+		req.PortId,
+		req.ChannelId,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPacketOutcomeResponse{PacketOutcome: val}, nil
+}
+
+// PendingPayouts pages through the index of the pending outcomes, so the
+// resolved packets are never scanned.
+func (k Keeper) PendingPayouts(ctx context.Context, req *types.QueryPendingPayoutsRequest) (*types.QueryPendingPayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var packetOutcomes []types.PacketOutcome
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	packetOutcomeStore := prefix.NewStore(store, types.KeyPrefix(types.PacketOutcomeKeyPrefix))
+	pendingStore := prefix.NewStore(store, types.KeyPrefix(types.PacketOutcomePendingKeyPrefix))
+
+	pageRes, err := query.Paginate(pendingStore, req.Pagination, func(key []byte, _ []byte) error {
+		b := packetOutcomeStore.Get(key)
+		if b == nil {
+			return fmt.Errorf("pending packet outcome %X not found", key)
+		}
+
+		var packetOutcome types.PacketOutcome
+		if err := k.cdc.Unmarshal(b, &packetOutcome); err != nil {
+			return err
+		}
+
+		packetOutcomes = append(packetOutcomes, packetOutcome)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingPayoutsResponse{PacketOutcome: packetOutcomes, Pagination: pageRes}, nil
+}
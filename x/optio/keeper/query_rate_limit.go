@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) RateLimitAll(ctx context.Context, req *types.QueryAllRateLimitRequest) (*types.QueryAllRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.RateLimit
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	rateLimitStore := prefix.NewStore(store, types.KeyPrefix(types.RateLimitKeyPrefix))
+
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(key []byte, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRateLimitResponse{RateLimit: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RateLimit(ctx context.Context, req *types.QueryGetRateLimitRequest) (*types.QueryGetRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetRateLimit(
+		ctx,
+		req.ChannelId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRateLimitResponse{RateLimit: val}, nil
+}
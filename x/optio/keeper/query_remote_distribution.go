@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) RemoteDistributionAll(ctx context.Context, req *types.QueryAllRemoteDistributionRequest) (*types.QueryAllRemoteDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var remoteDistributions []types.RemoteDistribution
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	remoteDistributionStore := prefix.NewStore(store, types.KeyPrefix(types.RemoteDistributionKeyPrefix))
+
+	pageRes, err := query.Paginate(remoteDistributionStore, req.Pagination, func(key []byte, value []byte) error {
+		var remoteDistribution types.RemoteDistribution
+		if err := k.cdc.Unmarshal(value, &remoteDistribution); err != nil {
+			return err
+		}
+
+		remoteDistributions = append(remoteDistributions, remoteDistribution)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRemoteDistributionResponse{RemoteDistribution: remoteDistributions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RemoteDistribution(ctx context.Context, req *types.QueryGetRemoteDistributionRequest) (*types.QueryGetRemoteDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetRemoteDistribution(
+		ctx,
+		req.ChannelId,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRemoteDistributionResponse{RemoteDistribution: val}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) RemoteTreasury(ctx context.Context, req *types.QueryRemoteTreasuryRequest) (*types.QueryRemoteTreasuryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	address, channelID, found := k.GetRemoteTreasury(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryRemoteTreasuryResponse{
+		Address:   address,
+		PortId:    types.RemoteTreasuryPortID(),
+		ChannelId: channelID,
+	}, nil
+}
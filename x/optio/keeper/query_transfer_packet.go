@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func (k Keeper) TransferPacketAll(ctx context.Context, req *types.QueryAllTransferPacketRequest) (*types.QueryAllTransferPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var transferPackets []types.TransferPacket
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	transferPacketStore := prefix.NewStore(store, types.KeyPrefix(types.TransferPacketKeyPrefix))
+
+	pageRes, err := query.Paginate(transferPacketStore, req.Pagination, func(key []byte, value []byte) error {
+		var transferPacket types.TransferPacket
+		if err := k.cdc.Unmarshal(value, &transferPacket); err != nil {
+			return err
+		}
+
+		transferPackets = append(transferPackets, transferPacket)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTransferPacketResponse{TransferPacket: transferPackets, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TransferPacket(ctx context.Context, req *types.QueryGetTransferPacketRequest) (*types.QueryGetTransferPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetTransferPacket(
+		ctx,
+		req.Channel,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTransferPacketResponse{TransferPacket: val}, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetRateLimit set a specific rateLimit in the store from its index
+func (k Keeper) SetRateLimit(ctx context.Context, rateLimit types.RateLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(
+		rateLimit.ChannelId,
+	), b)
+}
+
+// GetRateLimit returns a rateLimit from its index
+func (k Keeper) GetRateLimit(
+	ctx context.Context,
+	channelID string,
+
+) (val types.RateLimit, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitKeyPrefix))
+
+	b := store.Get(types.RateLimitKey(
+		channelID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRateLimit removes a rateLimit from the store
+func (k Keeper) RemoveRateLimit(
+	ctx context.Context,
+	channelID string,
+
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitKeyPrefix))
+	store.Delete(types.RateLimitKey(
+		channelID,
+	))
+}
+
+// GetAllRateLimit returns all rateLimit
+func (k Keeper) GetAllRateLimit(ctx context.Context) (list []types.RateLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetRateLimitPendingPacket set a specific rateLimitPendingPacket in the store from its index
+func (k Keeper) SetRateLimitPendingPacket(ctx context.Context, packet types.RateLimitPendingPacket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix))
+	b := k.cdc.MustMarshal(&packet)
+	store.Set(types.RateLimitPendingPacketKey(
+		packet.ChannelId,
+		packet.Sequence,
+	), b)
+}
+
+// GetRateLimitPendingPacket returns a rateLimitPendingPacket from its index
+func (k Keeper) GetRateLimitPendingPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+
+) (val types.RateLimitPendingPacket, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix))
+
+	b := store.Get(types.RateLimitPendingPacketKey(
+		channelID,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRateLimitPendingPacket removes a rateLimitPendingPacket from the store
+func (k Keeper) RemoveRateLimitPendingPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix))
+	store.Delete(types.RateLimitPendingPacketKey(
+		channelID,
+		sequence,
+	))
+}
+
+// GetAllRateLimitPendingPacket returns all rateLimitPendingPacket
+func (k Keeper) GetAllRateLimitPendingPacket(ctx context.Context) (list []types.RateLimitPendingPacket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimitPendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
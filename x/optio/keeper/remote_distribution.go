@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetRemoteDistribution set a specific remoteDistribution in the store from its index
+func (k Keeper) SetRemoteDistribution(ctx context.Context, remoteDistribution types.RemoteDistribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RemoteDistributionKeyPrefix))
+	b := k.cdc.MustMarshal(&remoteDistribution)
+	store.Set(types.RemoteDistributionKey(
+		remoteDistribution.ChannelId,
+		remoteDistribution.Sequence,
+	), b)
+}
+
+// GetRemoteDistribution returns a remoteDistribution from its index
+func (k Keeper) GetRemoteDistribution(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+
+) (val types.RemoteDistribution, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RemoteDistributionKeyPrefix))
+
+	b := store.Get(types.RemoteDistributionKey(
+		channelID,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRemoteDistribution returns all remoteDistribution
+func (k Keeper) GetAllRemoteDistribution(ctx context.Context) (list []types.RemoteDistribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RemoteDistributionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RemoteDistribution
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
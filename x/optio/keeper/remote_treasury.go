@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// RegisterRemoteTreasury opens the interchain account the module owns on the
+// host chain of a connection. The account is usable once the channel
+// handshake completes. An empty version requests the default metadata of
+// the connection.
+func (k Keeper) RegisterRemoteTreasury(ctx context.Context, connectionID, version string) error {
+	icaKeeper := k.icaControllerKeeper()
+	if icaKeeper == nil {
+		return errorsmod.Wrapf(types.ErrCrossChainDisabled, "cannot register a remote treasury on %s", connectionID)
+	}
+
+	// the legacy API routes the channel and packet callbacks of the
+	// controller to the module
+	//nolint:staticcheck
+	return icaKeeper.RegisterInterchainAccount(sdk.UnwrapSDKContext(ctx), connectionID, types.RemoteTreasuryOwner, version)
+}
+
+// GetRemoteTreasury returns the address of the remote treasury on the host chain
+// of a connection and its active channel, if any.
+func (k Keeper) GetRemoteTreasury(ctx context.Context, connectionID string) (address, channelID string, found bool) {
+	icaKeeper := k.icaControllerKeeper()
+	if icaKeeper == nil {
+		return "", "", false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	portID := types.RemoteTreasuryPortID()
+	address, found = icaKeeper.GetInterchainAccountAddress(sdkCtx, connectionID, portID)
+	if !found {
+		return "", "", false
+	}
+	channelID, found = icaKeeper.GetActiveChannelID(sdkCtx, connectionID, portID)
+	return address, channelID, found
+}
+
+// SendRemoteDistribution executes a bank multi-send from the remote treasury
+// of a connection. The packet times out after Params.transferTimeout and is
+// tracked until the IBC callbacks report its acknowledgement or timeout. The
+// packet memo requests the callbacks, so the memo is only kept in state. Host
+// chains only execute transactions encoded with protobuf.
+func (k Keeper) SendRemoteDistribution(ctx context.Context, params types.Params, fromAddress, connectionID string, outputs []banktypes.Output, memo string) (types.RemoteDistribution, error) {
+	icaKeeper := k.icaControllerKeeper()
+	if !params.CrossChainEnabled() || icaKeeper == nil {
+		return types.RemoteDistribution{}, errorsmod.Wrapf(types.ErrCrossChainDisabled, "cannot distribute on %s", connectionID)
+	}
+
+	treasury, channelID, found := k.GetRemoteTreasury(ctx, connectionID)
+	if !found {
+		return types.RemoteDistribution{}, errorsmod.Wrap(types.ErrNoRemoteTreasury, connectionID)
+	}
+
+	// the addresses belong to the host chain and are not decoded here
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: treasury, Coins: types.TotalOutputs(outputs)}},
+		Outputs: outputs,
+	}
+	msgAny, err := codectypes.NewAnyWithValue(multiSend)
+	if err != nil {
+		return types.RemoteDistribution{}, err
+	}
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: []*codectypes.Any{msgAny}})
+	if err != nil {
+		return types.RemoteDistribution{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout := sdkCtx.BlockTime().Add(params.TransferTimeout)
+	//nolint:staticcheck
+	sequence, err := icaKeeper.SendTx(sdkCtx, nil, connectionID, types.RemoteTreasuryPortID(), icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: types.CallbackMemo(),
+	}, uint64(timeout.UnixNano()))
+	if err != nil {
+		return types.RemoteDistribution{}, err
+	}
+
+	distribution := types.RemoteDistribution{
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		ConnectionId: connectionID,
+		FromAddress:  fromAddress,
+		Treasury:     treasury,
+		Outputs:      outputs,
+		Memo:         memo,
+		Status:       types.REMOTE_DISTRIBUTION_STATUS_PENDING,
+		Height:       sdkCtx.BlockHeight(),
+	}
+	k.SetRemoteDistribution(ctx, distribution)
+	k.trackPacket(ctx, types.RemoteTreasuryPortID(), channelID, sequence)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoteDistribution,
+			sdk.NewAttribute(types.AttributeKeyConnection, connectionID),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, fromAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, types.TotalOutputs(outputs).String()),
+		),
+	)
+
+	return distribution, nil
+}
+
+// OnRemoteDistributionAcknowledged records the result of a remote
+// distribution. Packets that are not tracked by the module are ignored.
+func (k Keeper) OnRemoteDistributionAcknowledged(ctx context.Context, channelID string, sequence uint64, success bool, ackError string) {
+	status := types.REMOTE_DISTRIBUTION_STATUS_COMPLETED
+	if !success {
+		status = types.REMOTE_DISTRIBUTION_STATUS_FAILED
+	}
+	k.updateRemoteDistribution(ctx, channelID, sequence, status, ackError)
+}
+
+// OnRemoteDistributionTimeout records a remote distribution that timed out.
+// Packets that are not tracked by the module are ignored.
+func (k Keeper) OnRemoteDistributionTimeout(ctx context.Context, channelID string, sequence uint64) {
+	k.updateRemoteDistribution(ctx, channelID, sequence, types.REMOTE_DISTRIBUTION_STATUS_TIMEOUT, "")
+}
+
+func (k Keeper) updateRemoteDistribution(ctx context.Context, channelID string, sequence uint64, status types.RemoteDistributionStatus, ackError string) {
+	distribution, found := k.GetRemoteDistribution(ctx, channelID, sequence)
+	if !found || !distribution.IsPending() {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	distribution.Status = status
+	distribution.Error = ackError
+	distribution.Height = sdkCtx.BlockHeight()
+	k.SetRemoteDistribution(ctx, distribution)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoteDistribution,
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeyError, ackError),
+		),
+	)
+}
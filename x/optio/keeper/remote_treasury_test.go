@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMsgRemoteDistribute(t *testing.T) {
+	distributor := sample.AccAddress()
+	outputs := []banktypes.Output{
+		{Address: "cosmos1alice", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 60))},
+		{Address: "cosmos1bob", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 40))},
+	}
+
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.ICAControllerKeeper, types.MsgServer) {
+		k, ctx, icaKeeper := keepertest.OptioKeeperWithICA(t)
+		params := types.DefaultParams()
+		params.AuthorizedAccounts = []string{distributor}
+		require.NoError(t, k.SetParams(ctx, params))
+		return k, ctx, icaKeeper, keeper.NewMsgServerImpl(k)
+	}
+
+	t.Run("registers the remote treasury", func(t *testing.T) {
+		k, ctx, _, ms := setup(t)
+
+		_, err := ms.RegisterRemoteTreasury(ctx, types.NewMsgRegisterRemoteTreasury(sample.AccAddress(), "connection-0", ""))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+
+		res, err := ms.RegisterRemoteTreasury(ctx, types.NewMsgRegisterRemoteTreasury(distributor, "connection-0", ""))
+		require.NoError(t, err)
+		require.Equal(t, "icacontroller-optio", res.PortId)
+
+		treasury, err := k.RemoteTreasury(ctx, &types.QueryRemoteTreasuryRequest{ConnectionId: "connection-0"})
+		require.NoError(t, err)
+		require.Equal(t, "host1optio", treasury.Address)
+		require.Equal(t, "channel-0", treasury.ChannelId)
+
+		// the authority may register as well
+		_, err = ms.RegisterRemoteTreasury(ctx, types.NewMsgRegisterRemoteTreasury(k.GetAuthority(), "connection-1", ""))
+		require.NoError(t, err)
+	})
+
+	t.Run("sends a tracked multi-send", func(t *testing.T) {
+		k, ctx, icaKeeper, ms := setup(t)
+
+		_, err := ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(distributor, "connection-0", outputs, "payroll"))
+		require.ErrorIs(t, err, types.ErrNoRemoteTreasury)
+
+		icaKeeper.Open("connection-0", types.RemoteTreasuryPortID(), "channel-3", "cosmos1treasury")
+		res, err := ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(distributor, "connection-0", outputs, "payroll"))
+		require.NoError(t, err)
+		require.Equal(t, "channel-3", res.ChannelId)
+		require.Equal(t, uint64(1), res.Sequence)
+
+		require.Len(t, icaKeeper.Sent, 1)
+		sent := icaKeeper.Sent[0]
+		require.Equal(t, icatypes.EXECUTE_TX, sent.Type)
+		require.Equal(t, types.CallbackMemo(), sent.Memo)
+		var tx icatypes.CosmosTx
+		require.NoError(t, tx.Unmarshal(sent.Data))
+		require.Len(t, tx.Messages, 1)
+		var multiSend banktypes.MsgMultiSend
+		require.NoError(t, multiSend.Unmarshal(tx.Messages[0].Value))
+		require.Equal(t, []banktypes.Input{{Address: "cosmos1treasury", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))}}, multiSend.Inputs)
+		require.Equal(t, outputs, multiSend.Outputs)
+
+		distribution, found := k.GetRemoteDistribution(ctx, "channel-3", 1)
+		require.True(t, found)
+		require.Equal(t, distributor, distribution.FromAddress)
+		require.Equal(t, "cosmos1treasury", distribution.Treasury)
+		require.Equal(t, "payroll", distribution.Memo)
+		require.Equal(t, types.REMOTE_DISTRIBUTION_STATUS_PENDING, distribution.Status)
+
+		outcome, found := k.GetPacketOutcome(ctx, types.RemoteTreasuryPortID(), "channel-3", 1)
+		require.True(t, found)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_PENDING, outcome.Status)
+	})
+
+	t.Run("rejects unauthorized accounts", func(t *testing.T) {
+		_, ctx, icaKeeper, ms := setup(t)
+		icaKeeper.Open("connection-0", types.RemoteTreasuryPortID(), "channel-0", "cosmos1treasury")
+
+		_, err := ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(sample.AccAddress(), "connection-0", outputs, ""))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+		require.Empty(t, icaKeeper.Sent)
+	})
+
+	t.Run("caps the outputs", func(t *testing.T) {
+		k, ctx, icaKeeper, ms := setup(t)
+		icaKeeper.Open("connection-0", types.RemoteTreasuryPortID(), "channel-0", "cosmos1treasury")
+
+		params := k.GetParams(ctx)
+		params.MaxRecipientsPerMsg = 1
+		require.NoError(t, k.SetParams(ctx, params))
+		_, err := ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(distributor, "connection-0", outputs, ""))
+		require.ErrorIs(t, err, types.ErrTooManyRecipients)
+		require.Empty(t, icaKeeper.Sent)
+
+		// an unset cap does not limit the outputs
+		params.MaxRecipientsPerMsg = 0
+		require.NoError(t, k.SetParams(ctx, params))
+		_, err = ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(distributor, "connection-0", outputs, ""))
+		require.NoError(t, err)
+		require.Len(t, icaKeeper.Sent, 1)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		result func(k keeper.Keeper, ctx sdk.Context)
+		status types.RemoteDistributionStatus
+		err    string
+	}{
+		{
+			name: "success acknowledgement",
+			result: func(k keeper.Keeper, ctx sdk.Context) {
+				k.OnRemoteDistributionAcknowledged(ctx, "channel-0", 1, true, "")
+			},
+			status: types.REMOTE_DISTRIBUTION_STATUS_COMPLETED,
+		},
+		{
+			name: "error acknowledgement",
+			result: func(k keeper.Keeper, ctx sdk.Context) {
+				k.OnRemoteDistributionAcknowledged(ctx, "channel-0", 1, false, "insufficient funds")
+			},
+			status: types.REMOTE_DISTRIBUTION_STATUS_FAILED,
+			err:    "insufficient funds",
+		},
+		{
+			name:   "timeout",
+			result: func(k keeper.Keeper, ctx sdk.Context) { k.OnRemoteDistributionTimeout(ctx, "channel-0", 1) },
+			status: types.REMOTE_DISTRIBUTION_STATUS_TIMEOUT,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, icaKeeper, ms := setup(t)
+			icaKeeper.Open("connection-0", types.RemoteTreasuryPortID(), "channel-0", "cosmos1treasury")
+			_, err := ms.RemoteDistribute(ctx, types.NewMsgRemoteDistribute(distributor, "connection-0", outputs, ""))
+			require.NoError(t, err)
+
+			tc.result(k, ctx)
+			distribution, _ := k.GetRemoteDistribution(ctx, "channel-0", 1)
+			require.Equal(t, tc.status, distribution.Status)
+			require.Equal(t, tc.err, distribution.Error)
+
+			// a second callback for the same packet is a no-op
+			k.OnRemoteDistributionTimeout(ctx, "channel-0", 1)
+			distribution, _ = k.GetRemoteDistribution(ctx, "channel-0", 1)
+			require.Equal(t, tc.status, distribution.Status)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// GetSupplyRecord returns the amounts minted and burned by the module.
+func (k Keeper) GetSupplyRecord(ctx context.Context) types.SupplyRecord {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.SupplyRecordKey)
+	if bz == nil {
+		return types.NewSupplyRecord()
+	}
+
+	var record types.SupplyRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	// records stored before the base fee existed lack BaseFeeBurned
+	return record.WithDefaults()
+}
+
+// SetSupplyRecord sets the amounts minted and burned by the module.
+func (k Keeper) SetSupplyRecord(ctx context.Context, record types.SupplyRecord) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.SupplyRecordKey, k.cdc.MustMarshal(&record))
+}
+
+// AddMinted records amount as minted.
+func (k Keeper) AddMinted(ctx context.Context, amount math.Int) {
+	record := k.GetSupplyRecord(ctx)
+	record.Minted = record.Minted.Add(amount)
+	k.SetSupplyRecord(ctx, record)
+}
+
+// AddBurned records amount as burned.
+func (k Keeper) AddBurned(ctx context.Context, amount math.Int) {
+	record := k.GetSupplyRecord(ctx)
+	record.Burned = record.Burned.Add(amount)
+	k.SetSupplyRecord(ctx, record)
+}
+
+// AddBaseFeeBurned records amount as burned base fee.
+func (k Keeper) AddBaseFeeBurned(ctx context.Context, amount math.Int) {
+	record := k.GetSupplyRecord(ctx)
+	record.BaseFeeBurned = record.BaseFeeBurned.Add(amount)
+	k.SetSupplyRecord(ctx, record)
+}
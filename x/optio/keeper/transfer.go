@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SendTransfer pays a cross-chain recipient of a distribution. The tokens,
+// already minted to the module account, are moved to the transfer sender and
+// sent to the receiver with an ICS-20 transfer that times out after
+// Params.transferTimeout. The packet requests the IBC callbacks of the module
+// and is tracked until they report its acknowledgement or timeout.
+func (k Keeper) SendTransfer(ctx context.Context, params types.Params, distributionID uint64, recipient types.Recipient) (types.TransferPacket, error) {
+	transferKeeper := k.transferKeeper()
+	if !params.CrossChainEnabled() || transferKeeper == nil {
+		return types.TransferPacket{}, errorsmod.Wrapf(types.ErrCrossChainDisabled, "cannot pay %s over %s", recipient.Receiver, recipient.SourceChannel)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	token := sdk.NewCoin(params.Denom, recipient.Amount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.TransferSenderAddress, sdk.NewCoins(token)); err != nil {
+		return types.TransferPacket{}, err
+	}
+
+	timeout := sdkCtx.BlockTime().Add(params.TransferTimeout)
+	res, err := transferKeeper.Transfer(ctx, transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		recipient.SourceChannel,
+		token,
+		types.TransferSenderAddress.String(),
+		recipient.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(timeout.UnixNano()),
+		types.CallbackMemo(),
+	))
+	if err != nil {
+		return types.TransferPacket{}, err
+	}
+
+	packet := types.TransferPacket{
+		Channel:        recipient.SourceChannel,
+		Sequence:       res.Sequence,
+		DistributionId: distributionID,
+		Receiver:       recipient.Receiver,
+		Token:          token,
+		Status:         types.TRANSFER_STATUS_PENDING,
+		Height:         sdkCtx.BlockHeight(),
+	}
+	k.SetTransferPacket(ctx, packet)
+	k.trackPacket(ctx, transfertypes.PortID, packet.Channel, packet.Sequence)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(distributionID, 10)),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, packet.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.String()),
+		),
+	)
+
+	return packet, nil
+}
+
+// OnTransferAcknowledged updates a tracked transfer once the counterparty
+// acknowledged it. Error acknowledgements are refunded by the transfer module
+// before this is called, and the refund is burned. Packets that are not
+// tracked by the module are ignored.
+func (k Keeper) OnTransferAcknowledged(ctx context.Context, channel string, sequence uint64, success bool) error {
+	packet, found := k.GetTransferPacket(ctx, channel, sequence)
+	if !found || !packet.IsPending() {
+		return nil
+	}
+
+	if success {
+		packet.Status = types.TRANSFER_STATUS_COMPLETED
+		packet.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		k.SetTransferPacket(ctx, packet)
+		return nil
+	}
+	return k.refundTransfer(ctx, packet, types.TRANSFER_STATUS_REFUNDED_ERROR)
+}
+
+// OnTransferTimeout burns the refund of a tracked transfer that timed out.
+// Packets that are not tracked by the module are ignored.
+func (k Keeper) OnTransferTimeout(ctx context.Context, channel string, sequence uint64) error {
+	packet, found := k.GetTransferPacket(ctx, channel, sequence)
+	if !found || !packet.IsPending() {
+		return nil
+	}
+	return k.refundTransfer(ctx, packet, types.TRANSFER_STATUS_REFUNDED_TIMEOUT)
+}
+
+// refundTransfer burns the tokens the transfer module refunded to the
+// transfer sender, which restores the headroom below Params.maxSupply. The
+// distribution history is kept as is; the packet status records the refund.
+func (k Keeper) refundTransfer(ctx context.Context, packet types.TransferPacket, status types.TransferStatus) error {
+	coins := sdk.NewCoins(packet.Token)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.TransferSenderAddress, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	k.AddBurned(ctx, packet.Token.Amount)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	packet.Status = status
+	packet.Height = sdkCtx.BlockHeight()
+	k.SetTransferPacket(ctx, packet)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferRefund,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(packet.DistributionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, packet.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SetTransferPacket set a specific transferPacket in the store from its index
+func (k Keeper) SetTransferPacket(ctx context.Context, transferPacket types.TransferPacket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransferPacketKeyPrefix))
+	b := k.cdc.MustMarshal(&transferPacket)
+	store.Set(types.TransferPacketKey(
+		transferPacket.Channel,
+		transferPacket.Sequence,
+	), b)
+}
+
+// GetTransferPacket returns a transferPacket from its index
+func (k Keeper) GetTransferPacket(
+	ctx context.Context,
+	channel string,
+	sequence uint64,
+
+) (val types.TransferPacket, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransferPacketKeyPrefix))
+
+	b := store.Get(types.TransferPacketKey(
+		channel,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTransferPacket returns all transferPacket
+func (k Keeper) GetAllTransferPacket(ctx context.Context) (list []types.TransferPacket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransferPacketKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TransferPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMsgDistributeCrossChain(t *testing.T) {
+	distributor := sample.AccAddress()
+	alice := sample.AccAddress()
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.BankKeeper, *keepertest.TransferKeeper, types.Params) {
+		k, ctx, bank, transfer := keepertest.OptioKeeperWithTransfer(t)
+		params := types.DefaultParams()
+		params.AuthorizedAccounts = []string{distributor}
+		params.MaxSupply = math.NewInt(100)
+		require.NoError(t, k.SetParams(ctx, params))
+		return k, ctx, bank, transfer, params
+	}
+	distribute := func(ctx sdk.Context, k keeper.Keeper, amount int64) (*types.MsgDistributeResponse, error) {
+		return keeper.NewMsgServerImpl(k).Distribute(ctx, types.NewMsgDistribute(distributor, math.NewInt(amount+10), []*types.Recipient{
+			{Address: alice, Amount: math.NewInt(10)},
+			{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(amount)},
+		}))
+	}
+
+	t.Run("sends a tracked transfer", func(t *testing.T) {
+		k, ctx, bank, transfer, params := setup(t)
+
+		res, err := distribute(ctx, k, 40)
+		require.NoError(t, err)
+
+		require.Len(t, transfer.Sent, 1)
+		sent := transfer.Sent[0]
+		require.Equal(t, transfertypes.PortID, sent.SourcePort)
+		require.Equal(t, "channel-0", sent.SourceChannel)
+		require.Equal(t, types.TransferSenderAddress.String(), sent.Sender)
+		require.Equal(t, "cosmos1receiver", sent.Receiver)
+		require.Equal(t, uint64(ctx.BlockTime().Add(params.TransferTimeout).UnixNano()), sent.TimeoutTimestamp)
+		require.Equal(t, types.CallbackMemo(), sent.Memo)
+
+		packet, found := k.GetTransferPacket(ctx, "channel-0", 1)
+		require.True(t, found)
+		require.Equal(t, res.DistributionId, packet.DistributionId)
+		require.Equal(t, sdk.NewInt64Coin(params.Denom, 40), packet.Token)
+		require.Equal(t, types.TRANSFER_STATUS_PENDING, packet.Status)
+
+		outcome, found := k.GetPacketOutcome(ctx, transfertypes.PortID, "channel-0", 1)
+		require.True(t, found)
+		require.Equal(t, types.PACKET_OUTCOME_STATUS_PENDING, outcome.Status)
+
+		require.Equal(t, math.NewInt(40), bank.GetBalance(ctx, escrow, params.Denom).Amount)
+		require.Equal(t, math.NewInt(10), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(alice), params.Denom).Amount)
+		require.Equal(t, math.NewInt(50), k.GetSupplyRecord(ctx).Minted)
+	})
+
+	t.Run("disabled without transfer timeout", func(t *testing.T) {
+		k, ctx, _, _, params := setup(t)
+		params.TransferTimeout = 0
+		require.NoError(t, k.SetParams(ctx, params))
+
+		_, err := distribute(ctx, k, 40)
+		require.ErrorIs(t, err, types.ErrCrossChainDisabled)
+	})
+
+	t.Run("success acknowledgement", func(t *testing.T) {
+		k, ctx, _, _, _ := setup(t)
+		_, err := distribute(ctx, k, 40)
+		require.NoError(t, err)
+
+		require.NoError(t, k.OnTransferAcknowledged(ctx, "channel-0", 1, true))
+		packet, _ := k.GetTransferPacket(ctx, "channel-0", 1)
+		require.Equal(t, types.TRANSFER_STATUS_COMPLETED, packet.Status)
+		require.True(t, k.GetSupplyRecord(ctx).Burned.IsZero())
+	})
+
+	for _, tc := range []struct {
+		name   string
+		refund func(k keeper.Keeper, ctx sdk.Context) error
+		status types.TransferStatus
+	}{
+		{
+			name:   "timeout",
+			refund: func(k keeper.Keeper, ctx sdk.Context) error { return k.OnTransferTimeout(ctx, "channel-0", 1) },
+			status: types.TRANSFER_STATUS_REFUNDED_TIMEOUT,
+		},
+		{
+			name: "error acknowledgement",
+			refund: func(k keeper.Keeper, ctx sdk.Context) error {
+				return k.OnTransferAcknowledged(ctx, "channel-0", 1, false)
+			},
+			status: types.TRANSFER_STATUS_REFUNDED_ERROR,
+		},
+	} {
+		t.Run(tc.name+" burns the refund", func(t *testing.T) {
+			k, ctx, bank, transfer, params := setup(t)
+			_, err := distribute(ctx, k, 90)
+			require.NoError(t, err)
+
+			// the supply is at the max until the refund is burned
+			_, err = distribute(ctx, k, 1)
+			require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+
+			require.NoError(t, transfer.Refund(transfer.Sent[0]))
+			require.NoError(t, tc.refund(k, ctx))
+
+			packet, _ := k.GetTransferPacket(ctx, "channel-0", 1)
+			require.Equal(t, tc.status, packet.Status)
+			require.Equal(t, math.NewInt(90), k.GetSupplyRecord(ctx).Burned)
+			require.Equal(t, math.NewInt(10), bank.GetSupply(ctx, params.Denom).Amount)
+			require.True(t, bank.GetBalance(ctx, types.TransferSenderAddress, params.Denom).IsZero())
+
+			// a second callback for the same packet is a no-op
+			require.NoError(t, tc.refund(k, ctx))
+			require.Equal(t, math.NewInt(90), k.GetSupplyRecord(ctx).Burned)
+
+			// the headroom is restored
+			_, err = distribute(ctx, k, 80)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("ignores untracked packets", func(t *testing.T) {
+		k, ctx, _, _, _ := setup(t)
+		require.NoError(t, k.OnTransferTimeout(ctx, "channel-0", 7))
+		require.NoError(t, k.OnTransferAcknowledged(ctx, "channel-0", 7, false))
+		require.Empty(t, k.GetAllTransferPacket(ctx))
+	})
+}
@@ -0,0 +1,50 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// v1GenesisState is the v1 optio genesis state. Exporters disagree on whether
+// uint64 is written as a JSON number or a string, so both are accepted.
+type v1GenesisState struct {
+	Params struct {
+		AuthorizedAccounts []string    `json:"authorizedAccounts"`
+		Denom              string      `json:"denom"`
+		MaxSupply          json.Number `json:"maxSupply"`
+	} `json:"params"`
+}
+
+// MigrateGenesis converts a v1 optio genesis state into the v2 format, where
+// amounts are math.Int. The params added after v1 are set to their defaults.
+func MigrateGenesis(bz json.RawMessage) (*types.GenesisState, error) {
+	var old v1GenesisState
+	if err := json.Unmarshal(bz, &old); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal v1 %s genesis state: %w", types.ModuleName, err)
+	}
+
+	maxSupply := math.ZeroInt()
+	if old.Params.MaxSupply != "" {
+		var ok bool
+		maxSupply, ok = math.NewIntFromString(old.Params.MaxSupply.String())
+		if !ok {
+			return nil, fmt.Errorf("invalid v1 maxSupply %q", old.Params.MaxSupply)
+		}
+	}
+
+	genState := types.DefaultGenesis()
+	genState.Params.AuthorizedAccounts = old.Params.AuthorizedAccounts
+	genState.Params.Denom = old.Params.Denom
+	genState.Params.MaxSupply = maxSupply
+	genState.Params.MinimumGasPrices = minimumGasPrices(old.Params.Denom)
+
+	if err := genState.Validate(); err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
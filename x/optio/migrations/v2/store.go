@@ -0,0 +1,104 @@
+package v2
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The only
+// persisted amount in v1 is Params.MaxSupply, which was encoded as a uint64
+// varint and is now a string-encoded math.Int. The params added after v1 are
+// set to their defaults, with the minimum gas prices in Params.Denom.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := kvStore.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	params, err := unmarshalV1Params(bz)
+	if err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// unmarshalV1Params decodes the v1 Params wire format:
+//
+//	repeated string authorizedAccounts = 1;
+//	string denom = 2;
+//	uint64 maxSupply = 3;
+func unmarshalV1Params(bz []byte) (types.Params, error) {
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = nil
+	params.Denom = ""
+	params.MaxSupply = math.ZeroInt()
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return params, fmt.Errorf("invalid v1 params: %w", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(bz)
+			if n < 0 {
+				return params, fmt.Errorf("invalid v1 params authorizedAccounts: %w", protowire.ParseError(n))
+			}
+			params.AuthorizedAccounts = append(params.AuthorizedAccounts, v)
+			bz = bz[n:]
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(bz)
+			if n < 0 {
+				return params, fmt.Errorf("invalid v1 params denom: %w", protowire.ParseError(n))
+			}
+			params.Denom = v
+			bz = bz[n:]
+		case num == 3 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return params, fmt.Errorf("invalid v1 params maxSupply: %w", protowire.ParseError(n))
+			}
+			params.MaxSupply = math.NewIntFromUint64(v)
+			bz = bz[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return params, fmt.Errorf("invalid v1 params field %d: %w", num, protowire.ParseError(n))
+			}
+			bz = bz[n:]
+		}
+	}
+	params.MinimumGasPrices = minimumGasPrices(params.Denom)
+
+	return params, nil
+}
+
+// minimumGasPrices returns the default price of 1 per unit of gas in denom,
+// or no prices without a denom.
+func minimumGasPrices(denom string) sdk.DecCoins {
+	if denom == "" {
+		return nil
+	}
+	return sdk.NewDecCoins(sdk.NewDecCoin(denom, math.OneInt()))
+}
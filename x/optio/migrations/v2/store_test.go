@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	v2 "github.com/OptioServices/optio/x/optio/migrations/v2"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	account := sample.AccAddress()
+
+	// v1 params: repeated string authorizedAccounts = 1; string denom = 2; uint64 maxSupply = 3;
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+	bz = protowire.AppendString(bz, account)
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendString(bz, "uOPT")
+	bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 18_000_000_000_000_000_000)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params))
+	require.Equal(t, []string{account}, params.AuthorizedAccounts)
+	require.Equal(t, "uOPT", params.Denom)
+	require.Equal(t, math.NewIntFromUint64(18_000_000_000_000_000_000), params.MaxSupply)
+
+	// the params added after v1 have their defaults, zero lengths would
+	// reject every reference, category and memo
+	require.Equal(t, types.DefaultMaxReferenceLength, params.MaxReferenceLength)
+	require.Equal(t, types.DefaultMaxCategoryLength, params.MaxCategoryLength)
+	require.Equal(t, types.DefaultMaxMemoLength, params.MaxMemoLength)
+	// and the recipient cap and gas are not silently off
+	require.Equal(t, types.DefaultMaxRecipientsPerMsg, params.MaxRecipientsPerMsg)
+	require.Equal(t, types.DefaultGasPerRecipient, params.GasPerRecipient)
+	want := types.DefaultParams()
+	want.AuthorizedAccounts = []string{account}
+	want.MaxSupply = params.MaxSupply
+	require.Equal(t, want.String(), params.String())
+}
+
+func TestMigrateGenesis(t *testing.T) {
+	for _, maxSupply := range []string{`1000`, `"1000"`} {
+		genState, err := v2.MigrateGenesis([]byte(`{"params":{"authorizedAccounts":[],"denom":"uOPT","maxSupply":` + maxSupply + `}}`))
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(1000), genState.Params.MaxSupply)
+		require.Equal(t, types.DefaultMaxReferenceLength, genState.Params.MaxReferenceLength)
+		require.Equal(t, types.DefaultMaxCategoryLength, genState.Params.MaxCategoryLength)
+		require.Equal(t, types.DefaultMaxMemoLength, genState.Params.MaxMemoLength)
+		require.Equal(t, types.DefaultMaxRecipientsPerMsg, genState.Params.MaxRecipientsPerMsg)
+		require.Equal(t, types.DefaultGasPerRecipient, genState.Params.GasPerRecipient)
+	}
+
+	// the minimum gas prices are in the denom of the chain
+	genState, err := v2.MigrateGenesis([]byte(`{"params":{"authorizedAccounts":[],"denom":"uother","maxSupply":"1000"}}`))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("uother", 1)), genState.Params.MinimumGasPrices)
+}
+
+func TestMigrateStoreMinimumGasPrices(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	var bz []byte
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendString(bz, "uother")
+	bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 1000)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("uother", 1)), params.MinimumGasPrices)
+}
@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"sort"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. Stores
+// written before the distributor stats and the supply record existed are
+// backfilled from the distribution history: the stats are recomputed and a
+// missing supply record starts at the minted and burned amounts of the
+// history. The bank supply of Params.Denom is not used, the mint, staking
+// and gov modules mint and burn the same denom when it is the bond denom.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	distributions, err := migrateDistributorStats(kvStore, cdc)
+	if err != nil {
+		return err
+	}
+
+	if kvStore.Has(types.SupplyRecordKey) {
+		return nil
+	}
+	transfers, err := transferPackets(kvStore, cdc)
+	if err != nil {
+		return err
+	}
+	record := types.NewSupplyRecord()
+	record.Minted, record.Burned = types.ModuleFlows(distributions, transfers)
+	if record.IsZero() {
+		return nil
+	}
+	bz, err := cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.SupplyRecordKey, bz)
+
+	return nil
+}
+
+// migrateDistributorStats overwrites the distributor stats with the totals
+// of the distribution history and returns the history.
+func migrateDistributorStats(kvStore storetypes.KVStore, cdc codec.BinaryCodec) ([]types.Distribution, error) {
+	distributionStore := prefix.NewStore(kvStore, types.KeyPrefix(types.DistributionKey))
+	iterator := storetypes.KVStorePrefixIterator(distributionStore, []byte{})
+	defer iterator.Close()
+
+	var distributions []types.Distribution
+	stats := make(map[string]types.DistributorStats)
+	for ; iterator.Valid(); iterator.Next() {
+		var distribution types.Distribution
+		if err := cdc.Unmarshal(iterator.Value(), &distribution); err != nil {
+			return nil, err
+		}
+		distributions = append(distributions, distribution)
+
+		s, ok := stats[distribution.Distributor]
+		if !ok {
+			s = types.DistributorStats{Address: distribution.Distributor, TotalDistributed: math.ZeroInt()}
+		}
+		s.TotalDistributed = s.TotalDistributed.Add(distribution.Amount)
+		s.DistributionCount++
+		if distribution.Height > s.LastHeight {
+			s.LastHeight = distribution.Height
+		}
+		stats[distribution.Distributor] = s
+	}
+
+	addresses := make([]string, 0, len(stats))
+	for address := range stats {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	statsStore := prefix.NewStore(kvStore, types.KeyPrefix(types.DistributorStatsKeyPrefix))
+	for _, address := range addresses {
+		s := stats[address]
+		bz, err := cdc.Marshal(&s)
+		if err != nil {
+			return nil, err
+		}
+		statsStore.Set(types.DistributorStatsKey(address), bz)
+	}
+
+	return distributions, nil
+}
+
+// transferPackets returns the tracked transfers to cross-chain recipients.
+func transferPackets(kvStore storetypes.KVStore, cdc codec.BinaryCodec) ([]types.TransferPacket, error) {
+	transferStore := prefix.NewStore(kvStore, types.KeyPrefix(types.TransferPacketKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(transferStore, []byte{})
+	defer iterator.Close()
+
+	var packets []types.TransferPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.TransferPacket
+		if err := cdc.Unmarshal(iterator.Value(), &packet); err != nil {
+			return nil, err
+		}
+		packets = append(packets, packet)
+	}
+	return packets, nil
+}
@@ -0,0 +1,117 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	v3 "github.com/OptioServices/optio/x/optio/migrations/v3"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	alice, bob, recipient := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for id, d := range []struct {
+		distributor string
+		amount      int64
+		height      int64
+	}{
+		{alice, 10, 3},
+		{bob, 5, 4},
+		{alice, 20, 7},
+	} {
+		distribution := types.Distribution{
+			Id:          uint64(id),
+			Distributor: d.distributor,
+			Amount:      math.NewInt(d.amount),
+			Recipients:  []types.Recipient{{Address: recipient, Amount: math.NewInt(d.amount)}},
+			Height:      d.height,
+		}
+		store.Set(append(types.KeyPrefix(types.DistributionKey), keeper.GetDistributionIDBytes(uint64(id))...), cdc.MustMarshal(&distribution))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	statsOf := func(address string) types.DistributorStats {
+		var stats types.DistributorStats
+		bz := store.Get(append(types.KeyPrefix(types.DistributorStatsKeyPrefix), types.DistributorStatsKey(address)...))
+		require.NotNil(t, bz, address)
+		require.NoError(t, cdc.Unmarshal(bz, &stats))
+		return stats
+	}
+	require.Equal(t, types.DistributorStats{Address: alice, TotalDistributed: math.NewInt(30), DistributionCount: 2, LastHeight: 7}, statsOf(alice))
+	require.Equal(t, types.DistributorStats{Address: bob, TotalDistributed: math.NewInt(5), DistributionCount: 1, LastHeight: 4}, statsOf(bob))
+
+	var record types.SupplyRecord
+	require.NoError(t, cdc.Unmarshal(store.Get(types.SupplyRecordKey), &record))
+	require.Equal(t, math.NewInt(35), record.Minted)
+	require.Equal(t, math.ZeroInt(), record.Burned)
+
+	// an existing supply record is kept
+	distribution := types.Distribution{
+		Id:          3,
+		Distributor: alice,
+		Amount:      math.NewInt(1),
+		Recipients:  []types.Recipient{{Address: recipient, Amount: math.NewInt(1)}},
+	}
+	store.Set(append(types.KeyPrefix(types.DistributionKey), keeper.GetDistributionIDBytes(3)...), cdc.MustMarshal(&distribution))
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(types.SupplyRecordKey), &record))
+	require.Equal(t, math.NewInt(35), record.Minted)
+}
+
+func TestMigrateStoreRefundedTransfers(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	distribution := types.Distribution{
+		Distributor: sample.AccAddress(),
+		Amount:      math.NewInt(30),
+		Recipients:  []types.Recipient{{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(30)}},
+	}
+	store.Set(append(types.KeyPrefix(types.DistributionKey), keeper.GetDistributionIDBytes(0)...), cdc.MustMarshal(&distribution))
+	packet := types.TransferPacket{
+		Channel:  "channel-0",
+		Sequence: 1,
+		Receiver: "cosmos1receiver",
+		Token:    sdk.NewInt64Coin("uOPT", 30),
+		Status:   types.TRANSFER_STATUS_REFUNDED_TIMEOUT,
+	}
+	store.Set(append(types.KeyPrefix(types.TransferPacketKeyPrefix), types.TransferPacketKey(packet.Channel, packet.Sequence)...), cdc.MustMarshal(&packet))
+
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var record types.SupplyRecord
+	require.NoError(t, cdc.Unmarshal(store.Get(types.SupplyRecordKey), &record))
+	require.Equal(t, math.NewInt(30), record.Minted)
+	require.Equal(t, math.NewInt(30), record.Burned)
+}
+
+func TestMigrateStoreEmpty(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// without a history the record stays unset, like on a new chain
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	require.False(t, ctx.KVStore(storeKey).Has(types.SupplyRecordKey))
+}
@@ -0,0 +1,50 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. Params
+// written before the base fee existed have no decimal base fee params, which
+// are set to their defaults. The base fee stays disabled.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := kvStore.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if params.BaseFeeMaxChangeRate.IsNil() {
+		params.BaseFeeMaxChangeRate = types.DefaultBaseFeeMaxChangeRate
+	}
+	if params.MinBaseFee.IsNil() {
+		params.MinBaseFee = types.DefaultMinBaseFee
+	}
+	if params.MaxBaseFee.IsNil() {
+		params.MaxBaseFee = types.DefaultMaxBaseFee
+	}
+	if params.BaseFeeBurnRate.IsNil() {
+		params.BaseFeeBurnRate = types.DefaultBaseFeeBurnRate
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v4 "github.com/OptioServices/optio/x/optio/migrations/v4"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	// v3 params have no decimal base fee params, fields 22 to 25
+	params := types.DefaultParams()
+	params.MaxMemoLength = 42
+	var bz []byte
+	for rest := cdc.MustMarshal(&params); len(rest) > 0; {
+		num, _, n := protowire.ConsumeField(rest)
+		require.GreaterOrEqual(t, n, 0)
+		if num < 22 || num > 25 {
+			bz = append(bz, rest[:n]...)
+		}
+		rest = rest[n:]
+	}
+	store.Set(types.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var got types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &got))
+	want := types.DefaultParams()
+	want.MaxMemoLength = 42
+	require.Equal(t, want.String(), got.String())
+	require.False(t, got.BaseFeeEnabled())
+
+	// params that are set are kept
+	got.BaseFeeBurnRate = math.LegacyNewDecWithPrec(5, 1)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&got))
+	require.NoError(t, v4.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &got))
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), got.BaseFeeBurnRate)
+}
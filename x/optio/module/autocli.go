@@ -0,0 +1,235 @@
+package optio
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "github.com/OptioServices/optio/api/optio/optio"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "DistributionAll",
+					Use:       "list-distribution",
+					Short:     "List all distributions, optionally filtered by --category",
+				},
+				{
+					RpcMethod:      "Distribution",
+					Use:            "show-distribution [id]",
+					Short:          "Shows a distribution by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "AddressStatusAll",
+					Use:       "list-address-status",
+					Short:     "List all addresses with a compliance status",
+				},
+				{
+					RpcMethod:      "AddressStatus",
+					Use:            "show-address-status [address]",
+					Short:          "Shows the compliance status of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Shows the base fee per unit of gas of the next block",
+				},
+				{
+					RpcMethod:      "DistributeGas",
+					Use:            "distribute-gas [num-recipients]",
+					Short:          "Shows the gas charged and the number of messages needed to distribute to num-recipients",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "num_recipients"}},
+				},
+				{
+					RpcMethod: "AuthorizedAccounts",
+					Use:       "authorized-accounts",
+					Short:     "List the accounts holding a role in the params with their distribution statistics",
+				},
+				{
+					RpcMethod: "FeeAllowanceBudget",
+					Use:       "fee-allowance-budget",
+					Short:     "Shows the fee allowance budget for first-time recipients and its usage",
+				},
+				{
+					RpcMethod: "TransferPacketAll",
+					Use:       "list-transfer-packet",
+					Short:     "List the ICS-20 transfers sent to cross-chain recipients",
+				},
+				{
+					RpcMethod:      "TransferPacket",
+					Use:            "show-transfer-packet [channel] [sequence]",
+					Short:          "Shows the status of the ICS-20 transfer sent to a cross-chain recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}, {ProtoField: "sequence"}},
+				},
+				{
+					RpcMethod: "RateLimitAll",
+					Use:       "list-rate-limit",
+					Short:     "List the rate limits of the ICS-20 channels with their current flow",
+				},
+				{
+					RpcMethod:      "RateLimit",
+					Use:            "show-rate-limit [channel-id]",
+					Short:          "Shows the rate limit of an ICS-20 channel with its current flow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod:      "RemoteTreasury",
+					Use:            "show-remote-treasury [connection-id]",
+					Short:          "Shows the remote treasury of the module on the host chain of a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod: "RemoteDistributionAll",
+					Use:       "list-remote-distribution",
+					Short:     "List the distributions executed by the remote treasuries",
+				},
+				{
+					RpcMethod: "RemoteDistribution",
+					Use:       "show-remote-distribution [channel-id] [sequence]",
+					Short:     "Shows a distribution executed by a remote treasury",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel_id"},
+						{ProtoField: "sequence"},
+					},
+				},
+				{
+					RpcMethod: "PacketOutcomeAll",
+					Use:       "list-packet-outcome",
+					Short:     "List the outcomes of the cross-chain payouts sent by the module",
+				},
+				{
+					RpcMethod: "PacketOutcome",
+					Use:       "show-packet-outcome [port-id] [channel-id] [sequence]",
+					Short:     "Shows the outcome of a cross-chain payout sent by the module",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "port_id"},
+						{ProtoField: "channel_id"},
+						{ProtoField: "sequence"},
+					},
+				},
+				{
+					RpcMethod: "PendingPayouts",
+					Use:       "pending-payouts",
+					Short:     "List the cross-chain payouts that await their acknowledgement",
+				},
+				{
+					RpcMethod:      "FeeExemption",
+					Use:            "fee-exemption [address]",
+					Short:          "Shows the fee exempt txs an authorized account may still send in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "Distribute",
+					Use:       "distribute [amount] [address:amount[:reference],...]",
+					Short:     "Mint and distribute amount to the given recipients",
+					Long: `Mint and distribute amount to the given recipients. Recipients are given as
+address:amount pairs with an optional accounting reference, separated by commas
+or spaces. The recipient amounts must add up to amount. Recipients on other
+chains are given as channel/receiver:amount and paid with an ICS-20 transfer
+over the channel.`,
+					Example: "distribute 300 optio1...:100:INV-1,channel-0/cosmos1...:200 --category payroll --from distributor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+						{ProtoField: "recipients", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SetAddressStatus",
+					Use:       "set-address-status [address:allowed|denied|unspecified,...]",
+					Short:     "Set the compliance status of one or more addresses",
+					Example:   "set-address-status optio1...:denied,optio1...:allowed --from compliance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "statuses", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SendDistribution",
+					Use:       "send-distribution [channel-id] [amount] [address:amount[:reference],...]",
+					Short:     "Send a distribution to the host chain of an optio channel",
+					Long: `Send a distribution packet over an optio channel. The host chain mints and pays
+out amount to the given recipients, which are accounts on the host chain, if
+the channel is approved as a remote controller there. Recipients rejected by
+its compliance policy are skipped; the acknowledgement reports the result of
+every recipient.`,
+					Example: "send-distribution channel-1 300 optio1...:100:INV-1,optio1...:200 --from distributor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel_id"},
+						{ProtoField: "amount"},
+						{ProtoField: "recipients", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SetRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterRemoteTreasury",
+					Use:       "register-remote-treasury [connection-id]",
+					Short:     "Register the remote treasury of the module on the host chain of a connection",
+					Long: `Register an interchain account owned by the module on the host chain of a
+connection. The remote treasury is usable once a relayer completes the channel
+handshake.`,
+					Example: "register-remote-treasury connection-0 --from distributor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "connection_id"},
+					},
+				},
+				{
+					RpcMethod: "RemoteDistribute",
+					Use:       "remote-distribute [connection-id] [address:coin,...]",
+					Short:     "Execute a multi-send from the remote treasury on the host chain of a connection",
+					Long: `Execute a bank multi-send from the remote treasury of a connection. The outputs
+are accounts on the host chain and the coin sent to them, an account paid in
+several denoms is given once per denom. The acknowledgement of the host chain is
+tracked as a remote distribution.`,
+					Example: "remote-distribute connection-0 cosmos1...:100uatom,cosmos1...:200uatom --from distributor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "connection_id"},
+						{ProtoField: "outputs", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RegisterFeeSponsor",
+					Use:       "register-fee-sponsor",
+					Short:     "Consent to pay for the fee allowances of recipients",
+					Long: `Register the sender as a fee sponsor. Governance can then make it the fee
+allowance sponsor, which grants the fee allowances of first-time recipients
+from the balance of the sender.`,
+					Example: "register-fee-sponsor --from sponsor",
+				},
+				{
+					RpcMethod: "UnregisterFeeSponsor",
+					Use:       "unregister-fee-sponsor",
+					Short:     "Withdraw the consent to pay for the fee allowances of recipients",
+					Example:   "unregister-fee-sponsor --from sponsor",
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
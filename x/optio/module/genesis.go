@@ -0,0 +1,114 @@
+package optio
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the distribution
+	for _, elem := range genState.DistributionList {
+		k.SetDistribution(ctx, elem)
+	}
+
+	// Set distribution count, the count and usage singletons are only
+	// written once used so that an import reproduces the exported store
+	if genState.DistributionCount > 0 {
+		k.SetDistributionCount(ctx, genState.DistributionCount)
+	}
+	// Set all the addressStatus
+	for _, elem := range genState.AddressStatusList {
+		k.SetAddressStatus(ctx, elem)
+	}
+	// Set all the distributorStats
+	for _, elem := range genState.DistributorStatsList {
+		k.SetDistributorStats(ctx, elem)
+	}
+	// Set all the feeAllowanceGrant
+	for _, elem := range genState.FeeAllowanceGrantList {
+		k.SetFeeAllowanceGrant(ctx, elem)
+	}
+	if genState.FeeAllowanceUsage.Count > 0 || !genState.FeeAllowanceUsage.Granted.IsZero() {
+		k.SetFeeAllowanceUsage(ctx, genState.FeeAllowanceUsage)
+	}
+	// the record is only written once used so that an import reproduces the
+	// exported store; balances funded in the bank genesis are not minted by
+	// the module and do not count
+	if !genState.SupplyRecord.IsZero() {
+		k.SetSupplyRecord(ctx, genState.SupplyRecord.WithDefaults())
+	}
+	// Set all the transferPacket
+	for _, elem := range genState.TransferPacketList {
+		k.SetTransferPacket(ctx, elem)
+	}
+	// Set all the rateLimit
+	for _, elem := range genState.RateLimitList {
+		k.SetRateLimit(ctx, elem)
+	}
+	// Set all the rateLimitPendingPacket
+	for _, elem := range genState.RateLimitPendingPacketList {
+		k.SetRateLimitPendingPacket(ctx, elem)
+	}
+	for _, elem := range genState.RemoteDistributionList {
+		k.SetRemoteDistribution(ctx, elem)
+	}
+	// Set all the packetOutcome, which rebuilds the pending index
+	for _, elem := range genState.PacketOutcomeList {
+		k.SetPacketOutcome(ctx, elem)
+	}
+	for _, elem := range genState.FeeExemptUsageList {
+		k.SetFeeExemptUsage(ctx, elem)
+	}
+	for _, elem := range genState.FeeSponsorList {
+		k.SetFeeSponsor(ctx, elem)
+	}
+	k.SetBaseFee(ctx, genState.BaseFee)
+	if genState.FeeExemptBlockGas != (types.FeeExemptBlockGas{}) {
+		k.SetFeeExemptBlockGas(ctx, genState.FeeExemptBlockGas)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if k.ShouldBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.PortId = k.GetPort(ctx)
+
+	genesis.DistributionList = k.GetAllDistribution(ctx)
+	genesis.DistributionCount = k.GetDistributionCount(ctx)
+	genesis.AddressStatusList = k.GetAllAddressStatus(ctx)
+	genesis.DistributorStatsList = k.GetAllDistributorStats(ctx)
+	genesis.FeeAllowanceUsage = k.GetFeeAllowanceUsage(ctx)
+	genesis.FeeAllowanceGrantList = k.GetAllFeeAllowanceGrant(ctx)
+	genesis.SupplyRecord = k.GetSupplyRecord(ctx)
+	genesis.TransferPacketList = k.GetAllTransferPacket(ctx)
+	genesis.RateLimitList = k.GetAllRateLimit(ctx)
+	genesis.RateLimitPendingPacketList = k.GetAllRateLimitPendingPacket(ctx)
+	genesis.RemoteDistributionList = k.GetAllRemoteDistribution(ctx)
+	genesis.PacketOutcomeList = k.GetAllPacketOutcome(ctx)
+	genesis.FeeExemptUsageList = k.GetAllFeeExemptUsage(ctx)
+	genesis.BaseFee = k.GetStoredBaseFee(ctx)
+	genesis.FeeSponsorList = k.GetAllFeeSponsor(ctx)
+	genesis.FeeExemptBlockGas = k.GetStoredFeeExemptBlockGas(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
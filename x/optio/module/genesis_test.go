@@ -0,0 +1,237 @@
+package optio_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioServices/optio/testutil/keeper"
+	"github.com/OptioServices/optio/testutil/nullify"
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	optio "github.com/OptioServices/optio/x/optio/module"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestGenesis(t *testing.T) {
+	distributor := sample.AccAddress()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		PortId: types.PortID,
+
+		DistributionList: []types.Distribution{
+			{
+				Id:          0,
+				Distributor: distributor,
+				Amount:      math.NewInt(30),
+				Recipients: []types.Recipient{
+					{Address: alice, Amount: math.NewInt(10), Reference: "INV-1"},
+					{Address: bob, Amount: math.NewInt(20)},
+				},
+				Category: "payroll",
+				Height:   3,
+				Time:     time.Unix(1700000000, 0).UTC(),
+			},
+			{
+				Id:          1,
+				Distributor: distributor,
+				Amount:      math.NewInt(5),
+				Recipients:  []types.Recipient{{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(5)}},
+				Height:      4,
+				Time:        time.Unix(1700000060, 0).UTC(),
+			},
+		},
+		DistributionCount: 2,
+		AddressStatusList: []types.AddressStatus{
+			{Address: alice, Status: types.COMPLIANCE_STATUS_ALLOWED},
+			{Address: bob, Status: types.COMPLIANCE_STATUS_DENIED},
+		},
+		DistributorStatsList: []types.DistributorStats{
+			{Address: distributor, TotalDistributed: math.NewInt(35), DistributionCount: 2, LastHeight: 4},
+		},
+		FeeAllowanceUsage: types.FeeAllowanceUsage{
+			Granted: sdk.NewCoins(sdk.NewInt64Coin("uOPT", 200)),
+			Count:   2,
+		},
+		FeeAllowanceGrantList: []types.FeeAllowanceGrant{
+			{Address: alice, Granter: distributor, Height: 3},
+			{Address: bob, Granter: distributor, Height: 3},
+		},
+		SupplyRecord: types.SupplyRecord{Minted: math.NewInt(35), Burned: math.ZeroInt(), BaseFeeBurned: math.NewInt(3)},
+		TransferPacketList: []types.TransferPacket{
+			{
+				Channel:        "channel-0",
+				Sequence:       1,
+				DistributionId: 1,
+				Receiver:       "cosmos1receiver",
+				Token:          sdk.NewInt64Coin("uOPT", 5),
+				Status:         types.TRANSFER_STATUS_PENDING,
+				Height:         4,
+			},
+		},
+		RateLimitList: []types.RateLimit{
+			{
+				ChannelId: "channel-0",
+				Quota:     types.RateLimitQuota{MaxPercent: math.LegacyNewDec(5), MaxAmount: math.NewInt(100), Window: time.Hour},
+				Flow: types.RateLimitFlow{
+					Inflow:      math.NewInt(3),
+					Outflow:     math.NewInt(5),
+					Supply:      math.NewInt(38),
+					WindowStart: time.Unix(1080, 0).UTC(),
+					Buckets: []types.RateLimitBucket{
+						{Start: time.Unix(1080, 0).UTC(), Inflow: math.NewInt(3), Outflow: math.ZeroInt(), Supply: math.NewInt(38)},
+						{Start: time.Unix(1440, 0).UTC(), Inflow: math.ZeroInt(), Outflow: math.NewInt(5), Supply: math.NewInt(41)},
+					},
+				},
+			},
+		},
+		RateLimitPendingPacketList: []types.RateLimitPendingPacket{
+			{ChannelId: "channel-0", Sequence: 1, Amount: math.NewInt(5), BucketStart: time.Unix(1440, 0).UTC()},
+		},
+		RemoteDistributionList: []types.RemoteDistribution{
+			{
+				ChannelId:    "channel-1",
+				Sequence:     1,
+				ConnectionId: "connection-0",
+				FromAddress:  distributor,
+				Treasury:     "cosmos1treasury",
+				Outputs:      []banktypes.Output{{Address: "cosmos1receiver", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))}},
+				Status:       types.REMOTE_DISTRIBUTION_STATUS_COMPLETED,
+				Height:       5,
+			},
+		},
+		PacketOutcomeList: []types.PacketOutcome{
+			{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Status: types.PACKET_OUTCOME_STATUS_PENDING, SendHeight: 3, Height: 3},
+			{PortId: "icacontroller-optio", ChannelId: "channel-1", Sequence: 1, Status: types.PACKET_OUTCOME_STATUS_ACKNOWLEDGED, SendHeight: 3, Height: 5},
+		},
+		FeeExemptUsageList: []types.FeeExemptUsage{
+			{Address: distributor, Count: 3, WindowStart: time.Unix(100, 0).UTC()},
+		},
+		BaseFee: math.LegacyNewDecWithPrec(15, 1),
+		FeeSponsorList: []types.FeeSponsor{
+			{Address: distributor, Height: 2},
+		},
+		FeeExemptBlockGas: types.FeeExemptBlockGas{Height: 5, Gas: 1000},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.OptioKeeper(t)
+	optio.InitGenesis(ctx, k, genesisState)
+	got := optio.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+	require.NoError(t, got.Validate())
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.Equal(t, genesisState.PortId, got.PortId)
+
+	require.ElementsMatch(t, genesisState.DistributionList, got.DistributionList)
+	require.Equal(t, genesisState.DistributionCount, got.DistributionCount)
+	require.ElementsMatch(t, genesisState.AddressStatusList, got.AddressStatusList)
+	require.ElementsMatch(t, genesisState.DistributorStatsList, got.DistributorStatsList)
+	require.Equal(t, genesisState.FeeAllowanceUsage, got.FeeAllowanceUsage)
+	require.ElementsMatch(t, genesisState.FeeAllowanceGrantList, got.FeeAllowanceGrantList)
+	require.Equal(t, genesisState.SupplyRecord, got.SupplyRecord)
+	require.ElementsMatch(t, genesisState.TransferPacketList, got.TransferPacketList)
+	require.ElementsMatch(t, genesisState.RateLimitList, got.RateLimitList)
+	require.ElementsMatch(t, genesisState.RateLimitPendingPacketList, got.RateLimitPendingPacketList)
+	require.ElementsMatch(t, genesisState.RemoteDistributionList, got.RemoteDistributionList)
+	require.ElementsMatch(t, genesisState.PacketOutcomeList, got.PacketOutcomeList)
+	require.ElementsMatch(t, genesisState.FeeExemptUsageList, got.FeeExemptUsageList)
+	require.Equal(t, genesisState.BaseFee, got.BaseFee)
+	require.ElementsMatch(t, genesisState.FeeSponsorList, got.FeeSponsorList)
+	require.Equal(t, genesisState.FeeExemptBlockGas, got.FeeExemptBlockGas)
+
+	// the pending index is rebuilt from the outcomes
+	pending, err := k.PendingPayouts(ctx, &types.QueryPendingPayoutsRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.PacketOutcome, 1)
+	require.Equal(t, "transfer", pending.PacketOutcome[0].PortId)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+	}{
+		{
+			desc:     "default",
+			genState: types.DefaultGenesis(),
+		},
+		{
+			desc: "stored singletons",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.DistributionCount = 3
+				genState.SupplyRecord = types.SupplyRecord{Minted: math.ZeroInt(), Burned: math.ZeroInt(), BaseFeeBurned: math.NewInt(5)}
+				genState.BaseFee = math.LegacyNewDecWithPrec(15, 1)
+				genState.FeeExemptBlockGas = types.FeeExemptBlockGas{Height: 5, Gas: 1000}
+				return genState
+			}(),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.NoError(t, tc.genState.Validate())
+
+			k, ctx := keepertest.OptioKeeper(t)
+			optio.InitGenesis(ctx, k, *tc.genState)
+			exported := cdc.MustMarshalJSON(optio.ExportGenesis(ctx, k))
+
+			var imported types.GenesisState
+			cdc.MustUnmarshalJSON(exported, &imported)
+			require.NoError(t, imported.Validate())
+
+			k, ctx = keepertest.OptioKeeper(t)
+			optio.InitGenesis(ctx, k, imported)
+			require.Equal(t, string(exported), string(cdc.MustMarshalJSON(optio.ExportGenesis(ctx, k))))
+		})
+	}
+}
+
+func TestGenesisFundedDenom(t *testing.T) {
+	k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+	genState := types.DefaultGenesis()
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(genState.Params.Denom, 1000))))
+
+	// balances funded at genesis were not minted by the module
+	optio.InitGenesis(ctx, k, *genState)
+	require.Equal(t, types.NewSupplyRecord(), k.GetSupplyRecord(ctx))
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestGenesisExportAfterBaseFeeBurn(t *testing.T) {
+	k, ctx, bank := keepertest.OptioKeeperWithBank(t)
+	genState := types.DefaultGenesis()
+	genState.Params.BaseFeeTargetGas = 1000
+	genState.Params.BaseFeeBurnRate = math.LegacyOneDec()
+	optio.InitGenesis(ctx, k, *genState)
+
+	// the fee collector holds base fees paid in tokens the module never
+	// minted
+	require.NoError(t, bank.MintCoins(ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(genState.Params.Denom, 1000))))
+	burned, err := k.BurnBaseFee(ctx, math.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), burned)
+
+	got := optio.ExportGenesis(ctx, k)
+	require.Equal(t, math.ZeroInt(), got.SupplyRecord.Minted)
+	require.Equal(t, math.ZeroInt(), got.SupplyRecord.Burned)
+	require.Equal(t, math.NewInt(1000), got.SupplyRecord.BaseFeeBurned)
+	require.NoError(t, got.Validate())
+}
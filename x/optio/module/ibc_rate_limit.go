@@ -0,0 +1,284 @@
+package optio
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+)
+
+var (
+	_ porttypes.Middleware            = (*RateLimitMiddleware)(nil)
+	_ porttypes.UpgradableModule      = (*RateLimitMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*RateLimitMiddleware)(nil)
+)
+
+// RateLimitMiddleware caps the net flow of the module denom over the ICS-20
+// channels rate limited by governance. Outgoing transfers are checked when
+// the transfer keeper sends them through the middleware as its ICS4Wrapper,
+// incoming transfers are acknowledged with an error once the cap is reached.
+type RateLimitMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewRateLimitMiddleware creates a new RateLimitMiddleware given the transfer
+// application, the ICS4Wrapper below the middleware and the optio keeper.
+func NewRateLimitMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) RateLimitMiddleware {
+	return RateLimitMiddleware{
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// WithICS4Wrapper returns a copy of the middleware that sends packets through
+// the given ICS4Wrapper, so that middlewares wrapping this one can also sit
+// below it on the send path.
+func (im RateLimitMiddleware) WithICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) RateLimitMiddleware {
+	im.ics4Wrapper = ics4Wrapper
+	return im
+}
+
+// transferAmount decodes the denom and amount of an ICS-20 packet, reporting
+// false for packets that are not fungible token transfers.
+func transferAmount(data []byte) (transfertypes.FungibleTokenPacketData, math.Int, bool) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return packetData, math.Int{}, false
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	return packetData, amount, ok
+}
+
+// SendPacket implements the ICS4Wrapper interface. Outgoing transfers of the
+// module denom are counted in the outflow of their channel.
+func (im RateLimitMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// the denom of a token native to this chain has no trace prefix
+	packetData, amount, ok := transferAmount(data)
+	if !ok {
+		return sequence, nil
+	}
+	if err := im.keeper.RateLimitSend(ctx, sourceChannel, sequence, packetData.Denom, amount); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (im RateLimitMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (im RateLimitMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im RateLimitMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Incoming transfers of the
+// module denom are counted in the inflow of their channel and acknowledged
+// with an error once the net inflow exceeds the cap. Error acknowledgements
+// revert the inflow together with the other state changes of the packet.
+func (im RateLimitMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if packetData, amount, ok := transferAmount(packet.GetData()); ok {
+		if err := im.keeper.RateLimitRecv(ctx, packet.GetDestChannel(), receivedDenom(packet, packetData.Denom), amount); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Error
+// acknowledgements revert the outflow of the refunded transfer.
+func (im RateLimitMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	im.keeper.OnRateLimitedPacketAcknowledged(ctx, packet.SourceChannel, packet.Sequence, ack.Success())
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow of the
+// refunded transfer is reverted.
+func (im RateLimitMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnRateLimitedPacketTimeout(ctx, packet.SourceChannel, packet.Sequence)
+	return nil
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface
+func (im RateLimitMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface
+func (im RateLimitMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface
+func (im RateLimitMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface
+func (im RateLimitMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// deferring to the application below. The packet-forward middleware does not
+// expose the decoder of the transfer application, so the packet data is then
+// decoded as ICS-20 data like the transfer application does.
+func (im RateLimitMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	if unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler); ok {
+		return unmarshaler.UnmarshalPacketData(bz)
+	}
+
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, err
+	}
+	return packetData, nil
+}
@@ -0,0 +1,169 @@
+package optio
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	optiosimulation "github.com/OptioServices/optio/x/optio/simulation"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+var (
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+)
+
+const (
+	opWeightMsgDistribute          = "op_weight_msg_distribute"
+	defaultWeightMsgDistribute int = 100
+
+	opWeightMsgSetAddressStatus          = "op_weight_msg_set_address_status"
+	defaultWeightMsgSetAddressStatus int = 20
+
+	opWeightMsgUpdateParams          = "op_weight_msg_update_params"
+	defaultWeightMsgUpdateParams int = 100
+
+	opWeightMsgRegisterFeeSponsor          = "op_weight_msg_register_fee_sponsor"
+	defaultWeightMsgRegisterFeeSponsor int = 20
+
+	opWeightMsgUnregisterFeeSponsor          = "op_weight_msg_unregister_fee_sponsor"
+	defaultWeightMsgUnregisterFeeSponsor int = 10
+
+	opWeightMsgRegisterRemoteTreasury          = "op_weight_msg_register_remote_treasury"
+	defaultWeightMsgRegisterRemoteTreasury int = 5
+
+	opWeightMsgRemoteDistribute          = "op_weight_msg_remote_distribute"
+	defaultWeightMsgRemoteDistribute int = 5
+
+	opWeightMsgSendDistribution          = "op_weight_msg_send_distribution"
+	defaultWeightMsgSendDistribution int = 5
+
+	opWeightMsgSetRateLimit          = "op_weight_msg_set_rate_limit"
+	defaultWeightMsgSetRateLimit int = 20
+
+	opWeightMsgRemoveRateLimit          = "op_weight_msg_remove_rate_limit"
+	defaultWeightMsgRemoveRateLimit int = 10
+
+	// this line is used by starport scaffolding # simapp/module/const
+)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	optiosimulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = optiosimulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the optio module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+
+	var weightMsgDistribute int
+	simState.AppParams.GetOrGenerate(opWeightMsgDistribute, &weightMsgDistribute, nil,
+		func(_ *rand.Rand) {
+			weightMsgDistribute = defaultWeightMsgDistribute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDistribute,
+		optiosimulation.SimulateMsgDistribute(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetAddressStatus int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetAddressStatus, &weightMsgSetAddressStatus, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAddressStatus = defaultWeightMsgSetAddressStatus
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAddressStatus,
+		optiosimulation.SimulateMsgSetAddressStatus(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRegisterFeeSponsor int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterFeeSponsor, &weightMsgRegisterFeeSponsor, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterFeeSponsor = defaultWeightMsgRegisterFeeSponsor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterFeeSponsor,
+		optiosimulation.SimulateMsgRegisterFeeSponsor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUnregisterFeeSponsor int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnregisterFeeSponsor, &weightMsgUnregisterFeeSponsor, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnregisterFeeSponsor = defaultWeightMsgUnregisterFeeSponsor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnregisterFeeSponsor,
+		optiosimulation.SimulateMsgUnregisterFeeSponsor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRegisterRemoteTreasury int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterRemoteTreasury, &weightMsgRegisterRemoteTreasury, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterRemoteTreasury = defaultWeightMsgRegisterRemoteTreasury
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterRemoteTreasury,
+		optiosimulation.SimulateMsgRegisterRemoteTreasury(),
+	))
+
+	var weightMsgRemoteDistribute int
+	simState.AppParams.GetOrGenerate(opWeightMsgRemoteDistribute, &weightMsgRemoteDistribute, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoteDistribute = defaultWeightMsgRemoteDistribute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoteDistribute,
+		optiosimulation.SimulateMsgRemoteDistribute(),
+	))
+
+	var weightMsgSendDistribution int
+	simState.AppParams.GetOrGenerate(opWeightMsgSendDistribution, &weightMsgSendDistribution, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendDistribution = defaultWeightMsgSendDistribution
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendDistribution,
+		optiosimulation.SimulateMsgSendDistribution(),
+	))
+
+	// this line is used by starport scaffolding # simapp/module/operation
+
+	return operations
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateParams,
+			defaultWeightMsgUpdateParams,
+			optiosimulation.SimulateMsgUpdateParams(am.keeper),
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgSetRateLimit,
+			defaultWeightMsgSetRateLimit,
+			optiosimulation.SimulateMsgSetRateLimit(am.keeper),
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRemoveRateLimit,
+			defaultWeightMsgRemoveRateLimit,
+			optiosimulation.SimulateMsgRemoveRateLimit(am.keeper),
+		),
+		// this line is used by starport scaffolding # simapp/module/OpMsg
+	}
+}
@@ -0,0 +1,138 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding optio type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.DistributionKey)):
+			var distributionA, distributionB types.Distribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.DistributionCountKey)):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.DistributionCategoryKey)):
+			// category index entries carry no value
+			return fmt.Sprintf("%s\n%s", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.AddressStatusKeyPrefix)):
+			var statusA, statusB types.AddressStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", statusA, statusB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.DistributorStatsKeyPrefix)):
+			var statsA, statsB types.DistributorStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.Equal(kvA.Key, types.FeeAllowanceUsageKey):
+			var usageA, usageB types.FeeAllowanceUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.FeeAllowanceGrantKeyPrefix)):
+			var grantA, grantB types.FeeAllowanceGrant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.FeeSponsorKeyPrefix)):
+			var sponsorA, sponsorB types.FeeSponsor
+			cdc.MustUnmarshal(kvA.Value, &sponsorA)
+			cdc.MustUnmarshal(kvB.Value, &sponsorB)
+			return fmt.Sprintf("%v\n%v", sponsorA, sponsorB)
+
+		case bytes.Equal(kvA.Key, types.SupplyRecordKey):
+			var recordA, recordB types.SupplyRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TransferPacketKeyPrefix)):
+			var packetA, packetB types.TransferPacket
+			cdc.MustUnmarshal(kvA.Value, &packetA)
+			cdc.MustUnmarshal(kvB.Value, &packetB)
+			return fmt.Sprintf("%v\n%v", packetA, packetB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RateLimitKeyPrefix)):
+			var rateLimitA, rateLimitB types.RateLimit
+			cdc.MustUnmarshal(kvA.Value, &rateLimitA)
+			cdc.MustUnmarshal(kvB.Value, &rateLimitB)
+			return fmt.Sprintf("%v\n%v", rateLimitA, rateLimitB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix)):
+			var packetA, packetB types.RateLimitPendingPacket
+			cdc.MustUnmarshal(kvA.Value, &packetA)
+			cdc.MustUnmarshal(kvB.Value, &packetB)
+			return fmt.Sprintf("%v\n%v", packetA, packetB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RemoteDistributionKeyPrefix)):
+			var distributionA, distributionB types.RemoteDistribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PacketOutcomeKeyPrefix)):
+			var outcomeA, outcomeB types.PacketOutcome
+			cdc.MustUnmarshal(kvA.Value, &outcomeA)
+			cdc.MustUnmarshal(kvB.Value, &outcomeB)
+			return fmt.Sprintf("%v\n%v", outcomeA, outcomeB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PacketOutcomePendingKeyPrefix)):
+			// pending index entries carry no value
+			return fmt.Sprintf("%s\n%s", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.FeeExemptUsageKeyPrefix)):
+			var usageA, usageB types.FeeExemptUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.Equal(kvA.Key, types.FeeExemptBlockGasKey):
+			var blockGasA, blockGasB types.FeeExemptBlockGas
+			cdc.MustUnmarshal(kvA.Value, &blockGasA)
+			cdc.MustUnmarshal(kvB.Value, &blockGasB)
+			return fmt.Sprintf("%v\n%v", blockGasA, blockGasB)
+
+		case bytes.Equal(kvA.Key, types.BaseFeeKey):
+			var baseFeeA, baseFeeB math.LegacyDec
+			if err := baseFeeA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := baseFeeB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", baseFeeA, baseFeeB)
+
+		case bytes.Equal(kvA.Key, types.PortKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid optio key prefix %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/simulation"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	address := sample.AccAddress()
+	distribution := types.Distribution{
+		Id:          1,
+		Distributor: address,
+		Amount:      sdkmath.NewInt(10),
+		Recipients:  []types.Recipient{{Address: address, Amount: sdkmath.NewInt(10)}},
+	}
+	status := types.AddressStatus{Address: address, Status: types.COMPLIANCE_STATUS_DENIED}
+	stats := types.DistributorStats{Address: address, TotalDistributed: sdkmath.NewInt(10), DistributionCount: 1}
+	usage := types.FeeAllowanceUsage{Granted: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), Count: 1}
+	grant := types.FeeAllowanceGrant{Address: address, Granter: address, Height: 2}
+	record := types.SupplyRecord{Minted: sdkmath.NewInt(10), Burned: sdkmath.ZeroInt()}
+	packet := types.TransferPacket{Channel: "channel-0", Sequence: 1, Receiver: "cosmos1receiver", Token: sdk.NewInt64Coin("stake", 10), Status: types.TRANSFER_STATUS_PENDING}
+	rateLimit := types.NewRateLimit("channel-0", types.RateLimitQuota{MaxPercent: sdkmath.LegacyNewDec(5), MaxAmount: sdkmath.ZeroInt(), Window: time.Hour})
+	pending := types.RateLimitPendingPacket{ChannelId: "channel-0", Sequence: 1, Amount: sdkmath.NewInt(10)}
+	remote := types.RemoteDistribution{
+		ChannelId:    "channel-1",
+		Sequence:     1,
+		ConnectionId: "connection-0",
+		Treasury:     "cosmos1treasury",
+		Outputs:      []banktypes.Output{{Address: "cosmos1receiver", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+		Status:       types.REMOTE_DISTRIBUTION_STATUS_PENDING,
+	}
+	outcome := types.PacketOutcome{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Status: types.PACKET_OUTCOME_STATUS_PENDING}
+	exemptUsage := types.FeeExemptUsage{Address: address, Count: 2, WindowStart: time.Unix(100, 0).UTC()}
+	blockGas := types.FeeExemptBlockGas{Height: 3, Gas: 200000}
+	sponsor := types.FeeSponsor{Address: address, Height: 4}
+	params := types.DefaultParams()
+	baseFee, err := sdkmath.LegacyNewDecWithPrec(15, 1).Marshal()
+	require.NoError(t, err)
+
+	distributionKey := append(types.KeyPrefix(types.DistributionKey), keeper.GetDistributionIDBytes(1)...)
+	categoryKey := append(types.KeyPrefix(types.DistributionCategoryKey), keeper.GetDistributionCategoryKey("payroll", 1)...)
+	statusKey := append(types.KeyPrefix(types.AddressStatusKeyPrefix), types.AddressStatusKey(address)...)
+	statsKey := append(types.KeyPrefix(types.DistributorStatsKeyPrefix), types.DistributorStatsKey(address)...)
+	grantKey := append(types.KeyPrefix(types.FeeAllowanceGrantKeyPrefix), types.FeeAllowanceGrantKey(address)...)
+	packetKey := append(types.KeyPrefix(types.TransferPacketKeyPrefix), types.TransferPacketKey("channel-0", 1)...)
+	rateLimitKey := append(types.KeyPrefix(types.RateLimitKeyPrefix), types.RateLimitKey("channel-0")...)
+	pendingKey := append(types.KeyPrefix(types.RateLimitPendingPacketKeyPrefix), types.RateLimitPendingPacketKey("channel-0", 1)...)
+	remoteKey := append(types.KeyPrefix(types.RemoteDistributionKeyPrefix), types.RemoteDistributionKey("channel-1", 1)...)
+	outcomeKey := append(types.KeyPrefix(types.PacketOutcomeKeyPrefix), types.PacketOutcomeKey("transfer", "channel-0", 1)...)
+	outcomePendingKey := append(types.KeyPrefix(types.PacketOutcomePendingKeyPrefix), types.PacketOutcomeKey("transfer", "channel-0", 1)...)
+	exemptUsageKey := append(types.KeyPrefix(types.FeeExemptUsageKeyPrefix), types.FeeExemptUsageKey(address)...)
+	sponsorKey := append(types.KeyPrefix(types.FeeSponsorKeyPrefix), types.FeeSponsorKey(address)...)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: distributionKey, Value: cdc.MustMarshal(&distribution)},
+			{Key: types.KeyPrefix(types.DistributionCountKey), Value: keeper.GetDistributionIDBytes(2)},
+			{Key: categoryKey, Value: []byte{}},
+			{Key: statusKey, Value: cdc.MustMarshal(&status)},
+			{Key: statsKey, Value: cdc.MustMarshal(&stats)},
+			{Key: types.FeeAllowanceUsageKey, Value: cdc.MustMarshal(&usage)},
+			{Key: grantKey, Value: cdc.MustMarshal(&grant)},
+			{Key: types.SupplyRecordKey, Value: cdc.MustMarshal(&record)},
+			{Key: packetKey, Value: cdc.MustMarshal(&packet)},
+			{Key: rateLimitKey, Value: cdc.MustMarshal(&rateLimit)},
+			{Key: pendingKey, Value: cdc.MustMarshal(&pending)},
+			{Key: remoteKey, Value: cdc.MustMarshal(&remote)},
+			{Key: outcomeKey, Value: cdc.MustMarshal(&outcome)},
+			{Key: outcomePendingKey, Value: []byte{}},
+			{Key: exemptUsageKey, Value: cdc.MustMarshal(&exemptUsage)},
+			{Key: types.FeeExemptBlockGasKey, Value: cdc.MustMarshal(&blockGas)},
+			{Key: types.BaseFeeKey, Value: baseFee},
+			{Key: sponsorKey, Value: cdc.MustMarshal(&sponsor)},
+			{Key: types.PortKey, Value: []byte(types.PortID)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"Distribution", fmt.Sprintf("%v\n%v", distribution, distribution)},
+		{"DistributionCount", "2\n2"},
+		{"DistributionCategory", fmt.Sprintf("%s\n%s", categoryKey, categoryKey)},
+		{"AddressStatus", fmt.Sprintf("%v\n%v", status, status)},
+		{"DistributorStats", fmt.Sprintf("%v\n%v", stats, stats)},
+		{"FeeAllowanceUsage", fmt.Sprintf("%v\n%v", usage, usage)},
+		{"FeeAllowanceGrant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"SupplyRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"TransferPacket", fmt.Sprintf("%v\n%v", packet, packet)},
+		{"RateLimit", fmt.Sprintf("%v\n%v", rateLimit, rateLimit)},
+		{"RateLimitPendingPacket", fmt.Sprintf("%v\n%v", pending, pending)},
+		{"RemoteDistribution", fmt.Sprintf("%v\n%v", remote, remote)},
+		{"PacketOutcome", fmt.Sprintf("%v\n%v", outcome, outcome)},
+		{"PacketOutcomePending", fmt.Sprintf("%s\n%s", outcomePendingKey, outcomePendingKey)},
+		{"FeeExemptUsage", fmt.Sprintf("%v\n%v", exemptUsage, exemptUsage)},
+		{"FeeExemptBlockGas", fmt.Sprintf("%v\n%v", blockGas, blockGas)},
+		{"BaseFee", "1.500000000000000000\n1.500000000000000000"},
+		{"FeeSponsor", fmt.Sprintf("%v\n%v", sponsor, sponsor)},
+		{"Port", "optio\noptio"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.name == "other" {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// maxRecipientAmount bounds the amount drawn for a single recipient.
+var maxRecipientAmount = sdkmath.NewInt(1_000_000_000)
+
+// categories are the accounting categories drawn for distributions.
+var categories = []string{"", "payroll", "grants", "rewards", "airdrop"}
+
+func SimulateMsgDistribute(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgDistribute{})
+		params := k.GetParams(ctx)
+
+		simAccount, found := RandomAccountIn(r, accs, params.AuthorizedAccounts)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no authorized account"), nil, nil
+		}
+
+		// only draw recipients the compliance policy accepts
+		var eligible []simtypes.Account
+		for _, acc := range accs {
+			status, _ := k.GetAddressStatus(ctx, acc.Address.String())
+			if params.RejectionReason(status.Status) == "" {
+				eligible = append(eligible, acc)
+			}
+		}
+
+		maxRecipients := 10
+		if params.MaxRecipientsPerMsg != 0 && params.MaxRecipientsPerMsg < uint64(maxRecipients) {
+			maxRecipients = int(params.MaxRecipientsPerMsg)
+		}
+		addresses := RandomAddresses(r, eligible, 1, maxRecipients)
+		if len(addresses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no eligible recipient"), nil, nil
+		}
+
+		// split the headroom below the max supply between the recipients
+		headroom := params.MaxSupply.Sub(bk.GetSupply(ctx, params.Denom).Amount)
+		perRecipient := sdkmath.MinInt(headroom.QuoRaw(int64(len(addresses))), maxRecipientAmount)
+		if !perRecipient.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "max supply reached"), nil, nil
+		}
+
+		msg := &types.MsgDistribute{
+			FromAddress: simAccount.Address.String(),
+			Amount:      sdkmath.ZeroInt(),
+			Category:    randomMetadata(r, categories[r.Intn(len(categories))], params.MaxCategoryLength),
+			Memo:        randomMetadata(r, simtypes.RandStringOfLength(r, r.Intn(64)), params.MaxMemoLength),
+		}
+		for i, address := range addresses {
+			amount := simtypes.RandomAmount(r, perRecipient)
+			if amount.IsZero() {
+				amount = sdkmath.OneInt()
+			}
+			msg.Recipients = append(msg.Recipients, &types.Recipient{
+				Address:   address,
+				Amount:    amount,
+				Reference: randomMetadata(r, fmt.Sprintf("SIM-%d-%d", ctx.BlockHeight(), i), params.MaxReferenceLength),
+			})
+			msg.Amount = msg.Amount.Add(amount)
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomMetadata returns s, or an empty string when it does not fit into
+// maxLength.
+func randomMetadata(r *rand.Rand, s string, maxLength uint64) string {
+	if uint64(len(s)) > maxLength || r.Intn(4) == 0 {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// Simulation parameter constants
+const (
+	AuthorizedAccounts  = "authorized_accounts"
+	ComplianceAccounts  = "compliance_accounts"
+	MaxSupply           = "max_supply"
+	AllowlistEnabled    = "allowlist_enabled"
+	MaxRecipientsPerMsg = "max_recipients_per_msg"
+	GasPerRecipient     = "gas_per_recipient"
+	FeeAllowances       = "fee_allowances"
+	BondDenom           = "bond_denom"
+	MinimumGasPrices    = "minimum_gas_prices"
+	BaseFee             = "base_fee"
+	FeeExemptions       = "fee_exemptions"
+	RateLimits          = "rate_limits"
+)
+
+// maxSimPrice is the highest gas price drawn for the minimum gas prices and
+// the base fee. The simulation operations send txs with a gas limit of
+// simtestutil.DefaultGenTxGas and random fees of at least one unit, which
+// cover these prices.
+var maxSimPrice = sdkmath.LegacyNewDecWithPrec(1, 7)
+
+// randomPrice returns a gas price between floor and maxSimPrice.
+func randomPrice(r *rand.Rand, floor sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return floor.Add(maxSimPrice.Sub(floor).MulInt64(r.Int63n(101)).QuoInt64(100))
+}
+
+// RandomizedParams draws the module parameters, the distributor and
+// compliance roles go to random simulation accounts. The module denom is
+// either its own denom or, like on the optio chain, the bond denom. The
+// simulation accounts are only funded in the bond denom, so the base fee,
+// which is paid in the module denom, is only enabled with it.
+func RandomizedParams(simState *module.SimulationState) types.Params {
+	params := types.DefaultParams()
+	r := simState.Rand
+
+	simState.AppParams.GetOrGenerate(AuthorizedAccounts, &params.AuthorizedAccounts, r, func(r *rand.Rand) {
+		params.AuthorizedAccounts = RandomAddresses(r, simState.Accounts, 1, 3)
+	})
+	simState.AppParams.GetOrGenerate(ComplianceAccounts, &params.ComplianceAccounts, r, func(r *rand.Rand) {
+		params.ComplianceAccounts = RandomAddresses(r, simState.Accounts, 1, 2)
+	})
+	simState.AppParams.GetOrGenerate(MaxSupply, &params.MaxSupply, r, func(r *rand.Rand) {
+		params.MaxSupply = sdkmath.NewInt(r.Int63n(1_000_000_000_000) + 1_000_000_000)
+	})
+	simState.AppParams.GetOrGenerate(AllowlistEnabled, &params.AllowlistEnabled, r, func(r *rand.Rand) {
+		params.AllowlistEnabled = r.Intn(5) == 0
+	})
+	simState.AppParams.GetOrGenerate(MaxRecipientsPerMsg, &params.MaxRecipientsPerMsg, r, func(r *rand.Rand) {
+		params.MaxRecipientsPerMsg = uint64(r.Intn(20) + 1)
+	})
+	simState.AppParams.GetOrGenerate(GasPerRecipient, &params.GasPerRecipient, r, func(r *rand.Rand) {
+		params.GasPerRecipient = uint64(r.Intn(2001))
+	})
+
+	var feeAllowances bool
+	simState.AppParams.GetOrGenerate(FeeAllowances, &feeAllowances, r, func(r *rand.Rand) {
+		feeAllowances = r.Intn(2) == 0
+	})
+	if feeAllowances {
+		spendLimit := sdk.NewInt64Coin(simState.BondDenom, r.Int63n(1000)+1)
+		params.FeeAllowanceSpendLimit = sdk.NewCoins(spendLimit)
+		params.FeeAllowanceDuration = time.Duration(r.Int63n(int64(30*24*time.Hour))) + time.Hour
+		params.FeeAllowanceBudget = sdk.NewCoins(spendLimit.Add(sdk.NewCoin(spendLimit.Denom, spendLimit.Amount.MulRaw(r.Int63n(50)))))
+	}
+
+	var bondDenom bool
+	simState.AppParams.GetOrGenerate(BondDenom, &bondDenom, r, func(r *rand.Rand) {
+		bondDenom = r.Intn(2) == 0
+	})
+	if bondDenom {
+		// the max supply leaves the drawn headroom above the bond denom
+		// funded in the bank genesis
+		genesisSupply := simState.InitialStake.MulRaw(int64(len(simState.Accounts)) + simState.NumBonded)
+		params.Denom = simState.BondDenom
+		params.MaxSupply = params.MaxSupply.Add(genesisSupply)
+	}
+
+	// the prices are set for the bond denom and the module denom, fees in
+	// either of them pay for the gas
+	var minimumGasPrices bool
+	simState.AppParams.GetOrGenerate(MinimumGasPrices, &minimumGasPrices, r, func(r *rand.Rand) {
+		minimumGasPrices = r.Intn(2) == 0
+	})
+	params.MinimumGasPrices = nil
+	if minimumGasPrices {
+		for _, denom := range []string{simState.BondDenom, params.Denom} {
+			if params.MinimumGasPrices.AmountOf(denom).IsZero() {
+				price := randomPrice(r, sdkmath.LegacyNewDecWithPrec(1, 9))
+				params.MinimumGasPrices = params.MinimumGasPrices.Add(sdk.NewDecCoinFromDec(denom, price))
+			}
+		}
+	}
+
+	var baseFee bool
+	simState.AppParams.GetOrGenerate(BaseFee, &baseFee, r, func(r *rand.Rand) {
+		baseFee = r.Intn(2) == 0
+	})
+	if baseFee && params.Denom == simState.BondDenom {
+		params.BaseFeeTargetGas = uint64(r.Int63n(100_000_000) + 1)
+		params.BaseFeeMaxChangeRate = sdkmath.LegacyNewDecWithPrec(r.Int63n(1001), 3)
+		params.MinBaseFee = randomPrice(r, sdkmath.LegacyNewDecWithPrec(1, 12))
+		params.MaxBaseFee = randomPrice(r, params.MinBaseFee)
+		params.BaseFeeBurnRate = sdkmath.LegacyNewDecWithPrec(r.Int63n(101), 2)
+	}
+
+	var feeExemptions bool
+	simState.AppParams.GetOrGenerate(FeeExemptions, &feeExemptions, r, func(r *rand.Rand) {
+		feeExemptions = r.Intn(2) == 0
+	})
+	if feeExemptions {
+		params.FeeExemptBlockGas = uint64(r.Int63n(100_000_000) + 1)
+		params.FeeExemptTxsPerWindow = uint64(r.Intn(100) + 1)
+		params.FeeExemptWindow = time.Duration(r.Int63n(int64(24*time.Hour))) + time.Minute
+	}
+
+	return params
+}
+
+// RandomizedGenState generates a random GenesisState for optio.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := RandomizedParams(simState)
+
+	// mostly allowed statuses so that distributions find recipients with
+	// the allowlist enabled
+	var statuses []types.AddressStatus
+	for _, acc := range simState.Accounts {
+		switch n := simState.Rand.Intn(10); {
+		case n < 6:
+			statuses = append(statuses, types.AddressStatus{Address: acc.Address.String(), Status: types.COMPLIANCE_STATUS_ALLOWED})
+		case n < 7:
+			statuses = append(statuses, types.AddressStatus{Address: acc.Address.String(), Status: types.COMPLIANCE_STATUS_DENIED})
+		}
+	}
+
+	// the simulations open no IBC channel, the rate limits of a few made up
+	// channels are only carried through genesis and governance
+	var rateLimits []types.RateLimit
+	simState.AppParams.GetOrGenerate(RateLimits, &rateLimits, simState.Rand, func(r *rand.Rand) {
+		for i, n := 0, r.Intn(4); i < n; i++ {
+			rateLimits = append(rateLimits, types.NewRateLimit(fmt.Sprintf("channel-%d", i), randomRateLimitQuota(r, params.MaxSupply)))
+		}
+	})
+
+	optioGenesis := types.DefaultGenesis()
+	optioGenesis.Params = params
+	optioGenesis.AddressStatusList = statuses
+	optioGenesis.RateLimitList = rateLimits
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(optioGenesis)
+}
@@ -0,0 +1,97 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/x/optio/simulation"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 10)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    "stake",
+		Accounts:     accs,
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+	simulation.RandomizedGenState(&simState)
+
+	var genesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+	require.NoError(t, genesis.Validate())
+
+	require.NotEmpty(t, genesis.Params.AuthorizedAccounts)
+	require.NotEmpty(t, genesis.Params.ComplianceAccounts)
+	for _, address := range append(genesis.Params.AuthorizedAccounts, genesis.Params.ComplianceAccounts...) {
+		_, found := simulation.FindAccount(accs, address)
+		require.True(t, found, address)
+	}
+}
+
+func TestRandomizedGenStateFees(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// the fee params and rate limits are drawn for some seeds
+	var bondDenom, minimumGasPrices, baseFee, feeExemptions, rateLimits bool
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			BondDenom:    "stake",
+			Accounts:     simtypes.RandomAccounts(r, 10),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, genesis.Validate())
+
+		params := genesis.Params
+		if params.Denom == "stake" {
+			bondDenom = true
+			require.True(t, params.MaxSupply.GT(sdkmath.NewInt(13_000)))
+		}
+		if !params.MinimumGasPrices.Empty() {
+			minimumGasPrices = true
+			// a fee of one unit pays for the gas of a simulated tx
+			for _, fee := range types.RequiredFees(params.MinimumGasPrices, simtestutil.DefaultGenTxGas) {
+				require.Equal(t, sdkmath.OneInt(), fee.Amount)
+			}
+		}
+		if params.BaseFeeEnabled() {
+			baseFee = true
+			require.Equal(t, "stake", params.Denom)
+			require.Equal(t, sdkmath.OneInt(), types.RequiredBaseFee(params.MaxBaseFee, simtestutil.DefaultGenTxGas))
+		}
+		feeExemptions = feeExemptions || params.FeeExemptionsEnabled()
+		rateLimits = rateLimits || len(genesis.RateLimitList) > 0
+	}
+	require.True(t, bondDenom)
+	require.True(t, minimumGasPrices)
+	require.True(t, baseFee)
+	require.True(t, feeExemptions)
+	require.True(t, rateLimits)
+}
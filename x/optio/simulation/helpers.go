@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// FindAccount find a specific address from an account list
+func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	creator, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return simtypes.FindAccount(accs, creator)
+}
+
+// RandomAccountIn returns a random simulation account among addresses.
+func RandomAccountIn(r *rand.Rand, accs []simtypes.Account, addresses []string) (simtypes.Account, bool) {
+	var candidates []simtypes.Account
+	for _, address := range addresses {
+		if acc, found := FindAccount(accs, address); found {
+			candidates = append(candidates, acc)
+		}
+	}
+	if len(candidates) == 0 {
+		return simtypes.Account{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+// RandomAddresses returns the addresses of between min and max distinct
+// random accounts, in random order.
+func RandomAddresses(r *rand.Rand, accs []simtypes.Account, min, max int) []string {
+	if max > len(accs) {
+		max = len(accs)
+	}
+	if min > max {
+		return nil
+	}
+	n := simtypes.RandIntBetween(r, min, max+1)
+	addresses := make([]string, 0, n)
+	for _, i := range r.Perm(len(accs))[:n] {
+		addresses = append(addresses, accs[i].Address.String())
+	}
+	return addresses
+}
@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SimulateMsgRegisterRemoteTreasury is a no-op, registering a remote
+// treasury opens an ICA channel over an IBC connection and the simulations
+// have no counterparty chain.
+func SimulateMsgRegisterRemoteTreasury() simtypes.Operation {
+	return noIBCOperation(&types.MsgRegisterRemoteTreasury{})
+}
+
+// SimulateMsgRemoteDistribute is a no-op, the simulations register no
+// remote treasury to distribute from.
+func SimulateMsgRemoteDistribute() simtypes.Operation {
+	return noIBCOperation(&types.MsgRemoteDistribute{})
+}
+
+// SimulateMsgSendDistribution is a no-op, the simulations open no IBC
+// channel to send the distribution packet over.
+func SimulateMsgSendDistribution() simtypes.Operation {
+	return noIBCOperation(&types.MsgSendDistribution{})
+}
+
+func noIBCOperation(msg sdk.Msg) simtypes.Operation {
+	return func(_ *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no IBC connection in simulations"), nil, nil
+	}
+}
@@ -0,0 +1,55 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SimulateMsgSetRateLimit returns a MsgSetRateLimit with a random quota for
+// a governance proposal. The simulations open no IBC channel, the limits go
+// to a few made up channel ids.
+func SimulateMsgSetRateLimit(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		quota := randomRateLimitQuota(r, k.GetParams(ctx).MaxSupply)
+		return types.NewMsgSetRateLimit(k.GetAuthority(), randomChannelID(r), quota)
+	}
+}
+
+// SimulateMsgRemoveRateLimit returns a MsgRemoveRateLimit of a random rate
+// limit for a governance proposal, nil when there is none.
+func SimulateMsgRemoveRateLimit(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		rateLimits := k.GetAllRateLimit(ctx)
+		if len(rateLimits) == 0 {
+			return nil
+		}
+		rateLimit := rateLimits[r.Intn(len(rateLimits))]
+		return types.NewMsgRemoveRateLimit(k.GetAuthority(), rateLimit.ChannelId)
+	}
+}
+
+// randomRateLimitQuota returns a quota with a percentage cap, an absolute cap
+// up to maxSupply or both.
+func randomRateLimitQuota(r *rand.Rand, maxSupply sdkmath.Int) types.RateLimitQuota {
+	quota := types.RateLimitQuota{
+		MaxPercent: sdkmath.LegacyNewDec(int64(r.Intn(101))),
+		MaxAmount:  sdkmath.ZeroInt(),
+		Window:     time.Duration(r.Int63n(int64(24*time.Hour))) + time.Minute,
+	}
+	if quota.MaxPercent.IsZero() || r.Intn(2) == 0 {
+		quota.MaxAmount = simtypes.RandomAmount(r, maxSupply).AddRaw(1)
+	}
+	return quota
+}
+
+func randomChannelID(r *rand.Rand) string {
+	return fmt.Sprintf("channel-%d", r.Intn(3))
+}
@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func SimulateMsgSetAddressStatus(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetAddressStatus{})
+
+		simAccount, found := RandomAccountIn(r, accs, k.GetParams(ctx).ComplianceAccounts)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no compliance account"), nil, nil
+		}
+
+		msg := &types.MsgSetAddressStatus{FromAddress: simAccount.Address.String()}
+		for _, address := range RandomAddresses(r, accs, 1, 3) {
+			msg.Statuses = append(msg.Statuses, types.AddressStatus{
+				Address: address,
+				Status:  randomComplianceStatus(r),
+			})
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomComplianceStatus mostly allows addresses, denying or clearing the
+// status of the rest.
+func randomComplianceStatus(r *rand.Rand) types.ComplianceStatus {
+	switch n := r.Intn(10); {
+	case n < 6:
+		return types.COMPLIANCE_STATUS_ALLOWED
+	case n < 8:
+		return types.COMPLIANCE_STATUS_DENIED
+	default:
+		return types.COMPLIANCE_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/OptioServices/optio/x/optio/keeper"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams for a governance
+// proposal. The max supply never drops below the current supply.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		params := k.GetParams(ctx)
+
+		if addresses := RandomAddresses(r, accs, 1, 3); r.Intn(2) == 0 {
+			params.AuthorizedAccounts = addresses
+		}
+		if addresses := RandomAddresses(r, accs, 1, 2); r.Intn(2) == 0 {
+			params.ComplianceAccounts = addresses
+		}
+		params.AllowlistEnabled = r.Intn(5) == 0
+		params.MaxRecipientsPerMsg = uint64(r.Intn(20) + 1)
+		params.GasPerRecipient = uint64(r.Intn(2001))
+
+		supply := k.GetSupplyRecord(ctx).Supply()
+		params.MaxSupply = supply.Add(simtypes.RandomAmount(r, params.MaxSupply))
+
+		return &types.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params:    params,
+		}
+	}
+}
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/address_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ComplianceStatus is the compliance classification of an address.
+type ComplianceStatus int32
+
+const (
+	// COMPLIANCE_STATUS_UNSPECIFIED means the address has no explicit status.
+	COMPLIANCE_STATUS_UNSPECIFIED ComplianceStatus = 0
+	// COMPLIANCE_STATUS_ALLOWED marks a verified address, required when the
+	// allowlist is enabled.
+	COMPLIANCE_STATUS_ALLOWED ComplianceStatus = 1
+	// COMPLIANCE_STATUS_DENIED blocks the address from receiving distributions.
+	COMPLIANCE_STATUS_DENIED ComplianceStatus = 2
+)
+
+var ComplianceStatus_name = map[int32]string{
+	0: "COMPLIANCE_STATUS_UNSPECIFIED",
+	1: "COMPLIANCE_STATUS_ALLOWED",
+	2: "COMPLIANCE_STATUS_DENIED",
+}
+
+var ComplianceStatus_value = map[string]int32{
+	"COMPLIANCE_STATUS_UNSPECIFIED": 0,
+	"COMPLIANCE_STATUS_ALLOWED":     1,
+	"COMPLIANCE_STATUS_DENIED":      2,
+}
+
+func (x ComplianceStatus) String() string {
+	return proto.EnumName(ComplianceStatus_name, int32(x))
+}
+
+func (ComplianceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a654dc5f588d08a, []int{0}
+}
+
+// AddressStatus records the compliance status of an address. The address is
+// a local address or the receiver of cross-chain recipients on the
+// counterparty chain.
+type AddressStatus struct {
+	Address string           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status  ComplianceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=optio.optio.ComplianceStatus" json:"status,omitempty"`
+}
+
+func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
+func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
+func (*AddressStatus) ProtoMessage()    {}
+func (*AddressStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a654dc5f588d08a, []int{0}
+}
+func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressStatus.Merge(m, src)
+}
+func (m *AddressStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressStatus proto.InternalMessageInfo
+
+func (m *AddressStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressStatus) GetStatus() ComplianceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return COMPLIANCE_STATUS_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("optio.optio.ComplianceStatus", ComplianceStatus_name, ComplianceStatus_value)
+	proto.RegisterType((*AddressStatus)(nil), "optio.optio.AddressStatus")
+}
+
+func init() { proto.RegisterFile("optio/optio/address_status.proto", fileDescriptor_5a654dc5f588d08a) }
+
+var fileDescriptor_5a654dc5f588d08a = []byte{
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x2f, 0x28, 0xc9,
+	0xcc, 0xd7, 0x87, 0x90, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xf1, 0xc5, 0x25, 0x89, 0x25,
+	0xa5, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xdc, 0x60, 0x39, 0x3d, 0x30, 0x29, 0x25,
+	0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x16, 0xd7, 0x07, 0xb1, 0x20, 0x4a, 0x94, 0x12, 0xb8, 0x78, 0x1d,
+	0x21, 0x5a, 0x83, 0xc1, 0x3a, 0x85, 0x24, 0xb8, 0xd8, 0xa1, 0x66, 0x49, 0x30, 0x2a, 0x30, 0x6a,
+	0x70, 0x06, 0xc1, 0xb8, 0x42, 0xa6, 0x5c, 0x6c, 0x10, 0xd3, 0x25, 0x98, 0x14, 0x18, 0x35, 0xf8,
+	0x8c, 0x64, 0xf5, 0x90, 0x8c, 0xd7, 0x73, 0xce, 0xcf, 0x2d, 0xc8, 0xc9, 0x4c, 0xcc, 0x4b, 0x4e,
+	0x85, 0x18, 0x14, 0x04, 0x55, 0xac, 0x55, 0xc1, 0x25, 0x80, 0x2e, 0x27, 0xa4, 0xc8, 0x25, 0xeb,
+	0xec, 0xef, 0x1b, 0xe0, 0xe3, 0xe9, 0xe8, 0xe7, 0xec, 0x1a, 0x1f, 0x1c, 0xe2, 0x18, 0x12, 0x1a,
+	0x1c, 0x1f, 0xea, 0x17, 0x1c, 0xe0, 0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0x24,
+	0xcb, 0x25, 0x89, 0xa9, 0xc4, 0xd1, 0xc7, 0xc7, 0x3f, 0xdc, 0xd5, 0x45, 0x80, 0x51, 0x48, 0x86,
+	0x4b, 0x02, 0x53, 0xda, 0xc5, 0xd5, 0x0f, 0xa4, 0x99, 0x49, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83,
+	0x93, 0xdb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
+	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96,
+	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xfb, 0x83, 0x9c, 0x1f, 0x9c, 0x5a, 0x54, 0x96,
+	0x99, 0x9c, 0x5a, 0x0c, 0x0d, 0xcd, 0x0a, 0x28, 0x5d, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
+	0x0e, 0x2a, 0x63, 0xc0, 0x00, 0xfc, 0x59, 0xdd, 0xf1, 0x71, 0x01, 0x00, 0x00,
+}
+
+func (m *AddressStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAddressStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAddressStatus(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAddressStatus(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAddressStatus(uint64(m.Status))
+	}
+	return n
+}
+
+func sovAddressStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressStatus(x uint64) (n int) {
+	return sovAddressStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddressStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAddressStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ComplianceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAddressStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressStatus = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerRecipient is charged for every recipient checked against the
+// allowed recipients, in line with the bank SendAuthorization.
+const gasCostPerRecipient = uint64(10)
+
+var _ authz.Authorization = &DistributeAuthorization{}
+
+// NewDistributeAuthorization creates a new DistributeAuthorization object.
+func NewDistributeAuthorization(spendLimit math.Int, allowedRecipients []string, maxPerMsg math.Int, expiration *time.Time) *DistributeAuthorization {
+	return &DistributeAuthorization{
+		SpendLimit:        spendLimit,
+		AllowedRecipients: allowedRecipients,
+		MaxPerMsg:         maxPerMsg,
+		Expiration:        expiration,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a DistributeAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgDistribute{})
+}
+
+// Accept implements Authorization.Accept.
+func (a DistributeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mDistribute, ok := msg.(*MsgDistribute)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if a.Expiration != nil && !sdkCtx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("distribute authorization expired at %s", a.Expiration)
+	}
+
+	if !a.MaxPerMsg.IsNil() && a.MaxPerMsg.IsPositive() && mDistribute.Amount.GT(a.MaxPerMsg) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("amount %s exceeds max per message %s", mDistribute.Amount, a.MaxPerMsg)
+	}
+
+	limitLeft := a.SpendLimit.Sub(mDistribute.Amount)
+	if limitLeft.IsNegative() {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		allowed := make(map[string]bool, len(a.AllowedRecipients))
+		for _, addr := range a.AllowedRecipients {
+			allowed[addr] = true
+		}
+		for _, recipient := range mDistribute.Recipients {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerRecipient, "distribute authorization")
+			if !allowed[recipient.Account()] {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot distribute to %s address", recipient.Account())
+			}
+		}
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := a
+	updated.SpendLimit = limitLeft
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a DistributeAuthorization) ValidateBasic() error {
+	if a.SpendLimit.IsNil() || !a.SpendLimit.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit must be positive")
+	}
+	if !a.MaxPerMsg.IsNil() && a.MaxPerMsg.IsNegative() {
+		return sdkerrors.ErrInvalidCoins.Wrap("max per message cannot be negative")
+	}
+
+	found := make(map[string]bool, len(a.AllowedRecipients))
+	for _, addr := range a.AllowedRecipients {
+		if err := ValidateAccount(addr); err != nil {
+			return errorsmod.Wrap(err, "invalid allowed recipient")
+		}
+		if found[addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed recipient %s", addr)
+		}
+		found[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/authz.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributeAuthorization allows the grantee to distribute on behalf of an
+// authorized account within bounds.
+type DistributeAuthorization struct {
+	// spend_limit is the total amount the grantee can still distribute. It is
+	// decremented by every accepted MsgDistribute.
+	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit"`
+	// allowed_recipients restricts the recipients, empty allows any recipient.
+	// Cross-chain recipients are allowed by their receiver on the counterparty
+	// chain.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// max_per_msg caps the amount of a single MsgDistribute, zero disables the cap.
+	MaxPerMsg cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_per_msg,json=maxPerMsg,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_msg"`
+	// expiration is the time after which the authorization is no longer
+	// accepted, unset means no expiration besides the one of the grant.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *DistributeAuthorization) Reset()         { *m = DistributeAuthorization{} }
+func (m *DistributeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*DistributeAuthorization) ProtoMessage()    {}
+func (*DistributeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14d78897847bc52e, []int{0}
+}
+func (m *DistributeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributeAuthorization.Merge(m, src)
+}
+func (m *DistributeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributeAuthorization proto.InternalMessageInfo
+
+func (m *DistributeAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *DistributeAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DistributeAuthorization)(nil), "optio.optio.DistributeAuthorization")
+}
+
+func init() { proto.RegisterFile("optio/optio/authz.proto", fileDescriptor_14d78897847bc52e) }
+
+var fileDescriptor_14d78897847bc52e = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0x3e, 0xb7, 0x15, 0xd2, 0xf9, 0xa6, 0x46, 0xa0, 0x1e, 0x27, 0x91, 0x9c, 0x3a, 0x9d, 0x2a,
+	0xce, 0xa1, 0xb0, 0x31, 0xc1, 0x09, 0x21, 0x2a, 0x81, 0x28, 0x81, 0x89, 0x25, 0x72, 0x72, 0x26,
+	0xb1, 0x88, 0xf3, 0x2c, 0xfb, 0xa5, 0x84, 0xfe, 0x04, 0xa6, 0xfe, 0x0c, 0xc6, 0x0e, 0xfd, 0x11,
+	0x15, 0x53, 0xc5, 0x84, 0x18, 0x0a, 0xba, 0x1b, 0xfa, 0x1b, 0xd8, 0xd0, 0xd9, 0x39, 0x04, 0x03,
+	0x4b, 0x97, 0x67, 0xbf, 0xf7, 0xd9, 0xdf, 0xfb, 0xfc, 0x3d, 0xd3, 0x1d, 0xd0, 0x28, 0x21, 0xf6,
+	0x91, 0x37, 0x58, 0x1e, 0x33, 0x6d, 0x00, 0x21, 0x18, 0xb8, 0x12, 0x73, 0x71, 0xb4, 0xcd, 0x95,
+	0xac, 0x21, 0x76, 0xd1, 0xe3, 0xa3, 0xdb, 0x39, 0x58, 0x05, 0x36, 0x75, 0x59, 0xec, 0x93, 0x0e,
+	0xba, 0x59, 0x40, 0x01, 0xbe, 0xbe, 0xda, 0x75, 0xd5, 0xa8, 0x00, 0x28, 0x2a, 0x11, 0xbb, 0x2c,
+	0x6b, 0xde, 0xc5, 0x28, 0x95, 0xb0, 0xc8, 0x95, 0xf6, 0x07, 0x76, 0x7f, 0x6d, 0xd0, 0x9d, 0x27,
+	0xd2, 0xa2, 0x91, 0x59, 0x83, 0xe2, 0x71, 0x83, 0x25, 0x18, 0x79, 0xcc, 0x51, 0x42, 0x1d, 0xbc,
+	0xa2, 0x03, 0xab, 0x45, 0x3d, 0x4f, 0x2b, 0xa9, 0x24, 0x0e, 0xc9, 0x98, 0x4c, 0xfa, 0xb3, 0x7b,
+	0xe7, 0x97, 0x51, 0xef, 0xfb, 0x65, 0x74, 0xcb, 0x77, 0xb7, 0xf3, 0xf7, 0x4c, 0x42, 0xac, 0x38,
+	0x96, 0xec, 0xa0, 0xc6, 0xaf, 0x67, 0x53, 0xda, 0xc9, 0x3a, 0xa8, 0xf1, 0xf3, 0xd5, 0xe9, 0x1e,
+	0x49, 0xa8, 0x23, 0x79, 0xbe, 0xe2, 0x08, 0xa6, 0x34, 0xe0, 0x55, 0x05, 0x1f, 0xc4, 0x3c, 0x35,
+	0x22, 0x97, 0x5a, 0x8a, 0x1a, 0xed, 0x70, 0x63, 0xbc, 0x39, 0xe9, 0x27, 0xdb, 0x1d, 0x92, 0xfc,
+	0x01, 0x82, 0x43, 0x3a, 0x50, 0xbc, 0x4d, 0xb5, 0x30, 0xa9, 0xb2, 0xc5, 0x70, 0xf3, 0x9a, 0x0a,
+	0xfa, 0x8a, 0xb7, 0x87, 0xc2, 0xbc, 0xb0, 0x45, 0xf0, 0x88, 0x52, 0xd1, 0x6a, 0x69, 0xdc, 0x0b,
+	0x87, 0x5b, 0x63, 0x32, 0x19, 0xdc, 0x1f, 0x31, 0xef, 0x12, 0x5b, 0xbb, 0xc4, 0xde, 0xac, 0x5d,
+	0x9a, 0x6d, 0x9d, 0xfc, 0x88, 0x48, 0xf2, 0xd7, 0x9d, 0x87, 0xcf, 0xbe, 0x9c, 0x4d, 0x77, 0xbb,
+	0x0e, 0x7e, 0x76, 0x47, 0xfb, 0x99, 0x40, 0xbe, 0xcf, 0xfe, 0x71, 0xef, 0xd3, 0xd5, 0xe9, 0xde,
+	0x1d, 0x3f, 0xe1, 0xff, 0xf8, 0x3b, 0x7b, 0x7a, 0xbe, 0x08, 0xc9, 0xc5, 0x22, 0x24, 0x3f, 0x17,
+	0x21, 0x39, 0x59, 0x86, 0xbd, 0x8b, 0x65, 0xd8, 0xfb, 0xb6, 0x0c, 0x7b, 0x6f, 0xef, 0x16, 0x12,
+	0xcb, 0x26, 0x63, 0x39, 0xa8, 0xf8, 0xe5, 0x8a, 0xe3, 0xb5, 0x30, 0x47, 0x32, 0x17, 0xb6, 0xfb,
+	0x33, 0x6d, 0xb7, 0xe2, 0x47, 0x2d, 0x6c, 0x76, 0xc3, 0xe9, 0x7e, 0xf0, 0x7b, 0x00, 0xe5, 0xae,
+	0x58, 0xbf, 0x57, 0x02, 0x00, 0x00,
+}
+
+func (m *DistributeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxPerMsg.Size()
+		i -= size
+		if _, err := m.MaxPerMsg.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.MaxPerMsg.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestDistributeAuthorization(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics()), cmtproto.Header{Time: now}, false, log.NewNopLogger())
+
+	granter, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	msg := func(recipients ...string) *types.MsgDistribute {
+		rs := make([]*types.Recipient, len(recipients))
+		for i, r := range recipients {
+			rs[i] = &types.Recipient{Address: r, Amount: math.NewInt(10)}
+		}
+		return types.NewMsgDistribute(granter, math.NewInt(int64(10*len(recipients))), rs)
+	}
+
+	auth := types.NewDistributeAuthorization(math.NewInt(30), []string{alice}, math.NewInt(20), nil)
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/optio.optio.MsgDistribute", auth.MsgTypeURL())
+
+	// spend limit decrements
+	resp, err := auth.Accept(ctx, msg(alice))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.DistributeAuthorization)
+	require.Equal(t, math.NewInt(20), updated.SpendLimit)
+	require.Equal(t, auth.AllowedRecipients, updated.AllowedRecipients)
+	require.Equal(t, auth.SpendLimit, math.NewInt(30))
+
+	// recipient not allowed
+	_, err = auth.Accept(ctx, msg(bob))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// cross-chain recipients are allowed by their receiver
+	crossChain := func(receiver string) *types.MsgDistribute {
+		return types.NewMsgDistribute(granter, math.NewInt(10), []*types.Recipient{
+			{SourceChannel: "channel-0", Receiver: receiver, Amount: math.NewInt(10)},
+		})
+	}
+	withReceiver := types.NewDistributeAuthorization(math.NewInt(30), []string{alice, "osmo1receiver"}, math.ZeroInt(), nil)
+	require.NoError(t, withReceiver.ValidateBasic())
+	resp, err = withReceiver.Accept(ctx, crossChain("osmo1receiver"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	_, err = withReceiver.Accept(ctx, crossChain("osmo1other"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// max per message
+	_, err = auth.Accept(ctx, msg(alice, alice, alice))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// exhausting the spend limit deletes the grant
+	resp, err = updated.Accept(ctx, msg(alice, alice))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	// spend limit exceeded
+	small := types.NewDistributeAuthorization(math.NewInt(5), nil, math.ZeroInt(), nil)
+	_, err = small.Accept(ctx, msg(bob))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// expiration
+	expiration := now.Add(-time.Second)
+	expired := types.NewDistributeAuthorization(math.NewInt(100), nil, math.ZeroInt(), &expiration)
+	_, err = expired.Accept(ctx, msg(bob))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// wrong message type
+	_, err = auth.Accept(ctx, &types.MsgSetAddressStatus{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestDistributeAuthorizationValidateBasic(t *testing.T) {
+	alice := sample.AccAddress()
+	for _, tc := range []struct {
+		name string
+		auth *types.DistributeAuthorization
+		err  error
+	}{
+		{name: "valid", auth: types.NewDistributeAuthorization(math.NewInt(1), []string{alice}, math.ZeroInt(), nil)},
+		{name: "zero spend limit", auth: types.NewDistributeAuthorization(math.ZeroInt(), nil, math.ZeroInt(), nil), err: sdkerrors.ErrInvalidCoins},
+		{name: "negative max per msg", auth: types.NewDistributeAuthorization(math.NewInt(1), nil, math.NewInt(-1), nil), err: sdkerrors.ErrInvalidCoins},
+		{name: "cross-chain receiver", auth: types.NewDistributeAuthorization(math.NewInt(1), []string{alice, "osmo1receiver"}, math.ZeroInt(), nil)},
+		{name: "invalid recipient", auth: types.NewDistributeAuthorization(math.NewInt(1), []string{sdk.GetConfig().GetBech32AccountAddrPrefix() + "1invalid"}, math.ZeroInt(), nil), err: sdkerrors.ErrInvalidAddress},
+		{name: "empty recipient", auth: types.NewDistributeAuthorization(math.NewInt(1), []string{""}, math.ZeroInt(), nil), err: sdkerrors.ErrInvalidAddress},
+		{name: "duplicate recipient", auth: types.NewDistributeAuthorization(math.NewInt(1), []string{alice, alice}, math.ZeroInt(), nil), err: sdkerrors.ErrInvalidRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import "cosmossdk.io/math"
+
+// NextBaseFee returns the base fee of the block following a block that used
+// gasUsed at the given base fee. Like EIP-1559, the base fee rises when the
+// block used more gas than BaseFeeTargetGas and falls when it used less, in
+// proportion to the deviation from the target. The deviation is capped at
+// the target, so the base fee changes by at most BaseFeeMaxChangeRate per
+// block, and the result stays within MinBaseFee and MaxBaseFee.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	if !p.BaseFeeEnabled() {
+		return baseFee
+	}
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.BaseFeeTargetGas))
+	used := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed))
+	deviation := math.LegacyMinDec(used.Sub(target).Quo(target), math.LegacyOneDec())
+
+	next := baseFee.Add(baseFee.Mul(p.BaseFeeMaxChangeRate).Mul(deviation))
+	return p.ClampBaseFee(next)
+}
+
+// ClampBaseFee returns baseFee bounded by MinBaseFee and MaxBaseFee.
+func (p Params) ClampBaseFee(baseFee math.LegacyDec) math.LegacyDec {
+	baseFee = math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	if p.MaxBaseFee.IsPositive() {
+		baseFee = math.LegacyMinDec(baseFee, p.MaxBaseFee)
+	}
+	return baseFee
+}
+
+// RequiredBaseFee returns the base fee a tx with the given gas limit pays in
+// the module denom, rounded up.
+func RequiredBaseFee(baseFee math.LegacyDec, gas uint64) math.Int {
+	return baseFee.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
+
+// BaseFeeBurn returns the part of the required base fee that is burned.
+func (p Params) BaseFeeBurn(required math.Int) math.Int {
+	return p.BaseFeeBurnRate.MulInt(required).TruncateInt()
+}
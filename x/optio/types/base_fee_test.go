@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	dec := func(s string) math.LegacyDec {
+		return math.LegacyMustNewDecFromStr(s)
+	}
+
+	params := types.DefaultParams()
+	params.BaseFeeTargetGas = 1_000_000
+	params.BaseFeeMaxChangeRate = dec("0.125")
+	params.MinBaseFee = dec("1")
+	params.MaxBaseFee = dec("2")
+
+	for _, tc := range []struct {
+		desc     string
+		params   func(types.Params) types.Params
+		start    string
+		gasUsed  []uint64
+		expected []string
+	}{
+		{
+			desc:     "blocks at the target keep the base fee",
+			start:    "1.5",
+			gasUsed:  []uint64{1_000_000, 1_000_000},
+			expected: []string{"1.5", "1.5"},
+		},
+		{
+			desc:     "full blocks raise it by the max change rate",
+			start:    "1",
+			gasUsed:  []uint64{2_000_000, 2_000_000, 2_000_000},
+			expected: []string{"1.125", "1.265625", "1.423828125"},
+		},
+		{
+			desc:     "the change is bounded beyond twice the target",
+			start:    "1",
+			gasUsed:  []uint64{10_000_000},
+			expected: []string{"1.125"},
+		},
+		{
+			desc:     "the change is proportional to the deviation",
+			start:    "1.6",
+			gasUsed:  []uint64{1_500_000, 500_000},
+			expected: []string{"1.7", "1.59375"},
+		},
+		{
+			desc:     "empty blocks lower it to the min base fee",
+			start:    "1.2",
+			gasUsed:  []uint64{0, 0, 0},
+			expected: []string{"1.05", "1", "1"},
+		},
+		{
+			desc:     "it is capped at the max base fee",
+			start:    "1.9",
+			gasUsed:  []uint64{2_000_000, 2_000_000, 0},
+			expected: []string{"2", "2", "1.75"},
+		},
+		{
+			desc: "without max base fee it keeps rising",
+			params: func(p types.Params) types.Params {
+				p.MaxBaseFee = math.LegacyZeroDec()
+				return p
+			},
+			start:    "1.9",
+			gasUsed:  []uint64{2_000_000},
+			expected: []string{"2.1375"},
+		},
+		{
+			desc: "a disabled base fee does not change",
+			params: func(p types.Params) types.Params {
+				p.BaseFeeTargetGas = 0
+				return p
+			},
+			start:    "1.5",
+			gasUsed:  []uint64{2_000_000, 0},
+			expected: []string{"1.5", "1.5"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := params
+			if tc.params != nil {
+				p = tc.params(p)
+			}
+
+			baseFee := dec(tc.start)
+			for i, gasUsed := range tc.gasUsed {
+				baseFee = p.NextBaseFee(baseFee, gasUsed)
+				require.Equal(t, dec(tc.expected[i]).String(), baseFee.String(), "block %d", i)
+			}
+		})
+	}
+}
+
+func TestRequiredBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.BaseFeeBurnRate = math.LegacyMustNewDecFromStr("0.5")
+
+	required := types.RequiredBaseFee(math.LegacyMustNewDecFromStr("1.125"), 200_001)
+	require.Equal(t, math.NewInt(225_002), required)
+	require.Equal(t, math.NewInt(112_501), params.BaseFeeBurn(required))
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	// this line is used by starport scaffolding # 1
+)
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDistribute{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAddressStatus{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendDistribution{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterRemoteTreasury{},
+		&MsgRemoteDistribute{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterFeeSponsor{},
+		&MsgUnregisterFeeSponsor{},
+	)
+	// this line is used by starport scaffolding # 3
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&DistributeAuthorization{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// ModuleCdc encodes the acknowledgements of the optio IBC application.
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that a stored distribution is internally consistent: the
+// recipient amounts have to sum to the distributed amount.
+func (d Distribution) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Distributor); err != nil {
+		return fmt.Errorf("invalid distributor %s: %w", d.Distributor, err)
+	}
+	if d.Amount.IsNil() || !d.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", d.Amount)
+	}
+	if err := ValidateCategory(d.Category); err != nil {
+		return err
+	}
+
+	total := math.ZeroInt()
+	for _, recipient := range d.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+		total = total.Add(recipient.Amount)
+	}
+	if !total.Equal(d.Amount) {
+		return fmt.Errorf("recipient amounts sum to %s, expected %s", total, d.Amount)
+	}
+	return nil
+}
+
+// DistributionTotals sums a distribution history, overall and per
+// distributor. Only the totals and counts of the returned stats are set.
+func DistributionTotals(distributions []Distribution) (math.Int, map[string]DistributorStats) {
+	total := math.ZeroInt()
+	perDistributor := make(map[string]DistributorStats)
+	for _, distribution := range distributions {
+		total = total.Add(distribution.Amount)
+
+		stats, ok := perDistributor[distribution.Distributor]
+		if !ok {
+			stats = DistributorStats{Address: distribution.Distributor, TotalDistributed: math.ZeroInt()}
+		}
+		stats.TotalDistributed = stats.TotalDistributed.Add(distribution.Amount)
+		stats.DistributionCount++
+		perDistributor[distribution.Distributor] = stats
+	}
+	return total, perDistributor
+}
+
+// CheckDistributorStats checks that the stats hold exactly the totals and
+// counts of a distribution history as returned by DistributionTotals.
+func CheckDistributorStats(stats []DistributorStats, expected map[string]DistributorStats) error {
+	for _, elem := range stats {
+		want, ok := expected[elem.Address]
+		if !ok || elem.TotalDistributed.IsNil() ||
+			!elem.TotalDistributed.Equal(want.TotalDistributed) ||
+			elem.DistributionCount != want.DistributionCount {
+			return fmt.Errorf("distributor stats of %s do not match the distribution history", elem.Address)
+		}
+	}
+	if len(stats) != len(expected) {
+		return fmt.Errorf("%d distributors in the history, %d with stats", len(expected), len(stats))
+	}
+	return nil
+}
@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/distributor_stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributorStats accumulates the distributions made by an account.
+type DistributorStats struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TotalDistributed  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_distributed,json=totalDistributed,proto3,customtype=cosmossdk.io/math.Int" json:"total_distributed"`
+	DistributionCount uint64                `protobuf:"varint,3,opt,name=distribution_count,json=distributionCount,proto3" json:"distribution_count,omitempty"`
+	// last_height is the block height of the latest distribution.
+	LastHeight int64 `protobuf:"varint,4,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *DistributorStats) Reset()         { *m = DistributorStats{} }
+func (m *DistributorStats) String() string { return proto.CompactTextString(m) }
+func (*DistributorStats) ProtoMessage()    {}
+func (*DistributorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cee42bc47c0d8e4, []int{0}
+}
+func (m *DistributorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributorStats.Merge(m, src)
+}
+func (m *DistributorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributorStats proto.InternalMessageInfo
+
+func (m *DistributorStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DistributorStats) GetDistributionCount() uint64 {
+	if m != nil {
+		return m.DistributionCount
+	}
+	return 0
+}
+
+func (m *DistributorStats) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// AuthorizedAccount describes an account holding a role in Params together
+// with its usage.
+type AuthorizedAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// roles lists "distributor" for Params.authorizedAccounts and "compliance"
+	// for Params.complianceAccounts.
+	Roles             []string              `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	TotalDistributed  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_distributed,json=totalDistributed,proto3,customtype=cosmossdk.io/math.Int" json:"total_distributed"`
+	DistributionCount uint64                `protobuf:"varint,4,opt,name=distribution_count,json=distributionCount,proto3" json:"distribution_count,omitempty"`
+	LastHeight        int64                 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// remaining_quota is what the account can still distribute, bounded by the
+	// headroom below Params.maxSupply. Zero for accounts without the
+	// distributor role.
+	RemainingQuota cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=remaining_quota,json=remainingQuota,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_quota"`
+}
+
+func (m *AuthorizedAccount) Reset()         { *m = AuthorizedAccount{} }
+func (m *AuthorizedAccount) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedAccount) ProtoMessage()    {}
+func (*AuthorizedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cee42bc47c0d8e4, []int{1}
+}
+func (m *AuthorizedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedAccount.Merge(m, src)
+}
+func (m *AuthorizedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedAccount proto.InternalMessageInfo
+
+func (m *AuthorizedAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AuthorizedAccount) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *AuthorizedAccount) GetDistributionCount() uint64 {
+	if m != nil {
+		return m.DistributionCount
+	}
+	return 0
+}
+
+func (m *AuthorizedAccount) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DistributorStats)(nil), "optio.optio.DistributorStats")
+	proto.RegisterType((*AuthorizedAccount)(nil), "optio.optio.AuthorizedAccount")
+}
+
+func init() {
+	proto.RegisterFile("optio/optio/distributor_stats.proto", fileDescriptor_3cee42bc47c0d8e4)
+}
+
+var fileDescriptor_3cee42bc47c0d8e4 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0xcd, 0x34, 0x7d, 0x4f, 0xde, 0x3c, 0xd0, 0x26, 0x54, 0x88, 0x5d, 0xa4, 0xa1, 0x6e, 0x82,
+	0xd8, 0x44, 0xf0, 0x0b, 0x5a, 0x8b, 0xd8, 0x95, 0x98, 0xae, 0x14, 0x24, 0x4c, 0x93, 0x21, 0x19,
+	0x6c, 0x72, 0xeb, 0xcc, 0x8d, 0xa8, 0x5f, 0xe1, 0x67, 0xb8, 0x74, 0xe1, 0x47, 0x74, 0x23, 0x14,
+	0x57, 0xa2, 0x50, 0xa4, 0x5d, 0xf8, 0x1b, 0x92, 0x49, 0x6c, 0x45, 0x44, 0x44, 0x70, 0x73, 0x93,
+	0x73, 0xce, 0x70, 0xee, 0x3d, 0x70, 0xe8, 0x4d, 0x58, 0xa3, 0x80, 0xb0, 0x99, 0xa9, 0x50, 0x28,
+	0xc5, 0xb2, 0x42, 0x90, 0xb1, 0x42, 0x86, 0x2a, 0x58, 0x4b, 0x40, 0xb0, 0x2f, 0xb5, 0x1c, 0xe8,
+	0x39, 0xb0, 0x58, 0x21, 0x4a, 0x08, 0xf5, 0x6c, 0xf4, 0xc1, 0x8d, 0x04, 0x54, 0x01, 0x2a, 0xd6,
+	0x28, 0x6c, 0x40, 0x2b, 0xf5, 0x33, 0xc8, 0xa0, 0xe1, 0xeb, 0xbf, 0x86, 0x1d, 0x7d, 0x21, 0xb4,
+	0x37, 0x3b, 0x2d, 0x5b, 0xd4, 0xbb, 0x6c, 0x87, 0x5e, 0x61, 0x69, 0x2a, 0xb9, 0x52, 0x0e, 0xf1,
+	0x88, 0x7f, 0x11, 0xfd, 0x80, 0xf6, 0x53, 0x6a, 0x21, 0x20, 0x5b, 0xc5, 0xc7, 0x03, 0x79, 0xea,
+	0x74, 0xea, 0x37, 0xd3, 0x3b, 0x9b, 0xdd, 0xd0, 0xf8, 0xbc, 0x1b, 0x5e, 0x6f, 0xb6, 0xaa, 0xf4,
+	0x59, 0x20, 0x20, 0x2c, 0x18, 0xe6, 0xc1, 0xbc, 0xc4, 0x8f, 0xef, 0xc7, 0xb4, 0x3d, 0x67, 0x5e,
+	0xe2, 0xdb, 0x6f, 0xef, 0x6e, 0x91, 0xa8, 0xa7, 0xad, 0x66, 0x27, 0x27, 0x7b, 0x4c, 0xed, 0xa3,
+	0xb1, 0x80, 0x32, 0x4e, 0xa0, 0x2a, 0xd1, 0x31, 0x3d, 0xe2, 0x77, 0x23, 0xeb, 0x67, 0xe5, 0x5e,
+	0x2d, 0xd8, 0x43, 0x7a, 0xb9, 0x62, 0x0a, 0xe3, 0x9c, 0x8b, 0x2c, 0x47, 0xa7, 0xeb, 0x11, 0xdf,
+	0x8c, 0x68, 0x4d, 0x3d, 0xd0, 0xcc, 0xe8, 0x43, 0x87, 0x5a, 0x93, 0x0a, 0x73, 0x90, 0xe2, 0x35,
+	0x4f, 0x27, 0x89, 0xf6, 0xfb, 0x43, 0xbc, 0x3e, 0x3d, 0x93, 0xb0, 0xe2, 0xca, 0xe9, 0x78, 0xa6,
+	0x7f, 0x11, 0x35, 0xe0, 0xf7, 0xa1, 0xcd, 0xff, 0x1c, 0xba, 0xfb, 0x97, 0xa1, 0xcf, 0x7e, 0x0d,
+	0x6d, 0x3f, 0xa6, 0xd7, 0x24, 0x2f, 0x98, 0x28, 0x45, 0x99, 0xc5, 0xcf, 0x2b, 0x40, 0xe6, 0x9c,
+	0xff, 0xe3, 0xb1, 0x57, 0x8f, 0x46, 0x8f, 0x6a, 0x9f, 0xe9, 0xfd, 0xcd, 0xde, 0x25, 0xdb, 0xbd,
+	0x4b, 0xbe, 0xee, 0x5d, 0xf2, 0xe6, 0xe0, 0x1a, 0xdb, 0x83, 0x6b, 0x7c, 0x3a, 0xb8, 0xc6, 0x93,
+	0xdb, 0x99, 0xc0, 0xbc, 0x5a, 0x06, 0x09, 0x14, 0xe1, 0xc3, 0xba, 0x9d, 0x0b, 0x2e, 0x5f, 0x88,
+	0x84, 0xab, 0xb6, 0xd0, 0x2f, 0xdb, 0x2f, 0xbe, 0x5a, 0x73, 0xb5, 0x3c, 0xd7, 0xe5, 0xbb, 0xfb,
+	0x7d, 0x00, 0x26, 0x59, 0xe1, 0xc7, 0xf4, 0x02, 0x00, 0x00,
+}
+
+func (m *DistributorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintDistributorStats(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DistributionCount != 0 {
+		i = encodeVarintDistributorStats(dAtA, i, uint64(m.DistributionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalDistributed.Size()
+		i -= size
+		if _, err := m.TotalDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistributorStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistributorStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingQuota.Size()
+		i -= size
+		if _, err := m.RemainingQuota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistributorStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LastHeight != 0 {
+		i = encodeVarintDistributorStats(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DistributionCount != 0 {
+		i = encodeVarintDistributorStats(dAtA, i, uint64(m.DistributionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalDistributed.Size()
+		i -= size
+		if _, err := m.TotalDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistributorStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintDistributorStats(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistributorStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistributorStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistributorStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistributorStats(uint64(l))
+	}
+	l = m.TotalDistributed.Size()
+	n += 1 + l + sovDistributorStats(uint64(l))
+	if m.DistributionCount != 0 {
+		n += 1 + sovDistributorStats(uint64(m.DistributionCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovDistributorStats(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *AuthorizedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistributorStats(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovDistributorStats(uint64(l))
+		}
+	}
+	l = m.TotalDistributed.Size()
+	n += 1 + l + sovDistributorStats(uint64(l))
+	if m.DistributionCount != 0 {
+		n += 1 + sovDistributorStats(uint64(m.DistributionCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovDistributorStats(uint64(m.LastHeight))
+	}
+	l = m.RemainingQuota.Size()
+	n += 1 + l + sovDistributorStats(uint64(l))
+	return n
+}
+
+func sovDistributorStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistributorStats(x uint64) (n int) {
+	return sovDistributorStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistributorStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+			}
+			m.DistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistributorStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistributorStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+			}
+			m.DistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingQuota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistributorStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistributorStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDistributorStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDistributorStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistributorStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDistributorStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDistributorStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDistributorStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDistributorStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDistributorStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDistributorStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/optio module sentinel errors
+var (
+	ErrInvalidSigner      = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 1101, "account is not authorized to distribute")
+	ErrInvalidAmount      = sdkerrors.Register(ModuleName, 1102, "invalid amount")
+	ErrInvalidRecipients  = sdkerrors.Register(ModuleName, 1103, "invalid recipients")
+	ErrMaxSupplyExceeded  = sdkerrors.Register(ModuleName, 1104, "max supply exceeded")
+	ErrInvalidMetadata    = sdkerrors.Register(ModuleName, 1105, "invalid distribution metadata")
+	ErrRecipientRejected  = sdkerrors.Register(ModuleName, 1106, "recipients rejected by compliance policy")
+	ErrTooManyRecipients  = sdkerrors.Register(ModuleName, 1107, "too many recipients")
+	ErrCrossChainDisabled = sdkerrors.Register(ModuleName, 1108, "cross-chain recipients are disabled")
+	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 1109, "invalid version")
+	ErrUnknownController  = sdkerrors.Register(ModuleName, 1110, "channel is not an approved remote controller")
+	ErrQuotaExceeded      = sdkerrors.Register(ModuleName, 1111, "remote controller quota exceeded")
+	ErrRateLimitExceeded  = sdkerrors.Register(ModuleName, 1112, "channel rate limit exceeded")
+	ErrRateLimitNotFound  = sdkerrors.Register(ModuleName, 1113, "channel is not rate limited")
+	ErrNoRemoteTreasury   = sdkerrors.Register(ModuleName, 1114, "no remote treasury on connection")
+	ErrInvalidCallback    = sdkerrors.Register(ModuleName, 1115, "invalid packet callback")
+	ErrFeeSponsorNotFound = sdkerrors.Register(ModuleName, 1116, "account is not a registered fee sponsor")
+)
@@ -0,0 +1,68 @@
+package types
+
+// optio module event types
+const (
+	EventTypeDistribute       = "distribute"
+	EventTypeSetAddressStatus = "set_address_status"
+	EventTypeFeeAllowance     = "fee_allowance"
+	EventTypeTransfer         = "distribute_transfer"
+	EventTypeTransferRefund   = "distribute_transfer_refund"
+	// EventTypeDistributionPacket is emitted when a distribution packet is
+	// sent, received, acknowledged or timed out.
+	EventTypeDistributionPacket = "distribution_packet"
+	// EventTypeDistributionResult is emitted on the controller chain for
+	// every recipient result of an acknowledged distribution packet.
+	EventTypeDistributionResult = "distribution_packet_result"
+	// EventTypeRateLimit is emitted when the rate limit of a channel is set
+	// or removed.
+	EventTypeRateLimit = "rate_limit"
+	// EventTypeMemoHook is emitted for every recipient paid out of an
+	// incoming ICS-20 transfer with optio instructions in its memo.
+	EventTypeMemoHook = "memo_hook_distribute"
+	// EventTypeRemoteTreasury is emitted when a remote treasury is
+	// registered.
+	EventTypeRemoteTreasury = "remote_treasury"
+	// EventTypeRemoteDistribution is emitted when a remote distribution is
+	// sent, acknowledged or timed out.
+	EventTypeRemoteDistribution = "remote_distribution"
+	// EventTypePacketOutcome is emitted when the IBC callbacks report the
+	// acknowledgement or timeout of a packet sent by the module.
+	EventTypePacketOutcome = "packet_outcome"
+	// EventTypeFeeExemption is emitted for every signer of a tx exempt from
+	// minimum gas prices.
+	EventTypeFeeExemption = "fee_exemption"
+	// EventTypeBaseFee is emitted at the end of every block with the base
+	// fee of the next block.
+	EventTypeBaseFee = "base_fee"
+	// EventTypeFeeSponsor is emitted when an account registers or
+	// unregisters as a fee sponsor.
+	EventTypeFeeSponsor = "fee_sponsor"
+
+	AttributeKeyDistributor = "distributor"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyReference   = "reference"
+	AttributeKeyCategory    = "category"
+	AttributeKeyID          = "distribution_id"
+	AttributeKeyAddress     = "address"
+	AttributeKeyStatus      = "status"
+	AttributeKeyGranter     = "granter"
+	AttributeKeySpendLimit  = "spend_limit"
+	AttributeKeyExpiration  = "expiration"
+	AttributeKeyChannel     = "channel"
+	AttributeKeySequence    = "sequence"
+	AttributeKeyReceiver    = "receiver"
+	AttributeKeySender      = "sender"
+	AttributeKeySuccess     = "success"
+	AttributeKeyError       = "error"
+	AttributeKeyTimeout     = "timeout"
+	AttributeKeyMaxPercent  = "max_percent"
+	AttributeKeyMaxAmount   = "max_amount"
+	AttributeKeyWindow      = "window"
+	AttributeKeyRemoved     = "removed"
+	AttributeKeyConnection  = "connection"
+	AttributeKeyPort        = "port"
+	AttributeKeyGas         = "gas"
+	AttributeKeyBaseFee     = "base_fee"
+	AttributeKeyGasUsed     = "gas_used"
+)
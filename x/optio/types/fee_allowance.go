@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// FeeAllowanceAddress pays for the fee allowances when no sponsor is set.
+// The module account itself is a blocked address and cannot be funded, this
+// derived account can be funded by anyone and is only spent through the
+// allowances granted from it.
+var FeeAllowanceAddress = sdk.AccAddress(address.Module(ModuleName, []byte("fee_allowance")))
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/fee_allowance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeAllowanceUsage tracks the fee allowances granted to recipients against
+// Params.feeAllowanceBudget.
+type FeeAllowanceUsage struct {
+	// granted is the sum of the spend limits of all granted allowances.
+	Granted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=granted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"granted"`
+	Count   uint64                                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *FeeAllowanceUsage) Reset()         { *m = FeeAllowanceUsage{} }
+func (m *FeeAllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeAllowanceUsage) ProtoMessage()    {}
+func (*FeeAllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df520a96e77f3b4, []int{0}
+}
+func (m *FeeAllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAllowanceUsage.Merge(m, src)
+}
+func (m *FeeAllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAllowanceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAllowanceUsage proto.InternalMessageInfo
+
+func (m *FeeAllowanceUsage) GetGranted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
+}
+
+func (m *FeeAllowanceUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// FeeAllowanceGrant records that a recipient received its one-time fee
+// allowance.
+type FeeAllowanceGrant struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	Height  int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeeAllowanceGrant) Reset()         { *m = FeeAllowanceGrant{} }
+func (m *FeeAllowanceGrant) String() string { return proto.CompactTextString(m) }
+func (*FeeAllowanceGrant) ProtoMessage()    {}
+func (*FeeAllowanceGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df520a96e77f3b4, []int{1}
+}
+func (m *FeeAllowanceGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAllowanceGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAllowanceGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAllowanceGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAllowanceGrant.Merge(m, src)
+}
+func (m *FeeAllowanceGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAllowanceGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAllowanceGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAllowanceGrant proto.InternalMessageInfo
+
+func (m *FeeAllowanceGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeAllowanceGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *FeeAllowanceGrant) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// FeeSponsor records that an account consented to pay for the fee allowances
+// of recipients while it is Params.feeAllowanceSponsor.
+type FeeSponsor struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeeSponsor) Reset()         { *m = FeeSponsor{} }
+func (m *FeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsor) ProtoMessage()    {}
+func (*FeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df520a96e77f3b4, []int{2}
+}
+func (m *FeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsor.Merge(m, src)
+}
+func (m *FeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsor proto.InternalMessageInfo
+
+func (m *FeeSponsor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeSponsor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeAllowanceUsage)(nil), "optio.optio.FeeAllowanceUsage")
+	proto.RegisterType((*FeeAllowanceGrant)(nil), "optio.optio.FeeAllowanceGrant")
+	proto.RegisterType((*FeeSponsor)(nil), "optio.optio.FeeSponsor")
+}
+
+func init() { proto.RegisterFile("optio/optio/fee_allowance.proto", fileDescriptor_1df520a96e77f3b4) }
+
+var fileDescriptor_1df520a96e77f3b4 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
+	0x10, 0xc7, 0xbb, 0xf0, 0xfb, 0x41, 0x58, 0x4e, 0x34, 0xc4, 0x54, 0x0e, 0xa5, 0xe1, 0xd4, 0x18,
+	0xed, 0x06, 0x8d, 0x57, 0x13, 0x31, 0xc1, 0xa3, 0x49, 0x89, 0x17, 0x2f, 0x64, 0xdb, 0x8e, 0xa5,
+	0x0a, 0x9d, 0xa6, 0xbb, 0xa0, 0xbe, 0x85, 0x17, 0xdf, 0xc1, 0x78, 0xf2, 0x31, 0x38, 0x72, 0xf4,
+	0xa4, 0x06, 0x0e, 0xbe, 0x86, 0xe9, 0xb6, 0x8d, 0x78, 0xf1, 0x32, 0x7f, 0xf6, 0xbb, 0xf3, 0x99,
+	0xc9, 0x0c, 0xed, 0x62, 0x22, 0x23, 0x64, 0xb9, 0xbd, 0x06, 0x18, 0xf3, 0xe9, 0x14, 0xef, 0x78,
+	0xec, 0x83, 0x93, 0xa4, 0x28, 0x51, 0x6f, 0x2a, 0xc9, 0x51, 0xb6, 0xd3, 0xe2, 0xb3, 0x28, 0x46,
+	0xa6, 0x6c, 0xae, 0x77, 0x4c, 0x1f, 0xc5, 0x0c, 0x05, 0xf3, 0xb8, 0x00, 0xb6, 0xe8, 0x7b, 0x20,
+	0x79, 0x9f, 0xf9, 0x18, 0xc5, 0x85, 0xde, 0x0e, 0x31, 0x44, 0x15, 0xb2, 0x2c, 0xca, 0x5f, 0x7b,
+	0x4f, 0x84, 0xb6, 0x86, 0x00, 0xa7, 0x65, 0xb3, 0x4b, 0xc1, 0x43, 0xd0, 0x6f, 0x68, 0x3d, 0x4c,
+	0x79, 0x2c, 0x21, 0x30, 0x88, 0x55, 0xb5, 0x9b, 0x87, 0xbb, 0x4e, 0x4e, 0x77, 0x32, 0xba, 0x53,
+	0xd0, 0x9d, 0x33, 0x8c, 0xe2, 0xc1, 0xf1, 0xf2, 0xbd, 0xab, 0xbd, 0x7c, 0x74, 0xed, 0x30, 0x92,
+	0x93, 0xb9, 0xe7, 0xf8, 0x38, 0x63, 0xc5, 0x28, 0xb9, 0x3b, 0x10, 0xc1, 0x2d, 0x93, 0x0f, 0x09,
+	0x08, 0x55, 0x20, 0x9e, 0xbf, 0x5e, 0xf7, 0x88, 0x5b, 0x36, 0xd0, 0xdb, 0xf4, 0xbf, 0x8f, 0xf3,
+	0x58, 0x1a, 0x15, 0x8b, 0xd8, 0xff, 0xdc, 0x3c, 0xe9, 0x8d, 0x7f, 0x8f, 0x75, 0x9e, 0x7d, 0xd6,
+	0x0d, 0x5a, 0xe7, 0x41, 0x90, 0x82, 0x10, 0x06, 0xb1, 0x88, 0xdd, 0x70, 0xcb, 0x34, 0x53, 0x72,
+	0x5e, 0xaa, 0x30, 0x8d, 0x12, 0x9f, 0xea, 0x3b, 0xb4, 0x36, 0x81, 0x28, 0x9c, 0x48, 0xa3, 0x6a,
+	0x11, 0xbb, 0xea, 0x16, 0x59, 0xef, 0x84, 0xd2, 0x21, 0xc0, 0x28, 0xc1, 0x58, 0x60, 0xfa, 0x07,
+	0xf9, 0xa7, 0xbe, 0xb2, 0x5d, 0x3f, 0x18, 0x2e, 0xd7, 0x26, 0x59, 0xad, 0x4d, 0xf2, 0xb9, 0x36,
+	0xc9, 0xe3, 0xc6, 0xd4, 0x56, 0x1b, 0x53, 0x7b, 0xdb, 0x98, 0xda, 0xd5, 0xfe, 0xd6, 0x22, 0x2e,
+	0xb2, 0x6b, 0x8d, 0x20, 0x5d, 0x44, 0x3e, 0x88, 0xe2, 0xb8, 0xf7, 0x85, 0x57, 0x2b, 0xf1, 0x6a,
+	0xea, 0x0e, 0x47, 0xdf, 0x03, 0x00, 0x70, 0xaa, 0xeb, 0xe4, 0x00, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeAllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Granted) > 0 {
+		for iNdEx := len(m.Granted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Granted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeAllowance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAllowanceGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAllowanceGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAllowanceGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeAllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Granted) > 0 {
+		for _, e := range m.Granted {
+			l = e.Size()
+			n += 1 + l + sovFeeAllowance(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovFeeAllowance(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *FeeAllowanceGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeAllowance(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeeAllowance(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFeeAllowance(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeAllowance(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFeeAllowance(uint64(m.Height))
+	}
+	return n
+}
+
+func sovFeeAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeAllowance(x uint64) (n int) {
+	return sovFeeAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeAllowanceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAllowanceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granted = append(m.Granted, types.Coin{})
+			if err := m.Granted[len(m.Granted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAllowanceGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAllowanceGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAllowanceGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeAllowance = fmt.Errorf("proto: unexpected end of group")
+)
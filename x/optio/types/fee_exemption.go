@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgTypeURLPrefix prefixes the type URLs of the optio messages.
+const msgTypeURLPrefix = "/optio.optio."
+
+// OnlyOptioMsgs reports whether msgs is not empty and holds only messages of
+// the optio module.
+func OnlyOptioMsgs(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !strings.HasPrefix(sdk.MsgTypeURL(msg), msgTypeURLPrefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Expired reports whether the window of the usage has ended at blockTime.
+func (u FeeExemptUsage) Expired(window time.Duration, blockTime time.Time) bool {
+	return !blockTime.Before(u.WindowStart.Add(window))
+}
+
+// Validate checks that a fee exempt usage is well formed.
+func (u FeeExemptUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid fee exempt account %s: %w", u.Address, err)
+	}
+	return nil
+}
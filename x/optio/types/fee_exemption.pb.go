@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/fee_exemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeExemptUsage counts the fee exempt txs of an authorized account in the
+// current window.
+type FeeExemptUsage struct {
+	Address     string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Count       uint64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	WindowStart time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+}
+
+func (m *FeeExemptUsage) Reset()         { *m = FeeExemptUsage{} }
+func (m *FeeExemptUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeExemptUsage) ProtoMessage()    {}
+func (*FeeExemptUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ebab019b4ea8b88, []int{0}
+}
+func (m *FeeExemptUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExemptUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExemptUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeExemptUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemptUsage.Merge(m, src)
+}
+func (m *FeeExemptUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExemptUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemptUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExemptUsage proto.InternalMessageInfo
+
+func (m *FeeExemptUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeExemptUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FeeExemptUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// FeeExemptBlockGas is the summed gas limit of the fee exempt txs of a block
+// against Params.feeExemptBlockGas.
+type FeeExemptBlockGas struct {
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Gas    uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *FeeExemptBlockGas) Reset()         { *m = FeeExemptBlockGas{} }
+func (m *FeeExemptBlockGas) String() string { return proto.CompactTextString(m) }
+func (*FeeExemptBlockGas) ProtoMessage()    {}
+func (*FeeExemptBlockGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ebab019b4ea8b88, []int{1}
+}
+func (m *FeeExemptBlockGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExemptBlockGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExemptBlockGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeExemptBlockGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemptBlockGas.Merge(m, src)
+}
+func (m *FeeExemptBlockGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExemptBlockGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemptBlockGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExemptBlockGas proto.InternalMessageInfo
+
+func (m *FeeExemptBlockGas) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeExemptBlockGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeExemptUsage)(nil), "optio.optio.FeeExemptUsage")
+	proto.RegisterType((*FeeExemptBlockGas)(nil), "optio.optio.FeeExemptBlockGas")
+}
+
+func init() { proto.RegisterFile("optio/optio/fee_exemption.proto", fileDescriptor_8ebab019b4ea8b88) }
+
+var fileDescriptor_8ebab019b4ea8b88 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x31, 0x4f, 0x02, 0x31,
+	0x14, 0xc7, 0xaf, 0xa2, 0xa8, 0xc5, 0x18, 0xbd, 0x10, 0x73, 0x61, 0xe8, 0x11, 0x26, 0x06, 0xd3,
+	0x4b, 0x74, 0x76, 0x21, 0x11, 0x46, 0x93, 0x43, 0x17, 0x17, 0x52, 0x8e, 0x47, 0x69, 0xe4, 0x78,
+	0x97, 0x6b, 0x11, 0xfc, 0x08, 0x6e, 0x7c, 0x2c, 0x46, 0x46, 0x27, 0x35, 0xf0, 0x45, 0xcc, 0xb5,
+	0x77, 0x2e, 0xaf, 0xef, 0xd7, 0xbe, 0xd7, 0xff, 0x3f, 0x7f, 0x1a, 0x62, 0x66, 0x14, 0x46, 0xae,
+	0x4e, 0x01, 0x46, 0xb0, 0x86, 0xb4, 0x80, 0x05, 0xcf, 0x72, 0x34, 0xe8, 0x37, 0xec, 0x13, 0xb7,
+	0xb5, 0xd5, 0x94, 0x28, 0xd1, 0xde, 0x47, 0x45, 0xe7, 0x46, 0x5a, 0xa1, 0x44, 0x94, 0x73, 0x88,
+	0x2c, 0x8d, 0x97, 0xd3, 0xc8, 0xa8, 0x14, 0xb4, 0x11, 0x69, 0xe6, 0x06, 0x3a, 0x9f, 0x84, 0x5e,
+	0xf6, 0x01, 0x1e, 0xed, 0xd7, 0x2f, 0x5a, 0x48, 0xf0, 0x03, 0x7a, 0x2a, 0x26, 0x93, 0x1c, 0xb4,
+	0x0e, 0x48, 0x9b, 0x74, 0xcf, 0xe3, 0x0a, 0xfd, 0x26, 0x3d, 0x49, 0x70, 0xb9, 0x30, 0xc1, 0x51,
+	0x9b, 0x74, 0x8f, 0x63, 0x07, 0xfe, 0x80, 0x5e, 0xac, 0xd4, 0x62, 0x82, 0xab, 0x91, 0x36, 0x22,
+	0x37, 0x41, 0xad, 0x4d, 0xba, 0x8d, 0xbb, 0x16, 0x77, 0xd2, 0xbc, 0x92, 0xe6, 0xcf, 0x95, 0x74,
+	0xef, 0x6c, 0xfb, 0x1d, 0x7a, 0x9b, 0x9f, 0x90, 0xc4, 0x0d, 0xb7, 0x39, 0x2c, 0x16, 0x3b, 0x0f,
+	0xf4, 0xfa, 0xdf, 0x4a, 0x6f, 0x8e, 0xc9, 0xdb, 0x40, 0x68, 0xff, 0x86, 0xd6, 0x67, 0xa0, 0xe4,
+	0xcc, 0x58, 0x33, 0xb5, 0xb8, 0x24, 0xff, 0x8a, 0xd6, 0xa4, 0xd0, 0xa5, 0x93, 0xa2, 0xed, 0xf5,
+	0xb7, 0x7b, 0x46, 0x76, 0x7b, 0x46, 0x7e, 0xf7, 0x8c, 0x6c, 0x0e, 0xcc, 0xdb, 0x1d, 0x98, 0xf7,
+	0x75, 0x60, 0xde, 0xeb, 0xad, 0x54, 0x66, 0xb6, 0x1c, 0xf3, 0x04, 0xd3, 0xe8, 0xa9, 0x48, 0x6b,
+	0x08, 0xf9, 0xbb, 0x4a, 0x40, 0x97, 0xe1, 0xae, 0xcb, 0xd3, 0x7c, 0x64, 0xa0, 0xc7, 0x75, 0xeb,
+	0xf8, 0xfe, 0x6f, 0x00, 0xb3, 0xe2, 0x29, 0xe6, 0x80, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeExemptUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeExemptUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExemptUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeeExemption(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Count != 0 {
+		i = encodeVarintFeeExemption(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeExemption(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeExemptBlockGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeExemptBlockGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExemptBlockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintFeeExemption(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeeExemption(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeExemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeExemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeExemptUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeExemption(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovFeeExemption(uint64(m.Count))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovFeeExemption(uint64(l))
+	return n
+}
+
+func (m *FeeExemptBlockGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeeExemption(uint64(m.Height))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovFeeExemption(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovFeeExemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeExemption(x uint64) (n int) {
+	return sovFeeExemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeExemptUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeExemptUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeExemptUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeExemptBlockGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeExemptBlockGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeExemptBlockGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeExemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeExemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeExemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeExemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeExemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeExemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeExemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeExemption = fmt.Errorf("proto: unexpected end of group")
+)
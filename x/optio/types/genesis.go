@@ -0,0 +1,245 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		DistributionList:           []Distribution{},
+		AddressStatusList:          []AddressStatus{},
+		DistributorStatsList:       []DistributorStats{},
+		FeeAllowanceUsage:          FeeAllowanceUsage{Granted: sdk.Coins{}},
+		FeeAllowanceGrantList:      []FeeAllowanceGrant{},
+		SupplyRecord:               NewSupplyRecord(),
+		TransferPacketList:         []TransferPacket{},
+		PortId:                     PortID,
+		RateLimitList:              []RateLimit{},
+		RateLimitPendingPacketList: []RateLimitPendingPacket{},
+		RemoteDistributionList:     []RemoteDistribution{},
+		PacketOutcomeList:          []PacketOutcome{},
+		FeeExemptUsageList:         []FeeExemptUsage{},
+		BaseFee:                    math.LegacyZeroDec(),
+		FeeSponsorList:             []FeeSponsor{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Check for duplicated ID in distribution
+	distributionIdMap := make(map[uint64]bool)
+	distributionCount := gs.GetDistributionCount()
+	for _, elem := range gs.DistributionList {
+		if _, ok := distributionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for distribution")
+		}
+		if elem.Id >= distributionCount {
+			return fmt.Errorf("distribution id should be lower or equal than the last id")
+		}
+		distributionIdMap[elem.Id] = true
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("distribution %d: %w", elem.Id, err)
+		}
+	}
+
+	_, usage := DistributionTotals(gs.DistributionList)
+
+	// Check for duplicated index in addressStatus
+	addressStatusIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AddressStatusList {
+		if err := ValidateAccount(elem.Address); err != nil {
+			return fmt.Errorf("invalid address status address %s: %w", elem.Address, err)
+		}
+		if _, ok := ComplianceStatus_name[int32(elem.Status)]; !ok {
+			return fmt.Errorf("invalid status %d for %s", elem.Status, elem.Address)
+		}
+		index := string(AddressStatusKey(elem.Address))
+		if _, ok := addressStatusIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for addressStatus")
+		}
+		addressStatusIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in distributorStats, the entries have to
+	// match the distribution history
+	distributorStatsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DistributorStatsList {
+		index := string(DistributorStatsKey(elem.Address))
+		if _, ok := distributorStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for distributorStats")
+		}
+		distributorStatsIndexMap[index] = struct{}{}
+	}
+	if err := CheckDistributorStats(gs.DistributorStatsList, usage); err != nil {
+		return err
+	}
+
+	// Check for duplicated index in feeAllowanceGrant, the usage has to
+	// count every grant
+	feeAllowanceGrantIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FeeAllowanceGrantList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid fee allowance grant address %s: %w", elem.Address, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Granter); err != nil {
+			return fmt.Errorf("invalid fee allowance granter %s: %w", elem.Granter, err)
+		}
+		index := string(FeeAllowanceGrantKey(elem.Address))
+		if _, ok := feeAllowanceGrantIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeAllowanceGrant")
+		}
+		feeAllowanceGrantIndexMap[index] = struct{}{}
+	}
+	if err := gs.FeeAllowanceUsage.Granted.Validate(); err != nil {
+		return fmt.Errorf("invalid granted fee allowances: %w", err)
+	}
+	if gs.FeeAllowanceUsage.Count != uint64(len(gs.FeeAllowanceGrantList)) {
+		return fmt.Errorf("fee allowance usage counts %d grants, genesis has %d", gs.FeeAllowanceUsage.Count, len(gs.FeeAllowanceGrantList))
+	}
+
+	// Check for duplicated index in feeSponsor
+	feeSponsorIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FeeSponsorList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid fee sponsor address %s: %w", elem.Address, err)
+		}
+		index := string(FeeSponsorKey(elem.Address))
+		if _, ok := feeSponsorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeSponsor")
+		}
+		feeSponsorIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in transferPacket, every packet belongs to
+	// a distribution in the history
+	transferPacketIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TransferPacketList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if elem.DistributionId >= distributionCount {
+			return fmt.Errorf("transfer packet %s/%d references unknown distribution %d", elem.Channel, elem.Sequence, elem.DistributionId)
+		}
+		index := string(TransferPacketKey(elem.Channel, elem.Sequence))
+		if _, ok := transferPacketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transferPacket")
+		}
+		transferPacketIndexMap[index] = struct{}{}
+	}
+
+	// The supply record has to match the mints and burns of the module and
+	// stay under the max supply
+	record := gs.SupplyRecord.WithDefaults()
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	if record.Supply().GT(gs.Params.MaxSupply) {
+		return fmt.Errorf("supply %s exceeds max supply %s", record.Supply(), gs.Params.MaxSupply)
+	}
+	minted, burned := ModuleFlows(gs.DistributionList, gs.TransferPacketList)
+	if !record.Minted.Equal(minted) {
+		return fmt.Errorf("minted %s does not match the distributed total %s", record.Minted, minted)
+	}
+	if !record.Burned.Equal(burned) {
+		return fmt.Errorf("burned %s does not match the refunded transfers %s", record.Burned, burned)
+	}
+
+	// Check for duplicated index in rateLimit
+	rateLimitIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RateLimitList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(RateLimitKey(elem.ChannelId))
+		if _, ok := rateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimit")
+		}
+		rateLimitIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in rateLimitPendingPacket
+	rateLimitPendingPacketIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RateLimitPendingPacketList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(RateLimitPendingPacketKey(elem.ChannelId, elem.Sequence))
+		if _, ok := rateLimitPendingPacketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimitPendingPacket")
+		}
+		rateLimitPendingPacketIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in remoteDistribution
+	remoteDistributionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RemoteDistributionList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(RemoteDistributionKey(elem.ChannelId, elem.Sequence))
+		if _, ok := remoteDistributionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for remoteDistribution")
+		}
+		remoteDistributionIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in packetOutcome
+	packetOutcomeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PacketOutcomeList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(PacketOutcomeKey(elem.PortId, elem.ChannelId, elem.Sequence))
+		if _, ok := packetOutcomeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for packetOutcome")
+		}
+		packetOutcomeIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in feeExemptUsage
+	feeExemptUsageIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeExemptUsageList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(FeeExemptUsageKey(elem.Address))
+		if _, ok := feeExemptUsageIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeExemptUsage")
+		}
+		feeExemptUsageIndexMap[index] = struct{}{}
+	}
+
+	// the base fee is unset in states exported before it existed
+	if !gs.BaseFee.IsNil() {
+		if err := validateBaseFee(gs.BaseFee); err != nil {
+			return err
+		}
+	}
+
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return nil
+}
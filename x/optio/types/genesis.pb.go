@@ -0,0 +1,1347 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/genesis.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the optio module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DistributionList      []Distribution      `protobuf:"bytes,2,rep,name=distributionList,proto3" json:"distributionList"`
+	DistributionCount     uint64              `protobuf:"varint,3,opt,name=distributionCount,proto3" json:"distributionCount,omitempty"`
+	AddressStatusList     []AddressStatus     `protobuf:"bytes,4,rep,name=addressStatusList,proto3" json:"addressStatusList"`
+	DistributorStatsList  []DistributorStats  `protobuf:"bytes,5,rep,name=distributorStatsList,proto3" json:"distributorStatsList"`
+	FeeAllowanceUsage     FeeAllowanceUsage   `protobuf:"bytes,6,opt,name=feeAllowanceUsage,proto3" json:"feeAllowanceUsage"`
+	FeeAllowanceGrantList []FeeAllowanceGrant `protobuf:"bytes,7,rep,name=feeAllowanceGrantList,proto3" json:"feeAllowanceGrantList"`
+	SupplyRecord          SupplyRecord        `protobuf:"bytes,8,opt,name=supplyRecord,proto3" json:"supplyRecord"`
+	TransferPacketList    []TransferPacket    `protobuf:"bytes,9,rep,name=transferPacketList,proto3" json:"transferPacketList"`
+	// portId is the port of the optio IBC application.
+	PortId                     string                   `protobuf:"bytes,10,opt,name=portId,proto3" json:"portId,omitempty"`
+	RateLimitList              []RateLimit              `protobuf:"bytes,11,rep,name=rateLimitList,proto3" json:"rateLimitList"`
+	RateLimitPendingPacketList []RateLimitPendingPacket `protobuf:"bytes,12,rep,name=rateLimitPendingPacketList,proto3" json:"rateLimitPendingPacketList"`
+	RemoteDistributionList     []RemoteDistribution     `protobuf:"bytes,13,rep,name=remoteDistributionList,proto3" json:"remoteDistributionList"`
+	PacketOutcomeList          []PacketOutcome          `protobuf:"bytes,14,rep,name=packetOutcomeList,proto3" json:"packetOutcomeList"`
+	// feeExemptUsageList holds the fee exempt tx windows of authorized
+	// accounts.
+	FeeExemptUsageList []FeeExemptUsage `protobuf:"bytes,15,rep,name=feeExemptUsageList,proto3" json:"feeExemptUsageList"`
+	// baseFee is the stored base fee per unit of gas of the next block. It is
+	// zero until the base fee first moves, and the min base fee applies then.
+	BaseFee        cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"baseFee"`
+	FeeSponsorList []FeeSponsor                `protobuf:"bytes,17,rep,name=feeSponsorList,proto3" json:"feeSponsorList"`
+	// feeExemptBlockGas is the gas of the fee exempt txs in the last block. It
+	// only counts for its height, so it is ignored once imported at another one.
+	FeeExemptBlockGas FeeExemptBlockGas `protobuf:"bytes,18,opt,name=feeExemptBlockGas,proto3" json:"feeExemptBlockGas"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5ceb1cbec4b9ae2, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDistributionList() []Distribution {
+	if m != nil {
+		return m.DistributionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionCount() uint64 {
+	if m != nil {
+		return m.DistributionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAddressStatusList() []AddressStatus {
+	if m != nil {
+		return m.AddressStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributorStatsList() []DistributorStats {
+	if m != nil {
+		return m.DistributorStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeAllowanceUsage() FeeAllowanceUsage {
+	if m != nil {
+		return m.FeeAllowanceUsage
+	}
+	return FeeAllowanceUsage{}
+}
+
+func (m *GenesisState) GetFeeAllowanceGrantList() []FeeAllowanceGrant {
+	if m != nil {
+		return m.FeeAllowanceGrantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyRecord() SupplyRecord {
+	if m != nil {
+		return m.SupplyRecord
+	}
+	return SupplyRecord{}
+}
+
+func (m *GenesisState) GetTransferPacketList() []TransferPacket {
+	if m != nil {
+		return m.TransferPacketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRateLimitList() []RateLimit {
+	if m != nil {
+		return m.RateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitPendingPacketList() []RateLimitPendingPacket {
+	if m != nil {
+		return m.RateLimitPendingPacketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteDistributionList() []RemoteDistribution {
+	if m != nil {
+		return m.RemoteDistributionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketOutcomeList() []PacketOutcome {
+	if m != nil {
+		return m.PacketOutcomeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExemptUsageList() []FeeExemptUsage {
+	if m != nil {
+		return m.FeeExemptUsageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorList() []FeeSponsor {
+	if m != nil {
+		return m.FeeSponsorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExemptBlockGas() FeeExemptBlockGas {
+	if m != nil {
+		return m.FeeExemptBlockGas
+	}
+	return FeeExemptBlockGas{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "optio.optio.GenesisState")
+}
+
+func init() { proto.RegisterFile("optio/optio/genesis.proto", fileDescriptor_e5ceb1cbec4b9ae2) }
+
+var fileDescriptor_e5ceb1cbec4b9ae2 = []byte{
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0xe3, 0x85, 0x0d, 0xcb, 0x04, 0x58, 0x32, 0xcb, 0xb2, 0x26, 0xec, 0x3a, 0xd9, 0xe5,
+	0x12, 0xad, 0xa8, 0x23, 0x51, 0x89, 0x3b, 0x21, 0x04, 0x55, 0x45, 0x25, 0x4d, 0x5a, 0x55, 0x42,
+	0xaa, 0xa2, 0x89, 0xf3, 0x12, 0x2c, 0x62, 0x8f, 0x35, 0x33, 0x69, 0xc9, 0xb7, 0xe8, 0xc7, 0xe8,
+	0xb1, 0x87, 0x7e, 0x08, 0x8e, 0xa8, 0xa7, 0xaa, 0x07, 0x54, 0xc1, 0xa1, 0xfd, 0x18, 0x95, 0x67,
+	0xc6, 0xd5, 0x38, 0x36, 0x5c, 0x0c, 0x9e, 0xf7, 0x7f, 0xbf, 0xff, 0x1b, 0xcf, 0x7b, 0x13, 0xb4,
+	0x45, 0x23, 0xe1, 0xd3, 0x86, 0x7a, 0x8e, 0x21, 0x04, 0xee, 0x73, 0x37, 0x62, 0x54, 0x50, 0x5c,
+	0x92, 0x8b, 0xae, 0x7c, 0x56, 0xca, 0x24, 0xf0, 0x43, 0xda, 0x90, 0x4f, 0x15, 0xaf, 0x6c, 0x79,
+	0x94, 0x07, 0x94, 0xf7, 0xe5, 0x5b, 0x43, 0xbd, 0xe8, 0xd0, 0xc6, 0x98, 0x8e, 0xa9, 0x5a, 0x8f,
+	0xff, 0xd3, 0xab, 0x35, 0xd3, 0x8b, 0x0c, 0x87, 0x0c, 0x38, 0xef, 0x73, 0x41, 0xc4, 0x34, 0xc9,
+	0x73, 0x4c, 0xc5, 0xd0, 0xe7, 0x82, 0xf9, 0x83, 0xa9, 0xf0, 0x69, 0xa8, 0xe3, 0x3b, 0xb9, 0x71,
+	0xca, 0x24, 0x25, 0x81, 0x54, 0x4d, 0xd1, 0x08, 0xa0, 0x4f, 0x26, 0x13, 0xfa, 0x96, 0x84, 0x1e,
+	0xdc, 0x27, 0x80, 0x4b, 0x08, 0x22, 0xc3, 0xc6, 0x36, 0x05, 0x11, 0x61, 0x24, 0xe0, 0x79, 0x5b,
+	0x88, 0x88, 0x77, 0x01, 0xa2, 0x4f, 0xa7, 0xc2, 0xa3, 0x41, 0x02, 0xff, 0xdb, 0x54, 0x30, 0x22,
+	0xa0, 0x3f, 0xf1, 0x03, 0x5f, 0xe8, 0xe8, 0xbf, 0xa9, 0x28, 0x04, 0x54, 0x40, 0x5f, 0x30, 0x20,
+	0x7c, 0xca, 0x66, 0x79, 0xe6, 0x7c, 0x1a, 0x45, 0x93, 0x59, 0x5e, 0xb2, 0x60, 0x24, 0xe4, 0x23,
+	0x60, 0x7d, 0x55, 0x85, 0x92, 0xfc, 0xf7, 0x1d, 0xa1, 0x95, 0x63, 0x75, 0x8a, 0x3d, 0x41, 0x04,
+	0xe0, 0x7d, 0x54, 0x54, 0x1b, 0xb0, 0xad, 0x9a, 0x55, 0x2f, 0xed, 0xfd, 0xe1, 0x1a, 0xa7, 0xea,
+	0x76, 0x64, 0xa8, 0xb9, 0x7c, 0x75, 0x53, 0x2d, 0xbc, 0xff, 0xf6, 0xe1, 0x7f, 0xab, 0xab, 0xd5,
+	0xf8, 0x29, 0x5a, 0x37, 0xbf, 0xff, 0x89, 0xcf, 0x85, 0xfd, 0x4b, 0x6d, 0xa1, 0x5e, 0xda, 0xdb,
+	0x4a, 0x11, 0x5a, 0x86, 0xa8, 0xb9, 0x18, 0x73, 0xba, 0x99, 0x44, 0xbc, 0x8b, 0xca, 0xe6, 0xda,
+	0x21, 0x9d, 0x86, 0xc2, 0x5e, 0xa8, 0x59, 0xf5, 0xc5, 0x6e, 0x36, 0x80, 0x9f, 0xa1, 0xb2, 0x6e,
+	0x8e, 0x9e, 0xec, 0x0d, 0xe9, 0xbd, 0x28, 0xbd, 0x2b, 0x29, 0xef, 0x03, 0x53, 0xa5, 0xcd, 0xb3,
+	0xa9, 0xf8, 0x15, 0xda, 0x30, 0x5a, 0x25, 0x0e, 0x28, 0xe4, 0xaf, 0x12, 0xf9, 0x4f, 0xfe, 0x76,
+	0xb4, 0x50, 0x53, 0x73, 0x01, 0xb8, 0x8b, 0xca, 0x23, 0x80, 0x83, 0xa4, 0xbb, 0x5e, 0x72, 0x32,
+	0x06, 0xbb, 0x28, 0x3f, 0xb3, 0x93, 0xa2, 0xb6, 0xe7, 0x55, 0x49, 0xb1, 0x99, 0x74, 0x7c, 0x86,
+	0xfe, 0x34, 0x17, 0x8f, 0x19, 0x09, 0x85, 0xac, 0x76, 0xa9, 0xb6, 0xf0, 0x20, 0x57, 0x2a, 0x35,
+	0x37, 0x1f, 0x81, 0x0f, 0xd1, 0x8a, 0xea, 0xa7, 0x2e, 0x78, 0x94, 0x0d, 0xed, 0xdf, 0x6a, 0x56,
+	0xe6, 0x3c, 0x7b, 0x86, 0x40, 0xd3, 0x52, 0x49, 0xf8, 0x39, 0xc2, 0x49, 0xeb, 0x75, 0x64, 0xe7,
+	0xc9, 0xea, 0x96, 0x65, 0x75, 0xdb, 0x29, 0xd4, 0x8b, 0x94, 0x4c, 0xc3, 0x72, 0x92, 0xf1, 0x26,
+	0x2a, 0x46, 0x94, 0x89, 0x27, 0x43, 0x1b, 0xd5, 0xac, 0xfa, 0x72, 0x57, 0xbf, 0xe1, 0x26, 0x5a,
+	0x8d, 0x07, 0xe8, 0x24, 0x9e, 0x1f, 0xe9, 0x52, 0x92, 0x2e, 0x9b, 0x29, 0x97, 0x6e, 0xa2, 0xd0,
+	0x06, 0xe9, 0x14, 0xec, 0xa3, 0xca, 0xcf, 0x85, 0x0e, 0x84, 0x43, 0x3f, 0x1c, 0x1b, 0x65, 0xaf,
+	0x48, 0xe0, 0x4e, 0x3e, 0x30, 0x25, 0xd7, 0xf4, 0x07, 0x60, 0xf8, 0x35, 0xda, 0x54, 0x13, 0xdd,
+	0x9a, 0x1f, 0x9c, 0x55, 0x69, 0x53, 0x4d, 0xdb, 0x64, 0xa4, 0xda, 0xe2, 0x1e, 0x48, 0x3c, 0x16,
+	0x6a, 0xd4, 0x4f, 0xd5, 0x7d, 0x23, 0xc9, 0x6b, 0x39, 0x63, 0xd1, 0x31, 0x55, 0x49, 0xa7, 0x65,
+	0x52, 0xe3, 0x83, 0x1c, 0x01, 0x1c, 0xc9, 0xab, 0x4f, 0xf6, 0x9e, 0x04, 0xfe, 0x9e, 0x73, 0x90,
+	0xed, 0x94, 0x2c, 0x39, 0xc8, 0x6c, 0x32, 0xee, 0xa0, 0xa5, 0x01, 0xe1, 0xd0, 0x06, 0xb0, 0xd7,
+	0xe3, 0x93, 0x6c, 0xee, 0xc7, 0xd2, 0x2f, 0x37, 0xd5, 0x6d, 0xf5, 0xeb, 0xc0, 0x87, 0x17, 0xae,
+	0x4f, 0x1b, 0x01, 0x11, 0xe7, 0xee, 0x09, 0x8c, 0x89, 0x37, 0x6b, 0x81, 0xf7, 0xe9, 0xe3, 0x23,
+	0xa4, 0xc2, 0x6e, 0x0b, 0x3c, 0x75, 0x0b, 0x25, 0x18, 0x7c, 0x84, 0xd6, 0x46, 0x00, 0xbd, 0x88,
+	0x86, 0x9c, 0x32, 0x59, 0x60, 0x59, 0x16, 0xf8, 0xd7, 0x7c, 0x81, 0x5a, 0xa2, 0x8b, 0x9b, 0x4b,
+	0xd2, 0x93, 0xaa, 0xca, 0x6d, 0x4e, 0xa8, 0x77, 0x71, 0x4c, 0xb8, 0x8d, 0xf3, 0x27, 0x35, 0xad,
+	0x32, 0x26, 0x75, 0x2e, 0xd0, 0xbe, 0xba, 0x75, 0xac, 0xeb, 0x5b, 0xc7, 0xfa, 0x7a, 0xeb, 0x58,
+	0xef, 0xee, 0x9c, 0xc2, 0xf5, 0x9d, 0x53, 0xf8, 0x7c, 0xe7, 0x14, 0xce, 0x76, 0xc7, 0xbe, 0x38,
+	0x9f, 0x0e, 0x5c, 0x8f, 0x06, 0x8d, 0xd3, 0x18, 0xdb, 0x03, 0xf6, 0xc6, 0xf7, 0x80, 0xeb, 0xab,
+	0xfb, 0x52, 0xff, 0x15, 0xb3, 0x08, 0xf8, 0xa0, 0x28, 0x6f, 0xee, 0xc7, 0x3f, 0x06, 0x00, 0x87,
+	0x28, 0x21, 0xfc, 0x8a, 0x07, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeExemptBlockGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.FeeSponsorList) > 0 {
+		for iNdEx := len(m.FeeSponsorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.FeeExemptUsageList) > 0 {
+		for iNdEx := len(m.FeeExemptUsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeExemptUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PacketOutcomeList) > 0 {
+		for iNdEx := len(m.PacketOutcomeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketOutcomeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RemoteDistributionList) > 0 {
+		for iNdEx := len(m.RemoteDistributionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteDistributionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RateLimitPendingPacketList) > 0 {
+		for iNdEx := len(m.RateLimitPendingPacketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitPendingPacketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RateLimitList) > 0 {
+		for iNdEx := len(m.RateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TransferPacketList) > 0 {
+		for iNdEx := len(m.TransferPacketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPacketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.SupplyRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.FeeAllowanceGrantList) > 0 {
+		for iNdEx := len(m.FeeAllowanceGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowanceGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.FeeAllowanceUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DistributorStatsList) > 0 {
+		for iNdEx := len(m.DistributorStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributorStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddressStatusList) > 0 {
+		for iNdEx := len(m.AddressStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DistributionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DistributionList) > 0 {
+		for iNdEx := len(m.DistributionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DistributionList) > 0 {
+		for _, e := range m.DistributionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DistributionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionCount))
+	}
+	if len(m.AddressStatusList) > 0 {
+		for _, e := range m.AddressStatusList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributorStatsList) > 0 {
+		for _, e := range m.DistributorStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeAllowanceUsage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeAllowanceGrantList) > 0 {
+		for _, e := range m.FeeAllowanceGrantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SupplyRecord.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferPacketList) > 0 {
+		for _, e := range m.TransferPacketList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RateLimitList) > 0 {
+		for _, e := range m.RateLimitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitPendingPacketList) > 0 {
+		for _, e := range m.RateLimitPendingPacketList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemoteDistributionList) > 0 {
+		for _, e := range m.RemoteDistributionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketOutcomeList) > 0 {
+		for _, e := range m.PacketOutcomeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeExemptUsageList) > 0 {
+		for _, e := range m.FeeExemptUsageList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BaseFee.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.FeeSponsorList) > 0 {
+		for _, e := range m.FeeSponsorList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeExemptBlockGas.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionList = append(m.DistributionList, Distribution{})
+			if err := m.DistributionList[len(m.DistributionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+			}
+			m.DistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressStatusList = append(m.AddressStatusList, AddressStatus{})
+			if err := m.AddressStatusList[len(m.AddressStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributorStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributorStatsList = append(m.DistributorStatsList, DistributorStats{})
+			if err := m.DistributorStatsList[len(m.DistributorStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAllowanceUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowanceGrantList = append(m.FeeAllowanceGrantList, FeeAllowanceGrant{})
+			if err := m.FeeAllowanceGrantList[len(m.FeeAllowanceGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPacketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPacketList = append(m.TransferPacketList, TransferPacket{})
+			if err := m.TransferPacketList[len(m.TransferPacketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitList = append(m.RateLimitList, RateLimit{})
+			if err := m.RateLimitList[len(m.RateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitPendingPacketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitPendingPacketList = append(m.RateLimitPendingPacketList, RateLimitPendingPacket{})
+			if err := m.RateLimitPendingPacketList[len(m.RateLimitPendingPacketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDistributionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteDistributionList = append(m.RemoteDistributionList, RemoteDistribution{})
+			if err := m.RemoteDistributionList[len(m.RemoteDistributionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketOutcomeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketOutcomeList = append(m.PacketOutcomeList, PacketOutcome{})
+			if err := m.PacketOutcomeList[len(m.PacketOutcomeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptUsageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptUsageList = append(m.FeeExemptUsageList, FeeExemptUsage{})
+			if err := m.FeeExemptUsageList[len(m.FeeExemptUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorList = append(m.FeeSponsorList, FeeSponsor{})
+			if err := m.FeeSponsorList[len(m.FeeSponsorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptBlockGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeExemptBlockGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,459 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	distributor := sample.AccAddress()
+	recipient := sample.AccAddress()
+	distribution := func(id uint64, amount int64) types.Distribution {
+		return types.Distribution{
+			Id:          id,
+			Distributor: distributor,
+			Amount:      math.NewInt(amount),
+			Recipients:  []types.Recipient{{Address: recipient, Amount: math.NewInt(amount)}},
+		}
+	}
+	stats := func(total int64, count uint64) []types.DistributorStats {
+		return []types.DistributorStats{{Address: distributor, TotalDistributed: math.NewInt(total), DistributionCount: count}}
+	}
+	packet := func(sequence, distributionID uint64) types.TransferPacket {
+		return types.TransferPacket{
+			Channel:        "channel-0",
+			Sequence:       sequence,
+			DistributionId: distributionID,
+			Receiver:       "cosmos1receiver",
+			Token:          sdk.NewInt64Coin("uOPT", 10),
+			Status:         types.TRANSFER_STATUS_PENDING,
+		}
+	}
+	remoteDistribution := func(sequence uint64) types.RemoteDistribution {
+		return types.RemoteDistribution{
+			ChannelId:    "channel-1",
+			Sequence:     sequence,
+			ConnectionId: "connection-0",
+			Treasury:     "cosmos1treasury",
+			Outputs:      []banktypes.Output{{Address: "cosmos1receiver", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))}},
+			Status:       types.REMOTE_DISTRIBUTION_STATUS_PENDING,
+		}
+	}
+	packetOutcome := func(sequence uint64) types.PacketOutcome {
+		return types.PacketOutcome{
+			PortId:    "transfer",
+			ChannelId: "channel-0",
+			Sequence:  sequence,
+			Status:    types.PACKET_OUTCOME_STATUS_PENDING,
+		}
+	}
+	rateLimit := func(channelID string) types.RateLimit {
+		return types.NewRateLimit(channelID, types.RateLimitQuota{MaxPercent: math.LegacyNewDec(5), MaxAmount: math.ZeroInt(), Window: time.Hour})
+	}
+	withState := func(f func(gs *types.GenesisState)) *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.DistributionList = []types.Distribution{distribution(0, 10), distribution(1, 20)}
+		gs.DistributionCount = 2
+		gs.DistributorStatsList = stats(30, 2)
+		gs.SupplyRecord = types.SupplyRecord{Minted: math.NewInt(30), Burned: math.ZeroInt()}
+		f(gs)
+		return gs
+	}
+
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(nil, "uOPT", math.NewInt(1000), 64, 32, 256, nil, false, 1000, 20000, nil, time.Hour, "", nil, 10*time.Minute, nil, 0, 60, time.Hour, nil, 0, math.LegacyNewDecWithPrec(125, 3), math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyOneDec()),
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "negative max supply",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, "uOPT", math.NewInt(-1), 64, 32, 256, nil, false, 1000, 20000, nil, time.Hour, "", nil, 10*time.Minute, nil, 0, 60, time.Hour, nil, 0, math.LegacyNewDecWithPrec(125, 3), math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyOneDec()),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid authorized account",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"invalid"}, "uOPT", math.NewInt(1000), 64, 32, 256, nil, false, 1000, 20000, nil, time.Hour, "", nil, 10*time.Minute, nil, 0, 60, time.Hour, nil, 0, math.LegacyNewDecWithPrec(125, 3), math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyOneDec()),
+			},
+			valid: false,
+		},
+		{
+			desc:     "valid module state",
+			genState: withState(func(*types.GenesisState) {}),
+			valid:    true,
+		},
+		{
+			desc: "duplicated distribution",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributionList = []types.Distribution{distribution(0, 10), distribution(0, 20)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "distribution id above count",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributionCount = 1
+			}),
+			valid: false,
+		},
+		{
+			desc: "recipient amounts do not sum up",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributionList[0].Amount = math.NewInt(11)
+			}),
+			valid: false,
+		},
+		{
+			desc: "supply above max supply",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.MaxSupply = math.NewInt(29)
+			}),
+			valid: false,
+		},
+		{
+			desc: "refunds reduce the supply",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.MaxSupply = math.NewInt(20)
+				gs.DistributionList[0].Recipients = []types.Recipient{{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(10)}}
+				p := packet(1, 0)
+				p.Status = types.TRANSFER_STATUS_REFUNDED_TIMEOUT
+				gs.TransferPacketList = []types.TransferPacket{p}
+				gs.SupplyRecord.Burned = math.NewInt(10)
+			}),
+			valid: true,
+		},
+		{
+			desc: "distributed above minted",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.SupplyRecord.Minted = math.NewInt(29)
+			}),
+			valid: false,
+		},
+		{
+			desc: "minted above distributed",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.SupplyRecord.Minted = math.NewInt(31)
+			}),
+			valid: false,
+		},
+		{
+			desc: "burned without refunded transfers",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.SupplyRecord.Burned = math.NewInt(1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "base fee burned above minted",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.SupplyRecord.BaseFeeBurned = math.NewInt(31)
+			}),
+			valid: true,
+		},
+		{
+			desc: "negative base fee burned",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.SupplyRecord.BaseFeeBurned = math.NewInt(-1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "stats do not match history",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributorStatsList = stats(30, 3)
+			}),
+			valid: false,
+		},
+		{
+			desc: "missing stats",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributorStatsList = nil
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicated addressStatus",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.AddressStatusList = []types.AddressStatus{
+					{Address: recipient, Status: types.COMPLIANCE_STATUS_ALLOWED},
+					{Address: recipient, Status: types.COMPLIANCE_STATUS_DENIED},
+				}
+			}),
+			valid: false,
+		},
+		{
+			desc: "fee allowance usage does not count grants",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeAllowanceGrantList = []types.FeeAllowanceGrant{{Address: recipient, Granter: distributor}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicated fee sponsor",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeSponsorList = []types.FeeSponsor{{Address: distributor, Height: 1}, {Address: distributor, Height: 2}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "fee sponsor with invalid address",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeSponsorList = []types.FeeSponsor{{Address: "invalid"}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "cross-chain recipient with transfer packet",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributionList[0].Recipients = []types.Recipient{{SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(10)}}
+				gs.TransferPacketList = []types.TransferPacket{packet(1, 0)}
+			}),
+			valid: true,
+		},
+		{
+			desc: "cross-chain recipient with local address",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.DistributionList[0].Recipients = []types.Recipient{{Address: recipient, SourceChannel: "channel-0", Receiver: "cosmos1receiver", Amount: math.NewInt(10)}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicated transferPacket",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.TransferPacketList = []types.TransferPacket{packet(1, 0), packet(1, 1)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "transfer packet of unknown distribution",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.TransferPacketList = []types.TransferPacket{packet(1, 2)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "transfer packet without status",
+			genState: withState(func(gs *types.GenesisState) {
+				p := packet(1, 0)
+				p.Status = types.TRANSFER_STATUS_UNSPECIFIED
+				gs.TransferPacketList = []types.TransferPacket{p}
+			}),
+			valid: false,
+		},
+		{
+			desc: "missing port",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.PortId = ""
+			}),
+			valid: false,
+		},
+		{
+			desc: "remote controllers",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.RemoteControllers = []types.RemoteController{
+					{ChannelId: "channel-0", Quota: math.NewInt(1000)},
+					{ChannelId: "channel-1", Quota: math.ZeroInt()},
+				}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated remote controller",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.RemoteControllers = []types.RemoteController{
+					{ChannelId: "channel-0", Quota: math.NewInt(1000)},
+					{ChannelId: "channel-0", Quota: math.NewInt(10)},
+				}
+			}),
+			valid: false,
+		},
+		{
+			desc: "remote controller with negative quota",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.RemoteControllers = []types.RemoteController{{ChannelId: "channel-0", Quota: math.NewInt(-1)}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "rate limits",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RateLimitList = []types.RateLimit{rateLimit("channel-0"), rateLimit("channel-1")}
+				gs.RateLimitPendingPacketList = []types.RateLimitPendingPacket{{ChannelId: "channel-0", Sequence: 1, Amount: math.NewInt(10)}}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated rateLimit",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RateLimitList = []types.RateLimit{rateLimit("channel-0"), rateLimit("channel-0")}
+			}),
+			valid: false,
+		},
+		{
+			desc: "rate limit without cap",
+			genState: withState(func(gs *types.GenesisState) {
+				r := rateLimit("channel-0")
+				r.Quota.MaxPercent = math.LegacyZeroDec()
+				gs.RateLimitList = []types.RateLimit{r}
+			}),
+			valid: false,
+		},
+		{
+			desc: "rate limit flow not summing its buckets",
+			genState: withState(func(gs *types.GenesisState) {
+				r := rateLimit("channel-0").Advance(time.Unix(3600, 0).UTC(), math.NewInt(1000))
+				r.Flow.Outflow = math.NewInt(10)
+				gs.RateLimitList = []types.RateLimit{r}
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicated rateLimitPendingPacket",
+			genState: withState(func(gs *types.GenesisState) {
+				p := types.RateLimitPendingPacket{ChannelId: "channel-0", Sequence: 1, Amount: math.NewInt(10)}
+				gs.RateLimitPendingPacketList = []types.RateLimitPendingPacket{p, p}
+			}),
+			valid: false,
+		},
+		{
+			desc: "remote distributions",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RemoteDistributionList = []types.RemoteDistribution{remoteDistribution(1), remoteDistribution(2)}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated remoteDistribution",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.RemoteDistributionList = []types.RemoteDistribution{remoteDistribution(1), remoteDistribution(1)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "remote distribution without outputs",
+			genState: withState(func(gs *types.GenesisState) {
+				d := remoteDistribution(1)
+				d.Outputs = nil
+				gs.RemoteDistributionList = []types.RemoteDistribution{d}
+			}),
+			valid: false,
+		},
+		{
+			desc: "packet outcomes",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.PacketOutcomeList = []types.PacketOutcome{packetOutcome(1), packetOutcome(2)}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated packetOutcome",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.PacketOutcomeList = []types.PacketOutcome{packetOutcome(1), packetOutcome(1)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "packet outcome without status",
+			genState: withState(func(gs *types.GenesisState) {
+				o := packetOutcome(1)
+				o.Status = types.PACKET_OUTCOME_STATUS_UNSPECIFIED
+				gs.PacketOutcomeList = []types.PacketOutcome{o}
+			}),
+			valid: false,
+		},
+		{
+			desc: "fee exempt usages",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeExemptUsageList = []types.FeeExemptUsage{{Address: distributor, Count: 1}, {Address: recipient, Count: 2}}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated feeExemptUsage",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeExemptUsageList = []types.FeeExemptUsage{{Address: distributor, Count: 1}, {Address: distributor, Count: 2}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "fee exempt usage with invalid address",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.FeeExemptUsageList = []types.FeeExemptUsage{{Address: "invalid", Count: 1}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "fee exemptions without window",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.FeeExemptBlockGas = 1_000_000
+				gs.Params.FeeExemptWindow = 0
+			}),
+			valid: false,
+		},
+		{
+			desc: "negative base fee",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.BaseFee = math.LegacyNewDec(-1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "base fee without min base fee",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.BaseFeeTargetGas = 1_000_000
+				gs.Params.MinBaseFee = math.LegacyZeroDec()
+			}),
+			valid: false,
+		},
+		{
+			desc: "max base fee below min base fee",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.BaseFeeTargetGas = 1_000_000
+				gs.Params.MaxBaseFee = math.LegacyNewDecWithPrec(5, 1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "burn rate above one",
+			genState: withState(func(gs *types.GenesisState) {
+				gs.Params.BaseFeeBurnRate = math.LegacyNewDec(2)
+			}),
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
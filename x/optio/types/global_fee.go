@@ -0,0 +1,37 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CombineMinGasPrices returns the minimum gas prices a node enforces given
+// the chain-wide prices of the params and the local prices of the node. Only
+// the denoms of the chain-wide prices are accepted, and a node can raise
+// their price but not lower it. The local prices apply unchanged when there
+// are no chain-wide prices.
+func CombineMinGasPrices(global, local sdk.DecCoins) sdk.DecCoins {
+	if global.Empty() {
+		return local
+	}
+
+	combined := make(sdk.DecCoins, len(global))
+	for i, price := range global {
+		if amount := local.AmountOf(price.Denom); amount.GT(price.Amount) {
+			price.Amount = amount
+		}
+		combined[i] = price
+	}
+	return combined
+}
+
+// RequiredFees returns the fees the minimum gas prices require for a gas
+// limit, one per denom and rounded up. A fee covering any of them is enough.
+func RequiredFees(prices sdk.DecCoins, gas uint64) sdk.Coins {
+	fees := make(sdk.Coins, 0, len(prices))
+	for _, price := range prices {
+		fee := price.Amount.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+		fees = append(fees, sdk.NewCoin(price.Denom, fee))
+	}
+	return fees
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/x/optio/types"
+)
+
+func TestCombineMinGasPrices(t *testing.T) {
+	prices := func(s string) sdk.DecCoins {
+		coins, err := sdk.ParseDecCoins(s)
+		require.NoError(t, err)
+		return coins
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		global   string
+		local    string
+		expected string
+	}{
+		{desc: "no chain-wide prices", global: "", local: "0.5stake", expected: "0.5stake"},
+		{desc: "no local prices", global: "1uOPT", local: "", expected: "1uOPT"},
+		{desc: "lower local price", global: "1uOPT", local: "0.1uOPT", expected: "1uOPT"},
+		{desc: "higher local price", global: "1uOPT", local: "2uOPT", expected: "2uOPT"},
+		{desc: "other local denom", global: "1uOPT", local: "0.1stake", expected: "1uOPT"},
+		{desc: "several denoms", global: "0.1stake,1uOPT", local: "0.5stake,0.5uOPT", expected: "0.5stake,1uOPT"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, prices(tc.expected), types.CombineMinGasPrices(prices(tc.global), prices(tc.local)))
+		})
+	}
+}
+
+func TestRequiredFees(t *testing.T) {
+	prices, err := sdk.ParseDecCoins("0.015stake,1uOPT")
+	require.NoError(t, err)
+
+	required := types.RequiredFees(prices, 1001)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 16), sdk.NewInt64Coin("uOPT", 1001)), required)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 16)).IsAnyGTE(required))
+	require.False(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("uOPT", 1000)).IsAnyGTE(required))
+	require.Empty(t, types.RequiredFees(nil, 1001))
+}
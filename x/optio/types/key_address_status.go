@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// AddressStatusKeyPrefix is the prefix to retrieve all AddressStatus
+	AddressStatusKeyPrefix = "AddressStatus/value/"
+)
+
+// AddressStatusKey returns the store key to retrieve a AddressStatus from the index fields
+func AddressStatusKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
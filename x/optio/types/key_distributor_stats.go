@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// DistributorStatsKeyPrefix is the prefix to retrieve all DistributorStats
+	DistributorStatsKeyPrefix = "DistributorStats/value/"
+)
+
+// DistributorStatsKey returns the store key to retrieve a DistributorStats from the index fields
+func DistributorStatsKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// FeeAllowanceGrantKeyPrefix is the prefix to retrieve all FeeAllowanceGrant
+	FeeAllowanceGrantKeyPrefix = "FeeAllowanceGrant/value/"
+)
+
+// FeeAllowanceGrantKey returns the store key to retrieve a FeeAllowanceGrant from the index fields
+func FeeAllowanceGrantKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
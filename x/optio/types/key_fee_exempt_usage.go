@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// FeeExemptUsageKeyPrefix is the prefix to retrieve all FeeExemptUsage
+	FeeExemptUsageKeyPrefix = "FeeExemptUsage/value/"
+)
+
+// FeeExemptUsageKey returns the store key to retrieve a FeeExemptUsage from the index fields
+func FeeExemptUsageKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// FeeSponsorKeyPrefix is the prefix to retrieve all FeeSponsor
+	FeeSponsorKeyPrefix = "FeeSponsor/value/"
+)
+
+// FeeSponsorKey returns the store key to retrieve a FeeSponsor from the index fields
+func FeeSponsorKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,38 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PacketOutcomeKeyPrefix is the prefix to retrieve all PacketOutcome
+	PacketOutcomeKeyPrefix = "PacketOutcome/value/"
+
+	// PacketOutcomePendingKeyPrefix is the prefix of the index of the pending
+	// PacketOutcome. The index shares the keys of PacketOutcomeKeyPrefix.
+	PacketOutcomePendingKeyPrefix = "PacketOutcome/pending/"
+)
+
+// PacketOutcomeKey returns the store key to retrieve a PacketOutcome from the index fields
+func PacketOutcomeKey(
+	portID string,
+	channelID string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	portIDBytes := []byte(portID)
+	key = append(key, portIDBytes...)
+	key = append(key, []byte("/")...)
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,45 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RateLimitKeyPrefix is the prefix to retrieve all RateLimit
+	RateLimitKeyPrefix = "RateLimit/value/"
+
+	// RateLimitPendingPacketKeyPrefix is the prefix to retrieve all RateLimitPendingPacket
+	RateLimitPendingPacketKeyPrefix = "RateLimit/pending/"
+)
+
+// RateLimitKey returns the store key to retrieve a RateLimit from the index fields
+func RateLimitKey(
+	channelID string,
+) []byte {
+	var key []byte
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RateLimitPendingPacketKey returns the store key to retrieve a RateLimitPendingPacket from the index fields
+func RateLimitPendingPacketKey(
+	channelID string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,29 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RemoteDistributionKeyPrefix is the prefix to retrieve all RemoteDistribution
+	RemoteDistributionKeyPrefix = "RemoteDistribution/value/"
+)
+
+// RemoteDistributionKey returns the store key to retrieve a RemoteDistribution from the index fields
+func RemoteDistributionKey(
+	channelID string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,29 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TransferPacketKeyPrefix is the prefix to retrieve all TransferPacket
+	TransferPacketKeyPrefix = "TransferPacket/value/"
+)
+
+// TransferPacketKey returns the store key to retrieve a TransferPacket from the index fields
+func TransferPacketKey(
+	channel string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	channelBytes := []byte(channel)
+	key = append(key, channelBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
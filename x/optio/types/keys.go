@@ -0,0 +1,49 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "optio"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_optio"
+
+	// Version defines the current version the IBC module supports
+	Version = "optio-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "optio"
+)
+
+var (
+	ParamsKey = []byte("p_optio")
+
+	// PortKey defines the key to store the port ID in store
+	PortKey = KeyPrefix("optio-port-")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	DistributionKey         = "Distribution/value/"
+	DistributionCountKey    = "Distribution/count/"
+	DistributionCategoryKey = "Distribution/category/"
+)
+
+var (
+	// FeeAllowanceUsageKey stores the FeeAllowanceUsage singleton
+	FeeAllowanceUsageKey = []byte("FeeAllowanceUsage/value/")
+
+	// SupplyRecordKey stores the SupplyRecord singleton
+	SupplyRecordKey = []byte("SupplyRecord/value/")
+
+	// FeeExemptBlockGasKey stores the FeeExemptBlockGas singleton
+	FeeExemptBlockGasKey = []byte("FeeExemptBlockGas/value/")
+
+	// BaseFeeKey stores the base fee of the next block
+	BaseFeeKey = []byte("BaseFee/value/")
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MemoHookKey is the key of the optio instructions in the memo of an ICS-20
+// transfer.
+const MemoHookKey = "optio"
+
+// MemoHookAddress receives the ICS-20 transfers carrying optio instructions
+// in their memo and pays them out to the listed recipients within the same
+// packet, so that it holds no funds in between.
+var MemoHookAddress = sdk.AccAddress(address.Module(ModuleName, []byte("memo-hook")))
+
+// MemoHook are the optio instructions of an ICS-20 transfer memo, e.g.
+// {"optio":{"distribute":[{"address":"optio1...","amount":"100"}]}}.
+type MemoHook struct {
+	Distribute []MemoHookRecipient `json:"distribute"`
+}
+
+// MemoHookRecipient is a recipient of a transfer fanned out by the memo hook.
+// The amount is given in the denom of the transfer.
+type MemoHookRecipient struct {
+	Address string      `json:"address"`
+	Amount  json.Number `json:"amount"`
+}
+
+// ParseMemoHook decodes the optio instructions of a transfer memo. It reports
+// false for memos that are not JSON objects or that carry no optio key, so
+// that they are left to the other middlewares.
+func ParseMemoHook(memo string) (MemoHook, bool, error) {
+	var hook MemoHook
+	if memo == "" {
+		return hook, false, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return hook, false, nil
+	}
+	raw, ok := fields[MemoHookKey]
+	if !ok {
+		return hook, false, nil
+	}
+	if err := json.Unmarshal(raw, &hook); err != nil {
+		return hook, true, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s memo: %s", MemoHookKey, err)
+	}
+	return hook, true, nil
+}
+
+// Validate checks the recipients of the hook and that their amounts add up
+// to the transferred amount.
+func (h MemoHook) Validate(amount math.Int, maxRecipients uint64) error {
+	if len(h.Distribute) == 0 {
+		return errorsmod.Wrap(ErrInvalidRecipients, "at least one recipient is required")
+	}
+	if maxRecipients > 0 && uint64(len(h.Distribute)) > maxRecipients {
+		return errorsmod.Wrapf(ErrTooManyRecipients, "%d recipients exceed the limit of %d", len(h.Distribute), maxRecipients)
+	}
+
+	total := math.ZeroInt()
+	for i, recipient := range h.Distribute {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "recipient %d: invalid address %s (%s)", i, recipient.Address, err)
+		}
+		recipientAmount, err := recipient.Int()
+		if err != nil || !recipientAmount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "recipient %d: invalid amount %s", i, recipient.Amount)
+		}
+		total = total.Add(recipientAmount)
+	}
+	if !total.Equal(amount) {
+		return errorsmod.Wrapf(ErrInvalidAmount, "recipient amounts add up to %s, transferred %s", total, amount)
+	}
+	return nil
+}
+
+// Addresses returns the addresses of the recipients.
+func (h MemoHook) Addresses() []string {
+	addresses := make([]string, len(h.Distribute))
+	for i, recipient := range h.Distribute {
+		addresses[i] = recipient.Address
+	}
+	return addresses
+}
+
+// Int returns the amount of the recipient.
+func (r MemoHookRecipient) Int() (math.Int, error) {
+	amount, ok := math.NewIntFromString(r.Amount.String())
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid amount %s", r.Amount)
+	}
+	return amount, nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+)
+
+func TestParseMemoHook(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	tests := []struct {
+		name       string
+		memo       string
+		recipients []MemoHookRecipient
+		hook       bool
+		err        error
+	}{
+		{name: "empty memo"},
+		{name: "plain text memo", memo: "thanks"},
+		{name: "other middleware", memo: `{"forward":{"receiver":"cosmos1..."}}`},
+		{
+			name:       "string and number amounts",
+			memo:       fmt.Sprintf(`{"optio":{"distribute":[{"address":"%s","amount":"60"},{"address":"%s","amount":40}]}}`, alice, bob),
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "60"}, {Address: bob, Amount: "40"}},
+			hook:       true,
+		},
+		{
+			name: "malformed instructions",
+			memo: `{"optio":{"distribute":{"address":"optio1..."}}}`,
+			hook: true,
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook, ok, err := ParseMemoHook(tt.memo)
+			require.Equal(t, tt.hook, ok)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.recipients, hook.Distribute)
+		})
+	}
+}
+
+func TestMemoHook_Validate(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	tests := []struct {
+		name       string
+		recipients []MemoHookRecipient
+		err        error
+	}{
+		{
+			name:       "valid",
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "60"}, {Address: bob, Amount: "40"}},
+		},
+		{
+			name: "no recipients",
+			err:  ErrInvalidRecipients,
+		},
+		{
+			name:       "too many recipients",
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "40"}, {Address: bob, Amount: "30"}, {Address: alice, Amount: "30"}},
+			err:        ErrTooManyRecipients,
+		},
+		{
+			name:       "invalid address",
+			recipients: []MemoHookRecipient{{Address: "cosmos1invalid", Amount: "100"}},
+			err:        ErrInvalidRecipients,
+		},
+		{
+			name:       "zero amount",
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "100"}, {Address: bob, Amount: "0"}},
+			err:        ErrInvalidAmount,
+		},
+		{
+			name:       "fractional amount",
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "99.5"}, {Address: bob, Amount: "0.5"}},
+			err:        ErrInvalidAmount,
+		},
+		{
+			name:       "amounts do not add up",
+			recipients: []MemoHookRecipient{{Address: alice, Amount: "60"}, {Address: bob, Amount: "30"}},
+			err:        ErrInvalidAmount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MemoHook{Distribute: tt.recipients}.Validate(math.NewInt(100), 2)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
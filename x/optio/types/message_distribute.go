@@ -0,0 +1,85 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDistribute{}
+
+// categoryRegex restricts categories to labels that are safe to use as store
+// index keys.
+var categoryRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+func NewMsgDistribute(fromAddress string, amount math.Int, recipients []*Recipient) *MsgDistribute {
+	return &MsgDistribute{
+		FromAddress: fromAddress,
+		Amount:      amount,
+		Recipients:  recipients,
+	}
+}
+
+func (msg *MsgDistribute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be positive: %s", msg.Amount)
+	}
+
+	if err := ValidateCategory(msg.Category); err != nil {
+		return err
+	}
+
+	if len(msg.Recipients) == 0 {
+		return errorsmod.Wrap(ErrInvalidRecipients, "at least one recipient is required")
+	}
+
+	total := math.ZeroInt()
+	for i, recipient := range msg.Recipients {
+		if recipient == nil {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "recipient %d is empty", i)
+		}
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+		total = total.Add(recipient.Amount)
+	}
+
+	if !total.Equal(msg.Amount) {
+		return errorsmod.Wrapf(ErrInvalidAmount, "recipient amounts sum to %s, expected %s", total, msg.Amount)
+	}
+
+	return nil
+}
+
+// ValidateCategory checks that a non-empty category is a plain label.
+func ValidateCategory(category string) error {
+	if category != "" && !categoryRegex.MatchString(category) {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "invalid category %q", category)
+	}
+	return nil
+}
+
+// ValidateMetadataLength checks the accounting metadata against the length
+// limits in params.
+func (msg *MsgDistribute) ValidateMetadataLength(params Params) error {
+	if uint64(len(msg.Category)) > params.MaxCategoryLength {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "category longer than %d bytes", params.MaxCategoryLength)
+	}
+	if uint64(len(msg.Memo)) > params.MaxMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "memo longer than %d bytes", params.MaxMemoLength)
+	}
+	for _, recipient := range msg.Recipients {
+		if uint64(len(recipient.Reference)) > params.MaxReferenceLength {
+			return errorsmod.Wrapf(ErrInvalidMetadata, "reference for recipient %s longer than %d bytes", recipient.Account(), params.MaxReferenceLength)
+		}
+	}
+	return nil
+}
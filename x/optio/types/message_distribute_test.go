@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioServices/optio/testutil/sample"
+)
+
+func TestMsgDistribute_ValidateBasic(t *testing.T) {
+	recipient := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgDistribute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDistribute{
+				FromAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgDistribute{
+				FromAddress: sample.AccAddress(),
+				Amount:      math.ZeroInt(),
+			},
+			err: ErrInvalidAmount,
+		}, {
+			name: "no recipients",
+			msg: MsgDistribute{
+				FromAddress: sample.AccAddress(),
+				Amount:      math.NewInt(10),
+			},
+			err: ErrInvalidRecipients,
+		}, {
+			name: "amount mismatch",
+			msg: MsgDistribute{
+				FromAddress: sample.AccAddress(),
+				Amount:      math.NewInt(10),
+				Recipients:  []*Recipient{{Address: recipient, Amount: math.NewInt(9)}},
+			},
+			err: ErrInvalidAmount,
+		}, {
+			name: "valid",
+			msg: MsgDistribute{
+				FromAddress: sample.AccAddress(),
+				Amount:      math.NewInt(10),
+				Recipients:  []*Recipient{{Address: recipient, Amount: math.NewInt(10)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterFeeSponsor{}
+	_ sdk.Msg = &MsgUnregisterFeeSponsor{}
+)
+
+func NewMsgRegisterFeeSponsor(sponsor string) *MsgRegisterFeeSponsor {
+	return &MsgRegisterFeeSponsor{Sponsor: sponsor}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterFeeSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgUnregisterFeeSponsor(sponsor string) *MsgUnregisterFeeSponsor {
+	return &MsgUnregisterFeeSponsor{Sponsor: sponsor}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUnregisterFeeSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address (%s)", err)
+	}
+	return nil
+}
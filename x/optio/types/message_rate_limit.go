@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+)
+
+func NewMsgSetRateLimit(authority, channelID string, quota RateLimitQuota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel %s: %s", m.ChannelId, err)
+	}
+	if err := m.Quota.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func NewMsgRemoveRateLimit(authority, channelID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel %s: %s", m.ChannelId, err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRemoteTreasury{}
+	_ sdk.Msg = &MsgRemoteDistribute{}
+)
+
+func NewMsgRegisterRemoteTreasury(fromAddress, connectionID, version string) *MsgRegisterRemoteTreasury {
+	return &MsgRegisterRemoteTreasury{
+		FromAddress:  fromAddress,
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterRemoteTreasury) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid connection %s (%s)", m.ConnectionId, err)
+	}
+	return nil
+}
+
+func NewMsgRemoteDistribute(fromAddress, connectionID string, outputs []banktypes.Output, memo string) *MsgRemoteDistribute {
+	return &MsgRemoteDistribute{
+		FromAddress:  fromAddress,
+		ConnectionId: connectionID,
+		Outputs:      outputs,
+		Memo:         memo,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data. The output
+// addresses belong to the host chain and are checked there.
+func (m *MsgRemoteDistribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid connection %s (%s)", m.ConnectionId, err)
+	}
+	if err := ValidateOutputs(m.Outputs); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipients, err.Error())
+	}
+	return nil
+}
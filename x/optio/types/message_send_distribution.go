@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgSendDistribution{}
+
+func NewMsgSendDistribution(fromAddress string, channelID string, amount math.Int, recipients []*Recipient) *MsgSendDistribution {
+	return &MsgSendDistribution{
+		FromAddress: fromAddress,
+		ChannelId:   channelID,
+		Amount:      amount,
+		Recipients:  recipients,
+	}
+}
+
+// ValidateBasic checks the distribution like a MsgDistribute. The host
+// chain runs the optio app as well, so recipient addresses share the
+// address prefix of this chain.
+func (msg *MsgSendDistribution) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel %s (%s)", msg.ChannelId, err)
+	}
+	return msg.PacketData().MsgDistribute(msg.FromAddress).ValidateBasic()
+}
+
+// PacketData returns the distribution packet sent to the host chain.
+func (msg *MsgSendDistribution) PacketData() DistributionPacketData {
+	return DistributionPacketData{
+		Sender:     msg.FromAddress,
+		Amount:     msg.Amount,
+		Recipients: msg.Recipients,
+		Category:   msg.Category,
+		Memo:       msg.Memo,
+	}
+}
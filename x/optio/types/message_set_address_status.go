@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetAddressStatus{}
+
+func NewMsgSetAddressStatus(fromAddress string, statuses []AddressStatus) *MsgSetAddressStatus {
+	return &MsgSetAddressStatus{
+		FromAddress: fromAddress,
+		Statuses:    statuses,
+	}
+}
+
+func (msg *MsgSetAddressStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress address (%s)", err)
+	}
+
+	if len(msg.Statuses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one status is required")
+	}
+
+	seen := make(map[string]struct{}, len(msg.Statuses))
+	for _, status := range msg.Statuses {
+		if err := ValidateAccount(status.Address); err != nil {
+			return err
+		}
+		if _, ok := ComplianceStatus_name[int32(status.Status)]; !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid status %d for %s", status.Status, status.Address)
+		}
+		if _, ok := seen[status.Address]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", status.Address)
+		}
+		seen[status.Address] = struct{}{}
+	}
+
+	return nil
+}
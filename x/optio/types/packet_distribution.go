@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RemoteDistributorAddress is the distributor recorded for the distributions
+// received over an optio channel. Its distributor stats track the usage of
+// the channel quota.
+func RemoteDistributorAddress(channelID string) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, []byte("remote/"+channelID)))
+}
+
+// ValidateBasic is used for validating the packet
+func (p DistributionPacketData) ValidateBasic() error {
+	if p.Sender == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing distribution packet sender")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p DistributionPacketData) GetBytes() ([]byte, error) {
+	var modulePacket OptioPacketData
+	modulePacket.Packet = &OptioPacketData_DistributionPacket{&p}
+	return modulePacket.Marshal()
+}
+
+// MsgDistribute returns the distribution of the packet on the host chain,
+// made by distributor.
+func (p DistributionPacketData) MsgDistribute(distributor string) *MsgDistribute {
+	msg := NewMsgDistribute(distributor, p.Amount, p.Recipients)
+	msg.Category = p.Category
+	msg.Memo = p.Memo
+	return msg
+}
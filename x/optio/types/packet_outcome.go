@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// CallbackAddress is the callback address the module sets on the packets it
+// sends. The IBC callbacks middleware reports their acknowledgement or
+// timeout to the module only for packets that name this address.
+var CallbackAddress = sdk.AccAddress(address.Module(ModuleName, []byte("callbacks")))
+
+// CallbackMemo returns the packet memo that requests the source callbacks of
+// the module.
+func CallbackMemo() string {
+	return fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, CallbackAddress)
+}
+
+// IsPending reports whether the packet still awaits its acknowledgement.
+func (o PacketOutcome) IsPending() bool {
+	return o.Status == PACKET_OUTCOME_STATUS_PENDING
+}
+
+// Validate checks that a packet outcome is well formed.
+func (o PacketOutcome) Validate() error {
+	if err := host.PortIdentifierValidator(o.PortId); err != nil {
+		return fmt.Errorf("invalid port %s: %w", o.PortId, err)
+	}
+	if err := host.ChannelIdentifierValidator(o.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel %s: %w", o.ChannelId, err)
+	}
+	if o.Sequence == 0 {
+		return fmt.Errorf("packet outcome on %s/%s has no sequence", o.PortId, o.ChannelId)
+	}
+	if _, ok := PacketOutcomeStatus_name[int32(o.Status)]; !ok || o.Status == PACKET_OUTCOME_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %s for packet outcome %s/%s/%d", o.Status, o.PortId, o.ChannelId, o.Sequence)
+	}
+	return nil
+}
@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/packet_outcome.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketOutcomeStatus is the lifecycle state of a packet sent by the module.
+type PacketOutcomeStatus int32
+
+const (
+	PACKET_OUTCOME_STATUS_UNSPECIFIED PacketOutcomeStatus = 0
+	// PACKET_OUTCOME_STATUS_PENDING means the packet has not been acknowledged
+	// yet.
+	PACKET_OUTCOME_STATUS_PENDING PacketOutcomeStatus = 1
+	// PACKET_OUTCOME_STATUS_ACKNOWLEDGED means the counterparty chain
+	// acknowledged the packet successfully.
+	PACKET_OUTCOME_STATUS_ACKNOWLEDGED PacketOutcomeStatus = 2
+	// PACKET_OUTCOME_STATUS_FAILED means the counterparty chain returned an
+	// error acknowledgement.
+	PACKET_OUTCOME_STATUS_FAILED PacketOutcomeStatus = 3
+	// PACKET_OUTCOME_STATUS_TIMEOUT means the packet timed out.
+	PACKET_OUTCOME_STATUS_TIMEOUT PacketOutcomeStatus = 4
+)
+
+var PacketOutcomeStatus_name = map[int32]string{
+	0: "PACKET_OUTCOME_STATUS_UNSPECIFIED",
+	1: "PACKET_OUTCOME_STATUS_PENDING",
+	2: "PACKET_OUTCOME_STATUS_ACKNOWLEDGED",
+	3: "PACKET_OUTCOME_STATUS_FAILED",
+	4: "PACKET_OUTCOME_STATUS_TIMEOUT",
+}
+
+var PacketOutcomeStatus_value = map[string]int32{
+	"PACKET_OUTCOME_STATUS_UNSPECIFIED":  0,
+	"PACKET_OUTCOME_STATUS_PENDING":      1,
+	"PACKET_OUTCOME_STATUS_ACKNOWLEDGED": 2,
+	"PACKET_OUTCOME_STATUS_FAILED":       3,
+	"PACKET_OUTCOME_STATUS_TIMEOUT":      4,
+}
+
+func (x PacketOutcomeStatus) String() string {
+	return proto.EnumName(PacketOutcomeStatus_name, int32(x))
+}
+
+func (PacketOutcomeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d77b389aa2dfcb4, []int{0}
+}
+
+// PacketOutcome is the lifecycle of a cross-chain payout sent by the module,
+// an ICS-20 transfer to a cross-chain recipient or the multi-send of a remote
+// treasury. It is updated by the IBC callbacks of the packet.
+type PacketOutcome struct {
+	PortId    string              `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string              `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64              `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status    PacketOutcomeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=optio.optio.PacketOutcomeStatus" json:"status,omitempty"`
+	// error is the error acknowledgement of a failed packet.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// send_height is the block height the packet was sent at.
+	SendHeight int64 `protobuf:"varint,6,opt,name=send_height,json=sendHeight,proto3" json:"send_height,omitempty"`
+	// height is the block height of the latest status change.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PacketOutcome) Reset()         { *m = PacketOutcome{} }
+func (m *PacketOutcome) String() string { return proto.CompactTextString(m) }
+func (*PacketOutcome) ProtoMessage()    {}
+func (*PacketOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d77b389aa2dfcb4, []int{0}
+}
+func (m *PacketOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketOutcome.Merge(m, src)
+}
+func (m *PacketOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketOutcome proto.InternalMessageInfo
+
+func (m *PacketOutcome) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketOutcome) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketOutcome) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketOutcome) GetStatus() PacketOutcomeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_OUTCOME_STATUS_UNSPECIFIED
+}
+
+func (m *PacketOutcome) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PacketOutcome) GetSendHeight() int64 {
+	if m != nil {
+		return m.SendHeight
+	}
+	return 0
+}
+
+func (m *PacketOutcome) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("optio.optio.PacketOutcomeStatus", PacketOutcomeStatus_name, PacketOutcomeStatus_value)
+	proto.RegisterType((*PacketOutcome)(nil), "optio.optio.PacketOutcome")
+}
+
+func init() { proto.RegisterFile("optio/optio/packet_outcome.proto", fileDescriptor_3d77b389aa2dfcb4) }
+
+var fileDescriptor_3d77b389aa2dfcb4 = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x5f, 0x8b, 0x94, 0x50,
+	0x18, 0xc6, 0x3d, 0x3b, 0xb3, 0x6e, 0xfb, 0x2e, 0x85, 0x9c, 0x96, 0x92, 0xa1, 0x35, 0x77, 0xa1,
+	0x18, 0x22, 0x1c, 0xa8, 0x9b, 0x6e, 0x27, 0x3d, 0xb3, 0xc9, 0xee, 0xaa, 0xf8, 0x87, 0xa0, 0x1b,
+	0x99, 0xd5, 0x83, 0x4a, 0xad, 0xc7, 0xf4, 0x18, 0xf5, 0x0d, 0xba, 0xec, 0x3b, 0xf4, 0x65, 0xba,
+	0x1c, 0xba, 0xea, 0x32, 0x66, 0x6e, 0xfb, 0x10, 0xe1, 0x51, 0xa2, 0xc0, 0x6e, 0x5e, 0x7d, 0x9e,
+	0xe7, 0xf7, 0xf2, 0x88, 0xbc, 0xa0, 0xb3, 0x8a, 0x17, 0x6c, 0xd1, 0xcf, 0x6a, 0x9d, 0xbc, 0xa5,
+	0x3c, 0x66, 0x2d, 0x4f, 0xd8, 0x0d, 0x35, 0xaa, 0x9a, 0x71, 0x86, 0x8f, 0x44, 0x66, 0x88, 0x39,
+	0x3b, 0xce, 0x58, 0xc6, 0x84, 0xbf, 0xe8, 0xde, 0x7a, 0xe4, 0xec, 0x17, 0x82, 0xdb, 0x9e, 0xd8,
+	0x75, 0xfb, 0x55, 0x7c, 0x1f, 0x0e, 0x2a, 0x56, 0xf3, 0xb8, 0x48, 0x55, 0xa4, 0xa3, 0xf9, 0xa1,
+	0x2f, 0x77, 0xd2, 0x4e, 0xf1, 0x09, 0x40, 0x92, 0xaf, 0xcb, 0x92, 0xbe, 0xeb, 0xb2, 0x3d, 0x91,
+	0x1d, 0x0e, 0x8e, 0x9d, 0xe2, 0x19, 0xdc, 0x6a, 0xe8, 0xfb, 0x96, 0x96, 0x09, 0x55, 0x27, 0x3a,
+	0x9a, 0x4f, 0xfd, 0x3f, 0x1a, 0xbf, 0x00, 0xb9, 0xe1, 0x6b, 0xde, 0x36, 0xea, 0x54, 0x47, 0xf3,
+	0x3b, 0xcf, 0x74, 0xe3, 0xaf, 0x2f, 0x33, 0xfe, 0xe9, 0x0f, 0x04, 0xe7, 0x0f, 0x3c, 0x3e, 0x86,
+	0x7d, 0x5a, 0xd7, 0xac, 0x56, 0xf7, 0x45, 0x5f, 0x2f, 0xf0, 0x43, 0x38, 0x6a, 0x68, 0x99, 0xc6,
+	0x39, 0x2d, 0xb2, 0x9c, 0xab, 0xb2, 0x8e, 0xe6, 0x13, 0x1f, 0x3a, 0xeb, 0x95, 0x70, 0xf0, 0x3d,
+	0x90, 0x87, 0xec, 0x40, 0x64, 0x83, 0x7a, 0xf2, 0x1d, 0xc1, 0xdd, 0x91, 0x3a, 0xfc, 0x08, 0x4e,
+	0xbd, 0xa5, 0x79, 0x41, 0xc2, 0xd8, 0x8d, 0x42, 0xd3, 0xbd, 0x22, 0x71, 0x10, 0x2e, 0xc3, 0x28,
+	0x88, 0x23, 0x27, 0xf0, 0x88, 0x69, 0xaf, 0x6c, 0x62, 0x29, 0x12, 0x3e, 0x85, 0x93, 0x71, 0xcc,
+	0x23, 0x8e, 0x65, 0x3b, 0xe7, 0x0a, 0xc2, 0x8f, 0xe1, 0x6c, 0x1c, 0x59, 0x9a, 0x17, 0x8e, 0xfb,
+	0xfa, 0x92, 0x58, 0xe7, 0xc4, 0x52, 0xf6, 0xb0, 0x0e, 0x0f, 0xc6, 0xb9, 0xd5, 0xd2, 0xbe, 0x24,
+	0x96, 0x32, 0xf9, 0x7f, 0x59, 0x68, 0x5f, 0x11, 0x37, 0x0a, 0x95, 0xe9, 0x6c, 0xfa, 0xf9, 0xab,
+	0x26, 0xbd, 0x5c, 0x7d, 0xdb, 0x6a, 0x68, 0xb3, 0xd5, 0xd0, 0xcf, 0xad, 0x86, 0xbe, 0xec, 0x34,
+	0x69, 0xb3, 0xd3, 0xa4, 0x1f, 0x3b, 0x4d, 0x7a, 0xf3, 0x34, 0x2b, 0x78, 0xde, 0x5e, 0x1b, 0x09,
+	0xbb, 0x59, 0xb8, 0xdd, 0xbf, 0x0e, 0x68, 0xfd, 0xa1, 0x48, 0x68, 0x33, 0x5c, 0xcd, 0xc7, 0xe1,
+	0xc9, 0x3f, 0x55, 0xb4, 0xb9, 0x96, 0xc5, 0x49, 0x3c, 0xff, 0x3d, 0x00, 0xb1, 0x39, 0xae, 0xc3,
+	0x59, 0x02, 0x00, 0x00,
+}
+
+func (m *PacketOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SendHeight != 0 {
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(m.SendHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPacketOutcome(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketOutcome(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketOutcome(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPacketOutcome(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketOutcome(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacketOutcome(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacketOutcome(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacketOutcome(uint64(l))
+	}
+	if m.SendHeight != 0 {
+		n += 1 + sovPacketOutcome(uint64(m.SendHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacketOutcome(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPacketOutcome(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketOutcome(x uint64) (n int) {
+	return sovPacketOutcome(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketOutcome
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketOutcomeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHeight", wireType)
+			}
+			m.SendHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketOutcome(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketOutcome
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketOutcome(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketOutcome
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketOutcome
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketOutcome
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketOutcome
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketOutcome
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketOutcome        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketOutcome          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketOutcome = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,776 @@
+package types
+
+import (
+	"fmt"
+	stdmath "math"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyAuthorizedAccounts = []byte("AuthorizedAccounts")
+	// DefaultAuthorizedAccounts is empty, distribution rights are granted by governance.
+	DefaultAuthorizedAccounts []string
+)
+
+var (
+	KeyDenom = []byte("Denom")
+	// DefaultDenom is the base denomination of OPT.
+	DefaultDenom = "uOPT"
+)
+
+var (
+	KeyMaxSupply = []byte("MaxSupply")
+	// DefaultMaxSupply is one billion OPT expressed in uOPT.
+	DefaultMaxSupply = math.NewInt(1_000_000_000_000_000)
+)
+
+var (
+	KeyMaxReferenceLength = []byte("MaxReferenceLength")
+	// DefaultMaxReferenceLength fits common invoice and PO numbers.
+	DefaultMaxReferenceLength uint64 = 64
+)
+
+var (
+	KeyMaxCategoryLength = []byte("MaxCategoryLength")
+	// DefaultMaxCategoryLength is the default limit for distribution categories.
+	DefaultMaxCategoryLength uint64 = 32
+)
+
+var (
+	KeyMaxMemoLength = []byte("MaxMemoLength")
+	// DefaultMaxMemoLength is the default limit for distribution memos.
+	DefaultMaxMemoLength uint64 = 256
+)
+
+var (
+	KeyComplianceAccounts = []byte("ComplianceAccounts")
+	// DefaultComplianceAccounts is empty, the compliance role is granted by governance.
+	DefaultComplianceAccounts []string
+)
+
+var (
+	KeyAllowlistEnabled = []byte("AllowlistEnabled")
+	// DefaultAllowlistEnabled only enforces the denylist.
+	DefaultAllowlistEnabled = false
+)
+
+var (
+	KeyMaxRecipientsPerMsg = []byte("MaxRecipientsPerMsg")
+	// DefaultMaxRecipientsPerMsg keeps a single MsgDistribute well within block limits.
+	DefaultMaxRecipientsPerMsg uint64 = 1000
+)
+
+var (
+	KeyGasPerRecipient = []byte("GasPerRecipient")
+	// DefaultGasPerRecipient approximates the cost of a mint share and a bank transfer.
+	DefaultGasPerRecipient uint64 = 20000
+)
+
+var (
+	KeyFeeAllowanceSpendLimit = []byte("FeeAllowanceSpendLimit")
+	// DefaultFeeAllowanceSpendLimit is empty, fee allowances are opt-in.
+	DefaultFeeAllowanceSpendLimit sdk.Coins
+)
+
+var (
+	KeyFeeAllowanceDuration = []byte("FeeAllowanceDuration")
+	// DefaultFeeAllowanceDuration gives recipients a month to use their allowance.
+	DefaultFeeAllowanceDuration = 30 * 24 * time.Hour
+)
+
+var (
+	KeyFeeAllowanceSponsor = []byte("FeeAllowanceSponsor")
+	// DefaultFeeAllowanceSponsor is empty, FeeAllowanceAddress pays.
+	DefaultFeeAllowanceSponsor = ""
+)
+
+var (
+	KeyFeeAllowanceBudget = []byte("FeeAllowanceBudget")
+	// DefaultFeeAllowanceBudget is empty, no allowance can be granted until
+	// governance sets a budget.
+	DefaultFeeAllowanceBudget sdk.Coins
+)
+
+var (
+	KeyTransferTimeout = []byte("TransferTimeout")
+	// DefaultTransferTimeout leaves relayers ample time to deliver transfers
+	// to cross-chain recipients.
+	DefaultTransferTimeout = 10 * time.Minute
+)
+
+var (
+	KeyRemoteControllers = []byte("RemoteControllers")
+	// DefaultRemoteControllers is empty, controller chains are approved by
+	// governance once their channel is open.
+	DefaultRemoteControllers []RemoteController
+)
+
+var (
+	KeyFeeExemptBlockGas = []byte("FeeExemptBlockGas")
+	// DefaultFeeExemptBlockGas is zero, fee exemptions are opt-in.
+	DefaultFeeExemptBlockGas uint64
+)
+
+var (
+	KeyFeeExemptTxsPerWindow = []byte("FeeExemptTxsPerWindow")
+	// DefaultFeeExemptTxsPerWindow lets an authorized account send about one
+	// fee exempt tx a minute.
+	DefaultFeeExemptTxsPerWindow uint64 = 60
+)
+
+var (
+	KeyFeeExemptWindow = []byte("FeeExemptWindow")
+	// DefaultFeeExemptWindow resets the fee exempt txs of an account hourly.
+	DefaultFeeExemptWindow = time.Hour
+)
+
+var (
+	KeyMinimumGasPrices = []byte("MinimumGasPrices")
+	// DefaultMinimumGasPrices charges 1uOPT per unit of gas, the price the
+	// nodes of the network are configured with.
+	DefaultMinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin(DefaultDenom, math.OneInt()))
+)
+
+var (
+	KeyBaseFeeTargetGas = []byte("BaseFeeTargetGas")
+	// DefaultBaseFeeTargetGas is zero, the base fee is opt-in.
+	DefaultBaseFeeTargetGas uint64
+)
+
+var (
+	KeyBaseFeeMaxChangeRate = []byte("BaseFeeMaxChangeRate")
+	// DefaultBaseFeeMaxChangeRate is the 12.5% of EIP-1559.
+	DefaultBaseFeeMaxChangeRate = math.LegacyNewDecWithPrec(125, 3)
+)
+
+var (
+	KeyMinBaseFee = []byte("MinBaseFee")
+	// DefaultMinBaseFee matches the default minimum gas prices.
+	DefaultMinBaseFee = math.LegacyOneDec()
+)
+
+var (
+	KeyMaxBaseFee = []byte("MaxBaseFee")
+	// DefaultMaxBaseFee is zero, the base fee is not capped.
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+)
+
+var (
+	KeyBaseFeeBurnRate = []byte("BaseFeeBurnRate")
+	// DefaultBaseFeeBurnRate burns the whole base fee like EIP-1559, the
+	// fee collector receives what a tx pays above it.
+	DefaultBaseFeeBurnRate = math.LegacyOneDec()
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	authorizedAccounts []string,
+	denom string,
+	maxSupply math.Int,
+	maxReferenceLength uint64,
+	maxCategoryLength uint64,
+	maxMemoLength uint64,
+	complianceAccounts []string,
+	allowlistEnabled bool,
+	maxRecipientsPerMsg uint64,
+	gasPerRecipient uint64,
+	feeAllowanceSpendLimit sdk.Coins,
+	feeAllowanceDuration time.Duration,
+	feeAllowanceSponsor string,
+	feeAllowanceBudget sdk.Coins,
+	transferTimeout time.Duration,
+	remoteControllers []RemoteController,
+	feeExemptBlockGas uint64,
+	feeExemptTxsPerWindow uint64,
+	feeExemptWindow time.Duration,
+	minimumGasPrices sdk.DecCoins,
+	baseFeeTargetGas uint64,
+	baseFeeMaxChangeRate math.LegacyDec,
+	minBaseFee math.LegacyDec,
+	maxBaseFee math.LegacyDec,
+	baseFeeBurnRate math.LegacyDec,
+) Params {
+	return Params{
+		AuthorizedAccounts:     authorizedAccounts,
+		Denom:                  denom,
+		MaxSupply:              maxSupply,
+		MaxReferenceLength:     maxReferenceLength,
+		MaxCategoryLength:      maxCategoryLength,
+		MaxMemoLength:          maxMemoLength,
+		ComplianceAccounts:     complianceAccounts,
+		AllowlistEnabled:       allowlistEnabled,
+		MaxRecipientsPerMsg:    maxRecipientsPerMsg,
+		GasPerRecipient:        gasPerRecipient,
+		FeeAllowanceSpendLimit: feeAllowanceSpendLimit,
+		FeeAllowanceDuration:   feeAllowanceDuration,
+		FeeAllowanceSponsor:    feeAllowanceSponsor,
+		FeeAllowanceBudget:     feeAllowanceBudget,
+		TransferTimeout:        transferTimeout,
+		RemoteControllers:      remoteControllers,
+		FeeExemptBlockGas:      feeExemptBlockGas,
+		FeeExemptTxsPerWindow:  feeExemptTxsPerWindow,
+		FeeExemptWindow:        feeExemptWindow,
+		MinimumGasPrices:       minimumGasPrices,
+		BaseFeeTargetGas:       baseFeeTargetGas,
+		BaseFeeMaxChangeRate:   baseFeeMaxChangeRate,
+		MinBaseFee:             minBaseFee,
+		MaxBaseFee:             maxBaseFee,
+		BaseFeeBurnRate:        baseFeeBurnRate,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultAuthorizedAccounts,
+		DefaultDenom,
+		DefaultMaxSupply,
+		DefaultMaxReferenceLength,
+		DefaultMaxCategoryLength,
+		DefaultMaxMemoLength,
+		DefaultComplianceAccounts,
+		DefaultAllowlistEnabled,
+		DefaultMaxRecipientsPerMsg,
+		DefaultGasPerRecipient,
+		DefaultFeeAllowanceSpendLimit,
+		DefaultFeeAllowanceDuration,
+		DefaultFeeAllowanceSponsor,
+		DefaultFeeAllowanceBudget,
+		DefaultTransferTimeout,
+		DefaultRemoteControllers,
+		DefaultFeeExemptBlockGas,
+		DefaultFeeExemptTxsPerWindow,
+		DefaultFeeExemptWindow,
+		DefaultMinimumGasPrices,
+		DefaultBaseFeeTargetGas,
+		DefaultBaseFeeMaxChangeRate,
+		DefaultMinBaseFee,
+		DefaultMaxBaseFee,
+		DefaultBaseFeeBurnRate,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuthorizedAccounts, &p.AuthorizedAccounts, validateAuthorizedAccounts),
+		paramtypes.NewParamSetPair(KeyDenom, &p.Denom, validateDenom),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyMaxReferenceLength, &p.MaxReferenceLength, validateMaxLength),
+		paramtypes.NewParamSetPair(KeyMaxCategoryLength, &p.MaxCategoryLength, validateMaxLength),
+		paramtypes.NewParamSetPair(KeyMaxMemoLength, &p.MaxMemoLength, validateMaxLength),
+		paramtypes.NewParamSetPair(KeyComplianceAccounts, &p.ComplianceAccounts, validateComplianceAccounts),
+		paramtypes.NewParamSetPair(KeyAllowlistEnabled, &p.AllowlistEnabled, validateAllowlistEnabled),
+		paramtypes.NewParamSetPair(KeyMaxRecipientsPerMsg, &p.MaxRecipientsPerMsg, validateMaxRecipientsPerMsg),
+		paramtypes.NewParamSetPair(KeyGasPerRecipient, &p.GasPerRecipient, validateGasPerRecipient),
+		paramtypes.NewParamSetPair(KeyFeeAllowanceSpendLimit, &p.FeeAllowanceSpendLimit, validateFeeAllowanceCoins),
+		paramtypes.NewParamSetPair(KeyFeeAllowanceDuration, &p.FeeAllowanceDuration, validateFeeAllowanceDuration),
+		paramtypes.NewParamSetPair(KeyFeeAllowanceSponsor, &p.FeeAllowanceSponsor, validateFeeAllowanceSponsor),
+		paramtypes.NewParamSetPair(KeyFeeAllowanceBudget, &p.FeeAllowanceBudget, validateFeeAllowanceCoins),
+		paramtypes.NewParamSetPair(KeyTransferTimeout, &p.TransferTimeout, validateTransferTimeout),
+		paramtypes.NewParamSetPair(KeyRemoteControllers, &p.RemoteControllers, validateRemoteControllers),
+		paramtypes.NewParamSetPair(KeyFeeExemptBlockGas, &p.FeeExemptBlockGas, validateFeeExemptBlockGas),
+		paramtypes.NewParamSetPair(KeyFeeExemptTxsPerWindow, &p.FeeExemptTxsPerWindow, validateFeeExemptTxsPerWindow),
+		paramtypes.NewParamSetPair(KeyFeeExemptWindow, &p.FeeExemptWindow, validateFeeExemptWindow),
+		paramtypes.NewParamSetPair(KeyMinimumGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+		paramtypes.NewParamSetPair(KeyBaseFeeTargetGas, &p.BaseFeeTargetGas, validateBaseFeeTargetGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeMaxChangeRate, &p.BaseFeeMaxChangeRate, validateRate),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeBurnRate, &p.BaseFeeBurnRate, validateRate),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateAuthorizedAccounts(p.AuthorizedAccounts); err != nil {
+		return err
+	}
+
+	if err := validateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength(p.MaxReferenceLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength(p.MaxCategoryLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength(p.MaxMemoLength); err != nil {
+		return err
+	}
+
+	if err := validateComplianceAccounts(p.ComplianceAccounts); err != nil {
+		return err
+	}
+
+	if err := validateAllowlistEnabled(p.AllowlistEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxRecipientsPerMsg(p.MaxRecipientsPerMsg); err != nil {
+		return err
+	}
+
+	if err := validateGasPerRecipient(p.GasPerRecipient); err != nil {
+		return err
+	}
+
+	if err := validateFeeAllowanceCoins(p.FeeAllowanceSpendLimit); err != nil {
+		return err
+	}
+
+	if err := validateFeeAllowanceDuration(p.FeeAllowanceDuration); err != nil {
+		return err
+	}
+
+	if err := validateFeeAllowanceSponsor(p.FeeAllowanceSponsor); err != nil {
+		return err
+	}
+
+	if err := validateFeeAllowanceCoins(p.FeeAllowanceBudget); err != nil {
+		return err
+	}
+
+	if err := validateTransferTimeout(p.TransferTimeout); err != nil {
+		return err
+	}
+
+	if err := validateRemoteControllers(p.RemoteControllers); err != nil {
+		return err
+	}
+
+	if err := validateFeeExemptBlockGas(p.FeeExemptBlockGas); err != nil {
+		return err
+	}
+
+	if err := validateFeeExemptTxsPerWindow(p.FeeExemptTxsPerWindow); err != nil {
+		return err
+	}
+
+	if err := validateFeeExemptWindow(p.FeeExemptWindow); err != nil {
+		return err
+	}
+
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeTargetGas(p.BaseFeeTargetGas); err != nil {
+		return err
+	}
+
+	if err := validateRate(p.BaseFeeMaxChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateRate(p.BaseFeeBurnRate); err != nil {
+		return err
+	}
+
+	if !p.FeeAllowanceSpendLimit.Empty() && p.FeeAllowanceDuration <= 0 {
+		return fmt.Errorf("fee allowance duration must be positive when fee allowances are enabled")
+	}
+
+	if p.FeeExemptionsEnabled() && p.FeeExemptWindow <= 0 {
+		return fmt.Errorf("fee exempt window must be positive when fee exemptions are enabled")
+	}
+
+	// a zero base fee could never rise again
+	if p.BaseFeeEnabled() && !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive when the base fee is enabled")
+	}
+
+	if p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee %s is lower than min base fee %s", p.MaxBaseFee, p.MinBaseFee)
+	}
+
+	return nil
+}
+
+// IsAuthorized reports whether the given address may distribute tokens.
+func (p Params) IsAuthorized(address string) bool {
+	return containsAddress(p.AuthorizedAccounts, address)
+}
+
+// IsComplianceAccount reports whether the given address may manage address statuses.
+func (p Params) IsComplianceAccount(address string) bool {
+	return containsAddress(p.ComplianceAccounts, address)
+}
+
+// FeeAllowancesEnabled reports whether first-time recipients receive a fee
+// allowance.
+func (p Params) FeeAllowancesEnabled() bool {
+	return !p.FeeAllowanceSpendLimit.Empty()
+}
+
+// FeeExemptionsEnabled reports whether txs of authorized accounts may be
+// exempt from minimum gas prices.
+func (p Params) FeeExemptionsEnabled() bool {
+	return p.FeeExemptBlockGas > 0
+}
+
+// BaseFeeEnabled reports whether txs must pay the base fee.
+func (p Params) BaseFeeEnabled() bool {
+	return p.BaseFeeTargetGas > 0
+}
+
+// CrossChainEnabled reports whether distributions may pay cross-chain
+// recipients or be sent to host chains.
+func (p Params) CrossChainEnabled() bool {
+	return p.TransferTimeout > 0
+}
+
+// RemoteController returns the controller approved on the given channel of
+// the optio port.
+func (p Params) RemoteController(channelID string) (RemoteController, bool) {
+	for _, controller := range p.RemoteControllers {
+		if controller.ChannelId == channelID {
+			return controller, true
+		}
+	}
+	return RemoteController{}, false
+}
+
+// DistributeGas returns the gas charged for distributing to n recipients.
+func (p Params) DistributeGas(n uint64) (uint64, error) {
+	if p.GasPerRecipient != 0 && n > stdmath.MaxUint64/p.GasPerRecipient {
+		return 0, fmt.Errorf("gas for %d recipients overflows", n)
+	}
+	return n * p.GasPerRecipient, nil
+}
+
+// NumDistributeMsgs returns the minimum number of MsgDistribute needed to pay
+// n recipients without exceeding MaxRecipientsPerMsg.
+func (p Params) NumDistributeMsgs(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	if p.MaxRecipientsPerMsg == 0 {
+		return 1
+	}
+	return (n + p.MaxRecipientsPerMsg - 1) / p.MaxRecipientsPerMsg
+}
+
+// Roles of the accounts listed in Params.
+const (
+	RoleDistributor = "distributor"
+	RoleCompliance  = "compliance"
+)
+
+// Roles returns the roles Params grants to address.
+func (p Params) Roles(address string) []string {
+	var roles []string
+	if p.IsAuthorized(address) {
+		roles = append(roles, RoleDistributor)
+	}
+	if p.IsComplianceAccount(address) {
+		roles = append(roles, RoleCompliance)
+	}
+	return roles
+}
+
+// Reasons returned by RejectionReason.
+const (
+	RejectedDenied         = "denied"
+	RejectedNotAllowlisted = "not allowlisted"
+)
+
+// RejectionReason returns why the compliance policy rejects a recipient with
+// the given status, or an empty string if the recipient is accepted.
+func (p Params) RejectionReason(status ComplianceStatus) string {
+	switch {
+	case status == COMPLIANCE_STATUS_DENIED:
+		return RejectedDenied
+	case p.AllowlistEnabled && status != COMPLIANCE_STATUS_ALLOWED:
+		return RejectedNotAllowlisted
+	}
+	return ""
+}
+
+func containsAddress(accounts []string, address string) bool {
+	for _, account := range accounts {
+		if account == address {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuthorizedAccounts validates the AuthorizedAccounts param
+func validateAuthorizedAccounts(v interface{}) error {
+	return validateAccounts("authorized", v)
+}
+
+// validateComplianceAccounts validates the ComplianceAccounts param
+func validateComplianceAccounts(v interface{}) error {
+	return validateAccounts("compliance", v)
+}
+
+func validateAccounts(role string, v interface{}) error {
+	accounts, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return fmt.Errorf("invalid %s account %s: %w", role, account, err)
+		}
+		if _, ok := seen[account]; ok {
+			return fmt.Errorf("duplicate %s account %s", role, account)
+		}
+		seen[account] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateAllowlistEnabled validates the AllowlistEnabled param
+func validateAllowlistEnabled(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDenom validates the Denom param
+func validateDenom(v interface{}) error {
+	denom, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return sdk.ValidateDenom(denom)
+}
+
+// validateMaxSupply validates the MaxSupply param
+func validateMaxSupply(v interface{}) error {
+	maxSupply, ok := v.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxSupply.IsNil() || maxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be non-negative: %s", maxSupply)
+	}
+
+	return nil
+}
+
+// validateMaxLength validates the metadata length limit params. A limit of
+// zero disables the corresponding field.
+func validateMaxLength(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxRecipientsPerMsg validates the MaxRecipientsPerMsg param
+func validateMaxRecipientsPerMsg(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateGasPerRecipient validates the GasPerRecipient param
+func validateGasPerRecipient(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateFeeAllowanceCoins validates the FeeAllowanceSpendLimit and FeeAllowanceBudget params
+func validateFeeAllowanceCoins(v interface{}) error {
+	coins, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return coins.Validate()
+}
+
+// validateFeeAllowanceDuration validates the FeeAllowanceDuration param
+func validateFeeAllowanceDuration(v interface{}) error {
+	duration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("fee allowance duration cannot be negative: %s", duration)
+	}
+
+	return nil
+}
+
+// validateFeeAllowanceSponsor validates the FeeAllowanceSponsor param
+func validateFeeAllowanceSponsor(v interface{}) error {
+	sponsor, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if sponsor == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sponsor); err != nil {
+		return fmt.Errorf("invalid fee allowance sponsor %s: %w", sponsor, err)
+	}
+
+	return nil
+}
+
+// validateTransferTimeout validates the TransferTimeout param
+func validateTransferTimeout(v interface{}) error {
+	timeout, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if timeout < 0 {
+		return fmt.Errorf("transfer timeout cannot be negative: %s", timeout)
+	}
+
+	return nil
+}
+
+// validateRemoteControllers validates the RemoteControllers param
+func validateRemoteControllers(v interface{}) error {
+	controllers, ok := v.([]RemoteController)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool)
+	for _, controller := range controllers {
+		if err := host.ChannelIdentifierValidator(controller.ChannelId); err != nil {
+			return fmt.Errorf("invalid remote controller channel %s: %w", controller.ChannelId, err)
+		}
+		if seen[controller.ChannelId] {
+			return fmt.Errorf("duplicate remote controller channel %s", controller.ChannelId)
+		}
+		seen[controller.ChannelId] = true
+
+		if controller.Quota.IsNil() || controller.Quota.IsNegative() {
+			return fmt.Errorf("remote controller quota cannot be negative: %s", controller.Quota)
+		}
+	}
+
+	return nil
+}
+
+// validateFeeExemptBlockGas validates the FeeExemptBlockGas param
+func validateFeeExemptBlockGas(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateFeeExemptTxsPerWindow validates the FeeExemptTxsPerWindow param
+func validateFeeExemptTxsPerWindow(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateFeeExemptWindow validates the FeeExemptWindow param
+func validateFeeExemptWindow(v interface{}) error {
+	window, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window < 0 {
+		return fmt.Errorf("fee exempt window cannot be negative: %s", window)
+	}
+
+	return nil
+}
+
+// validateMinimumGasPrices validates the MinimumGasPrices param
+func validateMinimumGasPrices(v interface{}) error {
+	prices, ok := v.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return prices.Validate()
+}
+
+// validateBaseFeeTargetGas validates the BaseFeeTargetGas param
+func validateBaseFeeTargetGas(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRate validates the BaseFeeMaxChangeRate and BaseFeeBurnRate params
+func validateRate(v interface{}) error {
+	rate, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if rate.IsNil() || rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("rate must be between 0 and 1: %s", rate)
+	}
+
+	return nil
+}
+
+// validateBaseFee validates the MinBaseFee and MaxBaseFee params
+func validateBaseFee(v interface{}) error {
+	fee, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fee.IsNil() || fee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", fee)
+	}
+
+	return nil
+}
@@ -0,0 +1,1940 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	AuthorizedAccounts []string              `protobuf:"bytes,1,rep,name=authorizedAccounts,proto3" json:"authorizedAccounts,omitempty" yaml:"authorized_accounts"`
+	Denom              string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	MaxSupply          cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"maxSupply" yaml:"max_supply"`
+	MaxReferenceLength uint64                `protobuf:"varint,4,opt,name=maxReferenceLength,proto3" json:"maxReferenceLength,omitempty" yaml:"max_reference_length"`
+	MaxCategoryLength  uint64                `protobuf:"varint,5,opt,name=maxCategoryLength,proto3" json:"maxCategoryLength,omitempty" yaml:"max_category_length"`
+	MaxMemoLength      uint64                `protobuf:"varint,6,opt,name=maxMemoLength,proto3" json:"maxMemoLength,omitempty" yaml:"max_memo_length"`
+	// complianceAccounts may update address statuses via MsgSetAddressStatus.
+	ComplianceAccounts []string `protobuf:"bytes,7,rep,name=complianceAccounts,proto3" json:"complianceAccounts,omitempty" yaml:"compliance_accounts"`
+	// allowlistEnabled restricts recipients to addresses marked as allowed.
+	AllowlistEnabled bool `protobuf:"varint,8,opt,name=allowlistEnabled,proto3" json:"allowlistEnabled,omitempty" yaml:"allowlist_enabled"`
+	// maxRecipientsPerMsg caps the recipients of a single MsgDistribute, zero disables the cap.
+	MaxRecipientsPerMsg uint64 `protobuf:"varint,9,opt,name=maxRecipientsPerMsg,proto3" json:"maxRecipientsPerMsg,omitempty" yaml:"max_recipients_per_msg"`
+	// gasPerRecipient is charged for every recipient of a MsgDistribute.
+	GasPerRecipient uint64 `protobuf:"varint,10,opt,name=gasPerRecipient,proto3" json:"gasPerRecipient,omitempty" yaml:"gas_per_recipient"`
+	// feeAllowanceSpendLimit is the spend limit of the basic fee allowance
+	// granted to first-time recipients, empty disables the allowances.
+	FeeAllowanceSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=feeAllowanceSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"feeAllowanceSpendLimit" yaml:"fee_allowance_spend_limit"`
+	// feeAllowanceDuration is how long a granted fee allowance is valid.
+	FeeAllowanceDuration time.Duration `protobuf:"bytes,12,opt,name=feeAllowanceDuration,proto3,stdduration" json:"feeAllowanceDuration" yaml:"fee_allowance_duration"`
+	// feeAllowanceSponsor pays for the fee allowances and must have registered
+	// as a fee sponsor, empty means the fee allowance account of the module,
+	// which anyone can fund.
+	FeeAllowanceSponsor string `protobuf:"bytes,13,opt,name=feeAllowanceSponsor,proto3" json:"feeAllowanceSponsor,omitempty" yaml:"fee_allowance_sponsor"`
+	// feeAllowanceBudget caps the sum of all spend limits granted.
+	FeeAllowanceBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=feeAllowanceBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"feeAllowanceBudget" yaml:"fee_allowance_budget"`
+	// transferTimeout is the relative timeout of the ICS-20 transfers sent to
+	// cross-chain recipients and of the distribution packets sent to host
+	// chains, zero disables both.
+	TransferTimeout time.Duration `protobuf:"bytes,15,opt,name=transferTimeout,proto3,stdduration" json:"transferTimeout" yaml:"transfer_timeout"`
+	// remoteControllers lists the channels of the optio port over which a
+	// controller chain may send distribution packets.
+	RemoteControllers []RemoteController `protobuf:"bytes,16,rep,name=remoteControllers,proto3" json:"remoteControllers" yaml:"remote_controllers"`
+	// feeExemptBlockGas caps the summed gas limit of the fee exempt txs in a
+	// block, zero disables fee exemptions. Txs containing only optio messages
+	// signed by authorized accounts are exempt from minimum gas prices.
+	FeeExemptBlockGas uint64 `protobuf:"varint,17,opt,name=feeExemptBlockGas,proto3" json:"feeExemptBlockGas,omitempty" yaml:"fee_exempt_block_gas"`
+	// feeExemptTxsPerWindow caps the fee exempt txs of an authorized account
+	// within feeExemptWindow.
+	FeeExemptTxsPerWindow uint64 `protobuf:"varint,18,opt,name=feeExemptTxsPerWindow,proto3" json:"feeExemptTxsPerWindow,omitempty" yaml:"fee_exempt_txs_per_window"`
+	// feeExemptWindow is the duration after which the fee exempt txs of an
+	// account are reset.
+	FeeExemptWindow time.Duration `protobuf:"bytes,19,opt,name=feeExemptWindow,proto3,stdduration" json:"feeExemptWindow" yaml:"fee_exempt_window"`
+	// minimumGasPrices are the chain-wide minimum gas prices. Nodes only
+	// accept txs paying one of these denoms into their mempool, at no less
+	// than these prices, and blocks reject txs paying less. Empty leaves the
+	// fees to the nodes.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,20,rep,name=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimumGasPrices" yaml:"minimum_gas_prices"`
+	// baseFeeTargetGas is the gas per block the base fee steers towards, zero
+	// disables the base fee. Every tx must pay the base fee in the module
+	// denom for its gas limit.
+	BaseFeeTargetGas uint64 `protobuf:"varint,21,opt,name=baseFeeTargetGas,proto3" json:"baseFeeTargetGas,omitempty" yaml:"base_fee_target_gas"`
+	// baseFeeMaxChangeRate bounds the relative change of the base fee from one
+	// block to the next. The full rate applies to blocks using no gas or twice
+	// the target gas.
+	BaseFeeMaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=baseFeeMaxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"baseFeeMaxChangeRate" yaml:"base_fee_max_change_rate"`
+	// minBaseFee is the lowest base fee per unit of gas.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,23,opt,name=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minBaseFee" yaml:"min_base_fee"`
+	// maxBaseFee is the highest base fee per unit of gas, zero disables the
+	// cap.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,24,opt,name=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maxBaseFee" yaml:"max_base_fee"`
+	// baseFeeBurnRate is the share of the base fee that is burned, the rest
+	// of the fee goes to the fee collector.
+	BaseFeeBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,25,opt,name=baseFeeBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"baseFeeBurnRate" yaml:"base_fee_burn_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c190384b107a907, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAuthorizedAccounts() []string {
+	if m != nil {
+		return m.AuthorizedAccounts
+	}
+	return nil
+}
+
+func (m *Params) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxReferenceLength() uint64 {
+	if m != nil {
+		return m.MaxReferenceLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCategoryLength() uint64 {
+	if m != nil {
+		return m.MaxCategoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *Params) GetComplianceAccounts() []string {
+	if m != nil {
+		return m.ComplianceAccounts
+	}
+	return nil
+}
+
+func (m *Params) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxRecipientsPerMsg() uint64 {
+	if m != nil {
+		return m.MaxRecipientsPerMsg
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerRecipient() uint64 {
+	if m != nil {
+		return m.GasPerRecipient
+	}
+	return 0
+}
+
+func (m *Params) GetFeeAllowanceSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowanceSpendLimit
+	}
+	return nil
+}
+
+func (m *Params) GetFeeAllowanceDuration() time.Duration {
+	if m != nil {
+		return m.FeeAllowanceDuration
+	}
+	return 0
+}
+
+func (m *Params) GetFeeAllowanceSponsor() string {
+	if m != nil {
+		return m.FeeAllowanceSponsor
+	}
+	return ""
+}
+
+func (m *Params) GetFeeAllowanceBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowanceBudget
+	}
+	return nil
+}
+
+func (m *Params) GetTransferTimeout() time.Duration {
+	if m != nil {
+		return m.TransferTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetRemoteControllers() []RemoteController {
+	if m != nil {
+		return m.RemoteControllers
+	}
+	return nil
+}
+
+func (m *Params) GetFeeExemptBlockGas() uint64 {
+	if m != nil {
+		return m.FeeExemptBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetFeeExemptTxsPerWindow() uint64 {
+	if m != nil {
+		return m.FeeExemptTxsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFeeExemptWindow() time.Duration {
+	if m != nil {
+		return m.FeeExemptWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeTargetGas() uint64 {
+	if m != nil {
+		return m.BaseFeeTargetGas
+	}
+	return 0
+}
+
+// RemoteController approves a channel of the optio port to execute the
+// distributions sent by the controller chain at its other end.
+type RemoteController struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// quota caps the total amount distributed over the channel.
+	Quota cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=quota,proto3,customtype=cosmossdk.io/math.Int" json:"quota"`
+}
+
+func (m *RemoteController) Reset()         { *m = RemoteController{} }
+func (m *RemoteController) String() string { return proto.CompactTextString(m) }
+func (*RemoteController) ProtoMessage()    {}
+func (*RemoteController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c190384b107a907, []int{1}
+}
+func (m *RemoteController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteController.Merge(m, src)
+}
+func (m *RemoteController) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteController) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteController proto.InternalMessageInfo
+
+func (m *RemoteController) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "optio.optio.Params")
+	proto.RegisterType((*RemoteController)(nil), "optio.optio.RemoteController")
+}
+
+func init() { proto.RegisterFile("optio/optio/params.proto", fileDescriptor_4c190384b107a907) }
+
+var fileDescriptor_4c190384b107a907 = []byte{
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x8e, 0x7f, 0xfd, 0xf8, 0x35, 0xd3, 0x96, 0x64, 0x27, 0xdb, 0xd6, 0x49, 0x9b, 0xf5, 0x62,
+	0xaa, 0x6a, 0x29, 0xd4, 0x4b, 0xe1, 0xd6, 0x13, 0x75, 0xd2, 0x94, 0xa2, 0x84, 0x56, 0x4e, 0x10,
+	0x6a, 0x2f, 0xd6, 0xac, 0xf7, 0x5d, 0xaf, 0x55, 0x8f, 0x67, 0xb1, 0xc7, 0xad, 0x83, 0x84, 0xb8,
+	0x83, 0x90, 0x38, 0x72, 0x83, 0x23, 0x42, 0x1c, 0x7a, 0xe0, 0x8f, 0xe8, 0xb1, 0xe2, 0x84, 0x38,
+	0xb8, 0xa8, 0x3d, 0x94, 0xf3, 0xfe, 0x05, 0x68, 0x3e, 0xf6, 0x23, 0xbb, 0x2e, 0x89, 0x7a, 0xf1,
+	0xee, 0xcc, 0xbc, 0xef, 0xf3, 0x3c, 0xf3, 0x7e, 0xcc, 0x0c, 0x32, 0xd9, 0x80, 0x47, 0xac, 0xad,
+	0xbe, 0x03, 0x92, 0x12, 0x9a, 0x39, 0x83, 0x94, 0x71, 0x86, 0x4f, 0xcb, 0x39, 0x47, 0x7e, 0xd7,
+	0x6a, 0x84, 0x46, 0x09, 0x6b, 0xcb, 0xaf, 0x5a, 0x5f, 0x5b, 0x0d, 0x58, 0x46, 0x59, 0xe6, 0xcb,
+	0x51, 0x5b, 0x0d, 0xf4, 0x52, 0x43, 0x8d, 0xda, 0x1d, 0x92, 0x41, 0xfb, 0xd1, 0xf5, 0x0e, 0x70,
+	0x72, 0xbd, 0x1d, 0xb0, 0x28, 0xd1, 0xeb, 0xf5, 0x90, 0x85, 0x4c, 0xf9, 0x89, 0x7f, 0x23, 0xaf,
+	0x90, 0xb1, 0x30, 0x86, 0xb6, 0x1c, 0x75, 0xf2, 0x5e, 0xbb, 0x9b, 0xa7, 0x84, 0x47, 0x4c, 0x7b,
+	0xd9, 0xdf, 0xd5, 0xd1, 0xc9, 0x7b, 0x52, 0x21, 0xfe, 0x0c, 0x61, 0x92, 0xf3, 0x3e, 0x4b, 0xa3,
+	0xaf, 0xa0, 0x7b, 0x33, 0x08, 0x58, 0x9e, 0xf0, 0xcc, 0x34, 0x9a, 0xc7, 0x5a, 0x8b, 0x6e, 0x63,
+	0x58, 0x5a, 0x6b, 0xfb, 0x84, 0xc6, 0x37, 0xec, 0x89, 0x8d, 0x4f, 0xb4, 0x91, 0xed, 0x55, 0x78,
+	0xe2, 0x2b, 0xe8, 0x44, 0x17, 0x12, 0x46, 0xcd, 0xff, 0x35, 0x8d, 0xd6, 0xa2, 0xbb, 0x3c, 0x2c,
+	0xad, 0x33, 0x0a, 0x42, 0x4e, 0xdb, 0x9e, 0x5a, 0xc6, 0x01, 0x5a, 0xa4, 0xa4, 0xd8, 0xcd, 0x07,
+	0x83, 0x78, 0xdf, 0x3c, 0x26, 0x6d, 0x6f, 0x3d, 0x2d, 0xad, 0x85, 0xbf, 0x4a, 0xeb, 0x9c, 0xda,
+	0x73, 0xd6, 0x7d, 0xe8, 0x44, 0xac, 0x4d, 0x09, 0xef, 0x3b, 0x77, 0x12, 0x3e, 0x2c, 0xad, 0x9a,
+	0x02, 0xa2, 0xa4, 0xf0, 0x33, 0xe9, 0x68, 0xff, 0xf1, 0xfb, 0x35, 0xa4, 0xe3, 0x75, 0x27, 0xe1,
+	0xbf, 0xbc, 0x7a, 0x72, 0xd5, 0xf0, 0x26, 0xb8, 0xf8, 0x2e, 0xc2, 0x94, 0x14, 0x1e, 0xf4, 0x20,
+	0x85, 0x24, 0x80, 0x6d, 0x48, 0x42, 0xde, 0x37, 0x8f, 0x37, 0x8d, 0xd6, 0x71, 0xd7, 0x1a, 0x96,
+	0xd6, 0xc5, 0x09, 0x60, 0x3a, 0x32, 0xf2, 0x63, 0x69, 0x65, 0x7b, 0x15, 0xae, 0x78, 0x1b, 0xd5,
+	0x28, 0x29, 0x36, 0x08, 0x87, 0x90, 0xa5, 0xfb, 0x1a, 0xef, 0x84, 0xc4, 0x9b, 0x0a, 0x96, 0xc0,
+	0x0b, 0xb4, 0xcd, 0x18, 0x6e, 0xde, 0x11, 0x7f, 0x8c, 0xce, 0x52, 0x52, 0xec, 0x00, 0x65, 0x1a,
+	0xe9, 0xa4, 0x44, 0x5a, 0x1b, 0x96, 0xd6, 0xf9, 0x09, 0x12, 0x05, 0xca, 0xc6, 0x28, 0x07, 0x1d,
+	0x44, 0xf6, 0x02, 0x46, 0x07, 0x71, 0x44, 0x92, 0x00, 0xc6, 0xd9, 0xfb, 0xff, 0x6c, 0xf6, 0x26,
+	0x36, 0xd3, 0xd9, 0x9b, 0xf7, 0xc4, 0x9f, 0xa0, 0x65, 0x12, 0xc7, 0xec, 0x71, 0x1c, 0x65, 0xfc,
+	0x56, 0x42, 0x3a, 0x31, 0x74, 0xcd, 0x53, 0x4d, 0xa3, 0x75, 0xca, 0xbd, 0x34, 0x2c, 0x2d, 0x53,
+	0xd7, 0xc2, 0xc8, 0xc2, 0x07, 0x65, 0x62, 0x7b, 0x73, 0x5e, 0x78, 0x17, 0xad, 0xc8, 0xf8, 0x05,
+	0xd1, 0x20, 0x82, 0x84, 0x67, 0xf7, 0x20, 0xdd, 0xc9, 0x42, 0x73, 0x51, 0xee, 0xf0, 0xed, 0x61,
+	0x69, 0xad, 0x4f, 0xc7, 0x7e, 0x64, 0xe5, 0x0f, 0x20, 0xf5, 0x69, 0x16, 0xda, 0x5e, 0x95, 0x37,
+	0xde, 0x42, 0x4b, 0x21, 0x11, 0x83, 0xf1, 0x8a, 0x89, 0x24, 0xe0, 0x94, 0xba, 0x90, 0x28, 0x94,
+	0x31, 0xa8, 0xed, 0xcd, 0x3a, 0xe1, 0xdf, 0x0c, 0x74, 0xbe, 0x07, 0x70, 0x53, 0x88, 0x16, 0xfb,
+	0xdf, 0x1d, 0x40, 0xd2, 0xdd, 0x8e, 0x68, 0xc4, 0xcd, 0xd3, 0xcd, 0x63, 0xad, 0xd3, 0x1f, 0xae,
+	0x3a, 0xba, 0xaa, 0x44, 0xdf, 0x39, 0xba, 0xef, 0x9c, 0x0d, 0x16, 0x25, 0xee, 0x7d, 0x51, 0xa5,
+	0xc3, 0xd2, 0x6a, 0x2a, 0xba, 0x1e, 0x80, 0x4f, 0x46, 0x38, 0x7e, 0x26, 0x80, 0xfc, 0x58, 0x20,
+	0xd9, 0xbf, 0x3e, 0xb7, 0x5a, 0x61, 0xc4, 0xfb, 0x79, 0xc7, 0x09, 0x18, 0xd5, 0x6d, 0xad, 0x7f,
+	0xae, 0x65, 0xdd, 0x87, 0x6d, 0xbe, 0x3f, 0x80, 0x4c, 0x82, 0x66, 0xaa, 0x72, 0x5f, 0xa3, 0x09,
+	0x17, 0xa8, 0x3e, 0xbd, 0xb2, 0xa9, 0x9b, 0xd9, 0x3c, 0xd3, 0x34, 0xa4, 0x56, 0xd5, 0xed, 0xce,
+	0xa8, 0xdb, 0x9d, 0x91, 0x81, 0xfb, 0xae, 0xd6, 0xba, 0x5e, 0xa5, 0x75, 0x74, 0x26, 0xd8, 0x3f,
+	0x3e, 0xb7, 0x0c, 0xaf, 0x92, 0x01, 0x7b, 0x68, 0xe5, 0xa0, 0x26, 0x96, 0x64, 0x2c, 0x35, 0xcf,
+	0xca, 0x7e, 0x6d, 0x0e, 0x4b, 0xeb, 0x52, 0x75, 0x14, 0xa4, 0x99, 0xed, 0x55, 0x39, 0xe3, 0x9f,
+	0x0c, 0x84, 0xa7, 0xe7, 0xdd, 0xbc, 0x1b, 0x02, 0x37, 0xdf, 0x3a, 0x2c, 0xf0, 0x7b, 0x7a, 0x33,
+	0x17, 0xab, 0x28, 0x3b, 0x12, 0xe4, 0x0d, 0x62, 0x5e, 0x21, 0x05, 0xf7, 0xd1, 0x12, 0x4f, 0x49,
+	0x92, 0xf5, 0x20, 0xdd, 0x8b, 0x28, 0xb0, 0x9c, 0x9b, 0x4b, 0x87, 0x85, 0xfa, 0x1d, 0xad, 0xee,
+	0x82, 0x52, 0x37, 0xf2, 0xf7, 0xb9, 0x02, 0x50, 0x41, 0x9e, 0x85, 0xc5, 0x19, 0xaa, 0xa5, 0x40,
+	0x19, 0x87, 0x0d, 0x96, 0xf0, 0x94, 0xc5, 0x31, 0xa4, 0x99, 0xb9, 0x2c, 0x23, 0xb1, 0xee, 0x4c,
+	0xdd, 0x1a, 0x8e, 0x37, 0x63, 0xe5, 0x5e, 0xd1, 0x7c, 0xab, 0x8a, 0x4f, 0xa1, 0xf8, 0xc1, 0x04,
+	0xc6, 0x56, 0xfb, 0x9b, 0xc7, 0xc7, 0x3b, 0xa8, 0xd6, 0x03, 0xb8, 0x55, 0x00, 0x1d, 0x70, 0x37,
+	0x66, 0xc1, 0xc3, 0xdb, 0x24, 0x33, 0x6b, 0xb3, 0x87, 0xa2, 0x88, 0x2f, 0x48, 0x1b, 0xbf, 0x23,
+	0x8c, 0xfc, 0x90, 0x64, 0xb6, 0x37, 0xef, 0x89, 0x1f, 0xa0, 0x73, 0xe3, 0xc9, 0xbd, 0x42, 0x34,
+	0xda, 0x17, 0x51, 0xd2, 0x65, 0x8f, 0x4d, 0x2c, 0x21, 0x2f, 0x1f, 0xec, 0x15, 0x0d, 0xc9, 0x0b,
+	0xd5, 0xa5, 0x8f, 0xa5, 0xa9, 0xed, 0x55, 0x43, 0xe0, 0x08, 0x2d, 0x8d, 0x17, 0x34, 0xea, 0xca,
+	0x61, 0x99, 0xb8, 0xac, 0x23, 0x63, 0xce, 0x91, 0x6a, 0x32, 0x95, 0x8a, 0x19, 0x5c, 0x51, 0x96,
+	0xcb, 0x34, 0x4a, 0x22, 0x9a, 0xd3, 0xdb, 0x24, 0xbb, 0x97, 0x46, 0x01, 0x64, 0x66, 0x5d, 0xa6,
+	0xe2, 0x52, 0x65, 0x51, 0x6e, 0x42, 0x20, 0xeb, 0xf2, 0xf3, 0x83, 0x99, 0xd0, 0x18, 0xbe, 0x3c,
+	0x87, 0x24, 0x8a, 0xa8, 0xca, 0xf7, 0x8e, 0x50, 0x95, 0x1a, 0x50, 0x17, 0xe6, 0x9c, 0x18, 0xfc,
+	0x29, 0x5a, 0x16, 0x02, 0xb6, 0x00, 0xf6, 0x48, 0x1a, 0x02, 0x17, 0x69, 0x3b, 0x37, 0x7b, 0xf7,
+	0x08, 0x0b, 0x5f, 0xec, 0x99, 0x4b, 0x1b, 0x95, 0xb5, 0x39, 0x3f, 0xfc, 0xbd, 0x81, 0xea, 0x7a,
+	0x72, 0x87, 0x14, 0x1b, 0x7d, 0x92, 0x84, 0xe0, 0x11, 0x0e, 0xe6, 0x79, 0xd9, 0xda, 0xf7, 0xf5,
+	0x55, 0x7c, 0x71, 0xfe, 0x2a, 0xde, 0x86, 0x90, 0x04, 0xfb, 0x9b, 0x10, 0x0c, 0x4b, 0xcb, 0x9a,
+	0xe1, 0x94, 0x17, 0x9f, 0xc4, 0xf2, 0x53, 0xc2, 0x61, 0xfa, 0x7a, 0xde, 0x84, 0x40, 0xed, 0xab,
+	0x92, 0x16, 0x53, 0x84, 0x68, 0x94, 0xb8, 0x6a, 0xc9, 0xbc, 0x20, 0x45, 0xec, 0x1c, 0x4d, 0xc4,
+	0xca, 0x38, 0xee, 0xfe, 0x48, 0x48, 0x25, 0xf1, 0x14, 0x81, 0xa4, 0x23, 0xc5, 0x88, 0xce, 0x7c,
+	0x13, 0x3a, 0x52, 0x1c, 0x46, 0x37, 0x26, 0xc0, 0x5f, 0xa3, 0x25, 0xbd, 0x6b, 0x37, 0x4f, 0x13,
+	0x19, 0xe7, 0x55, 0xc9, 0xb9, 0x7b, 0x34, 0xce, 0xd5, 0x99, 0x38, 0x77, 0xf2, 0x34, 0x79, 0x7d,
+	0x84, 0x67, 0xb9, 0x6e, 0xac, 0xff, 0xf3, 0xb3, 0x65, 0x7c, 0xfb, 0xea, 0xc9, 0xd5, 0xba, 0x7a,
+	0x9c, 0x16, 0xfa, 0x91, 0xaa, 0x9e, 0x80, 0xf6, 0x37, 0x68, 0x79, 0xf6, 0x78, 0xc1, 0xeb, 0x08,
+	0x89, 0x44, 0x26, 0x10, 0xfb, 0x51, 0xd7, 0x34, 0x84, 0x58, 0x6f, 0x51, 0xcf, 0xdc, 0xe9, 0xe2,
+	0x2d, 0x74, 0xe2, 0xcb, 0x9c, 0x71, 0xa2, 0x5f, 0x79, 0x1f, 0xfc, 0xe7, 0xcb, 0xad, 0xea, 0x91,
+	0xa6, 0xdc, 0x6f, 0x1c, 0x17, 0xca, 0xdc, 0xad, 0xa7, 0x2f, 0x1a, 0xc6, 0xb3, 0x17, 0x0d, 0xe3,
+	0xef, 0x17, 0x0d, 0xe3, 0x87, 0x97, 0x8d, 0x85, 0x67, 0x2f, 0x1b, 0x0b, 0x7f, 0xbe, 0x6c, 0x2c,
+	0x3c, 0x78, 0x7f, 0xaa, 0x6d, 0xee, 0x0a, 0xcd, 0xbb, 0x90, 0x3e, 0x12, 0xcd, 0xd0, 0x3e, 0xb8,
+	0x13, 0xd9, 0x40, 0x9d, 0x93, 0xf2, 0x30, 0xf8, 0xe8, 0xdf, 0x01, 0x00, 0x56, 0xbf, 0x90, 0x38,
+	0x8a, 0x0b, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AuthorizedAccounts) != len(that1.AuthorizedAccounts) {
+		return false
+	}
+	for i := range this.AuthorizedAccounts {
+		if this.AuthorizedAccounts[i] != that1.AuthorizedAccounts[i] {
+			return false
+		}
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.MaxReferenceLength != that1.MaxReferenceLength {
+		return false
+	}
+	if this.MaxCategoryLength != that1.MaxCategoryLength {
+		return false
+	}
+	if this.MaxMemoLength != that1.MaxMemoLength {
+		return false
+	}
+	if len(this.ComplianceAccounts) != len(that1.ComplianceAccounts) {
+		return false
+	}
+	for i := range this.ComplianceAccounts {
+		if this.ComplianceAccounts[i] != that1.ComplianceAccounts[i] {
+			return false
+		}
+	}
+	if this.AllowlistEnabled != that1.AllowlistEnabled {
+		return false
+	}
+	if this.MaxRecipientsPerMsg != that1.MaxRecipientsPerMsg {
+		return false
+	}
+	if this.GasPerRecipient != that1.GasPerRecipient {
+		return false
+	}
+	if len(this.FeeAllowanceSpendLimit) != len(that1.FeeAllowanceSpendLimit) {
+		return false
+	}
+	for i := range this.FeeAllowanceSpendLimit {
+		if !this.FeeAllowanceSpendLimit[i].Equal(&that1.FeeAllowanceSpendLimit[i]) {
+			return false
+		}
+	}
+	if this.FeeAllowanceDuration != that1.FeeAllowanceDuration {
+		return false
+	}
+	if this.FeeAllowanceSponsor != that1.FeeAllowanceSponsor {
+		return false
+	}
+	if len(this.FeeAllowanceBudget) != len(that1.FeeAllowanceBudget) {
+		return false
+	}
+	for i := range this.FeeAllowanceBudget {
+		if !this.FeeAllowanceBudget[i].Equal(&that1.FeeAllowanceBudget[i]) {
+			return false
+		}
+	}
+	if this.TransferTimeout != that1.TransferTimeout {
+		return false
+	}
+	if len(this.RemoteControllers) != len(that1.RemoteControllers) {
+		return false
+	}
+	for i := range this.RemoteControllers {
+		if !this.RemoteControllers[i].Equal(&that1.RemoteControllers[i]) {
+			return false
+		}
+	}
+	if this.FeeExemptBlockGas != that1.FeeExemptBlockGas {
+		return false
+	}
+	if this.FeeExemptTxsPerWindow != that1.FeeExemptTxsPerWindow {
+		return false
+	}
+	if this.FeeExemptWindow != that1.FeeExemptWindow {
+		return false
+	}
+	if len(this.MinimumGasPrices) != len(that1.MinimumGasPrices) {
+		return false
+	}
+	for i := range this.MinimumGasPrices {
+		if !this.MinimumGasPrices[i].Equal(&that1.MinimumGasPrices[i]) {
+			return false
+		}
+	}
+	if this.BaseFeeTargetGas != that1.BaseFeeTargetGas {
+		return false
+	}
+	if !this.BaseFeeMaxChangeRate.Equal(that1.BaseFeeMaxChangeRate) {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if !this.MaxBaseFee.Equal(that1.MaxBaseFee) {
+		return false
+	}
+	if !this.BaseFeeBurnRate.Equal(that1.BaseFeeBurnRate) {
+		return false
+	}
+	return true
+}
+func (this *RemoteController) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoteController)
+	if !ok {
+		that2, ok := that.(RemoteController)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFeeBurnRate.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.BaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.BaseFeeTargetGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeTargetGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeeExemptWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeExemptWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.FeeExemptTxsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeExemptTxsPerWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.FeeExemptBlockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeExemptBlockGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RemoteControllers) > 0 {
+		for iNdEx := len(m.RemoteControllers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteControllers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.FeeAllowanceBudget) > 0 {
+		for iNdEx := len(m.FeeAllowanceBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowanceBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FeeAllowanceSponsor) > 0 {
+		i -= len(m.FeeAllowanceSponsor)
+		copy(dAtA[i:], m.FeeAllowanceSponsor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeAllowanceSponsor)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeeAllowanceDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeAllowanceDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	if len(m.FeeAllowanceSpendLimit) > 0 {
+		for iNdEx := len(m.FeeAllowanceSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowanceSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.GasPerRecipient != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPerRecipient))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxRecipientsPerMsg != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecipientsPerMsg))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ComplianceAccounts) > 0 {
+		for iNdEx := len(m.ComplianceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ComplianceAccounts[iNdEx])
+			copy(dAtA[i:], m.ComplianceAccounts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ComplianceAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCategoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCategoryLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxReferenceLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReferenceLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthorizedAccounts) > 0 {
+		for iNdEx := len(m.AuthorizedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedAccounts[iNdEx])
+			copy(dAtA[i:], m.AuthorizedAccounts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AuthorizedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quota.Size()
+		i -= size
+		if _, err := m.Quota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedAccounts) > 0 {
+		for _, s := range m.AuthorizedAccounts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxReferenceLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxReferenceLength))
+	}
+	if m.MaxCategoryLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxCategoryLength))
+	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxMemoLength))
+	}
+	if len(m.ComplianceAccounts) > 0 {
+		for _, s := range m.ComplianceAccounts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	if m.MaxRecipientsPerMsg != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecipientsPerMsg))
+	}
+	if m.GasPerRecipient != 0 {
+		n += 1 + sovParams(uint64(m.GasPerRecipient))
+	}
+	if len(m.FeeAllowanceSpendLimit) > 0 {
+		for _, e := range m.FeeAllowanceSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeAllowanceDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeAllowanceSponsor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.FeeAllowanceBudget) > 0 {
+		for _, e := range m.FeeAllowanceBudget {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.RemoteControllers) > 0 {
+		for _, e := range m.RemoteControllers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeExemptBlockGas != 0 {
+		n += 2 + sovParams(uint64(m.FeeExemptBlockGas))
+	}
+	if m.FeeExemptTxsPerWindow != 0 {
+		n += 2 + sovParams(uint64(m.FeeExemptTxsPerWindow))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeExemptWindow)
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BaseFeeTargetGas != 0 {
+		n += 2 + sovParams(uint64(m.BaseFeeTargetGas))
+	}
+	l = m.BaseFeeMaxChangeRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.BaseFeeBurnRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RemoteController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedAccounts = append(m.AuthorizedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReferenceLength", wireType)
+			}
+			m.MaxReferenceLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReferenceLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCategoryLength", wireType)
+			}
+			m.MaxCategoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCategoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceAccounts = append(m.ComplianceAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecipientsPerMsg", wireType)
+			}
+			m.MaxRecipientsPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecipientsPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerRecipient", wireType)
+			}
+			m.GasPerRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerRecipient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowanceSpendLimit = append(m.FeeAllowanceSpendLimit, types.Coin{})
+			if err := m.FeeAllowanceSpendLimit[len(m.FeeAllowanceSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeeAllowanceDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceSponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowanceSponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowanceBudget = append(m.FeeAllowanceBudget, types.Coin{})
+			if err := m.FeeAllowanceBudget[len(m.FeeAllowanceBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteControllers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteControllers = append(m.RemoteControllers, RemoteController{})
+			if err := m.RemoteControllers[len(m.RemoteControllers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptBlockGas", wireType)
+			}
+			m.FeeExemptBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeExemptBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptTxsPerWindow", wireType)
+			}
+			m.FeeExemptTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeExemptTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeeExemptWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetGas", wireType)
+			}
+			m.BaseFeeTargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeTargetGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,9044 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetDistributionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetDistributionRequest) Reset()         { *m = QueryGetDistributionRequest{} }
+func (m *QueryGetDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDistributionRequest) ProtoMessage()    {}
+func (*QueryGetDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{2}
+}
+func (m *QueryGetDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryGetDistributionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetDistributionResponse struct {
+	Distribution Distribution `protobuf:"bytes,1,opt,name=Distribution,proto3" json:"Distribution"`
+}
+
+func (m *QueryGetDistributionResponse) Reset()         { *m = QueryGetDistributionResponse{} }
+func (m *QueryGetDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDistributionResponse) ProtoMessage()    {}
+func (*QueryGetDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{3}
+}
+func (m *QueryGetDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetDistributionResponse) GetDistribution() Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return Distribution{}
+}
+
+type QueryAllDistributionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// category restricts the results to distributions with this category.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *QueryAllDistributionRequest) Reset()         { *m = QueryAllDistributionRequest{} }
+func (m *QueryAllDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDistributionRequest) ProtoMessage()    {}
+func (*QueryAllDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{4}
+}
+func (m *QueryAllDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDistributionRequest.Merge(m, src)
+}
+func (m *QueryAllDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryAllDistributionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllDistributionRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+type QueryAllDistributionResponse struct {
+	Distribution []Distribution      `protobuf:"bytes,1,rep,name=Distribution,proto3" json:"Distribution"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDistributionResponse) Reset()         { *m = QueryAllDistributionResponse{} }
+func (m *QueryAllDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDistributionResponse) ProtoMessage()    {}
+func (*QueryAllDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{5}
+}
+func (m *QueryAllDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDistributionResponse.Merge(m, src)
+}
+func (m *QueryAllDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryAllDistributionResponse) GetDistribution() []Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+func (m *QueryAllDistributionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetAddressStatusRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetAddressStatusRequest) Reset()         { *m = QueryGetAddressStatusRequest{} }
+func (m *QueryGetAddressStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAddressStatusRequest) ProtoMessage()    {}
+func (*QueryGetAddressStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{6}
+}
+func (m *QueryGetAddressStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAddressStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAddressStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAddressStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAddressStatusRequest.Merge(m, src)
+}
+func (m *QueryGetAddressStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAddressStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAddressStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAddressStatusRequest proto.InternalMessageInfo
+
+func (m *QueryGetAddressStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryGetAddressStatusResponse struct {
+	AddressStatus AddressStatus `protobuf:"bytes,1,opt,name=addressStatus,proto3" json:"addressStatus"`
+}
+
+func (m *QueryGetAddressStatusResponse) Reset()         { *m = QueryGetAddressStatusResponse{} }
+func (m *QueryGetAddressStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAddressStatusResponse) ProtoMessage()    {}
+func (*QueryGetAddressStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{7}
+}
+func (m *QueryGetAddressStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAddressStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAddressStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAddressStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAddressStatusResponse.Merge(m, src)
+}
+func (m *QueryGetAddressStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAddressStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAddressStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAddressStatusResponse proto.InternalMessageInfo
+
+func (m *QueryGetAddressStatusResponse) GetAddressStatus() AddressStatus {
+	if m != nil {
+		return m.AddressStatus
+	}
+	return AddressStatus{}
+}
+
+type QueryAllAddressStatusRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAddressStatusRequest) Reset()         { *m = QueryAllAddressStatusRequest{} }
+func (m *QueryAllAddressStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAddressStatusRequest) ProtoMessage()    {}
+func (*QueryAllAddressStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{8}
+}
+func (m *QueryAllAddressStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAddressStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAddressStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAddressStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAddressStatusRequest.Merge(m, src)
+}
+func (m *QueryAllAddressStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAddressStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAddressStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAddressStatusRequest proto.InternalMessageInfo
+
+func (m *QueryAllAddressStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAddressStatusResponse struct {
+	AddressStatus []AddressStatus     `protobuf:"bytes,1,rep,name=addressStatus,proto3" json:"addressStatus"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAddressStatusResponse) Reset()         { *m = QueryAllAddressStatusResponse{} }
+func (m *QueryAllAddressStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAddressStatusResponse) ProtoMessage()    {}
+func (*QueryAllAddressStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{9}
+}
+func (m *QueryAllAddressStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAddressStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAddressStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAddressStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAddressStatusResponse.Merge(m, src)
+}
+func (m *QueryAllAddressStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAddressStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAddressStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAddressStatusResponse proto.InternalMessageInfo
+
+func (m *QueryAllAddressStatusResponse) GetAddressStatus() []AddressStatus {
+	if m != nil {
+		return m.AddressStatus
+	}
+	return nil
+}
+
+func (m *QueryAllAddressStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDistributeGasRequest struct {
+	NumRecipients uint64 `protobuf:"varint,1,opt,name=num_recipients,json=numRecipients,proto3" json:"num_recipients,omitempty"`
+}
+
+func (m *QueryDistributeGasRequest) Reset()         { *m = QueryDistributeGasRequest{} }
+func (m *QueryDistributeGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributeGasRequest) ProtoMessage()    {}
+func (*QueryDistributeGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{10}
+}
+func (m *QueryDistributeGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributeGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributeGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributeGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributeGasRequest.Merge(m, src)
+}
+func (m *QueryDistributeGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributeGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributeGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributeGasRequest proto.InternalMessageInfo
+
+func (m *QueryDistributeGasRequest) GetNumRecipients() uint64 {
+	if m != nil {
+		return m.NumRecipients
+	}
+	return 0
+}
+
+type QueryDistributeGasResponse struct {
+	// gas is the per-recipient gas charged for num_recipients, on top of the
+	// regular transaction and bank transfer costs.
+	Gas                 uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPerRecipient     uint64 `protobuf:"varint,2,opt,name=gas_per_recipient,json=gasPerRecipient,proto3" json:"gas_per_recipient,omitempty"`
+	MaxRecipientsPerMsg uint64 `protobuf:"varint,3,opt,name=max_recipients_per_msg,json=maxRecipientsPerMsg,proto3" json:"max_recipients_per_msg,omitempty"`
+	// num_messages is the minimum number of MsgDistribute needed for
+	// num_recipients under max_recipients_per_msg.
+	NumMessages uint64 `protobuf:"varint,4,opt,name=num_messages,json=numMessages,proto3" json:"num_messages,omitempty"`
+}
+
+func (m *QueryDistributeGasResponse) Reset()         { *m = QueryDistributeGasResponse{} }
+func (m *QueryDistributeGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributeGasResponse) ProtoMessage()    {}
+func (*QueryDistributeGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{11}
+}
+func (m *QueryDistributeGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributeGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributeGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributeGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributeGasResponse.Merge(m, src)
+}
+func (m *QueryDistributeGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributeGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributeGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributeGasResponse proto.InternalMessageInfo
+
+func (m *QueryDistributeGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryDistributeGasResponse) GetGasPerRecipient() uint64 {
+	if m != nil {
+		return m.GasPerRecipient
+	}
+	return 0
+}
+
+func (m *QueryDistributeGasResponse) GetMaxRecipientsPerMsg() uint64 {
+	if m != nil {
+		return m.MaxRecipientsPerMsg
+	}
+	return 0
+}
+
+func (m *QueryDistributeGasResponse) GetNumMessages() uint64 {
+	if m != nil {
+		return m.NumMessages
+	}
+	return 0
+}
+
+type QueryAuthorizedAccountsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthorizedAccountsRequest) Reset()         { *m = QueryAuthorizedAccountsRequest{} }
+func (m *QueryAuthorizedAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizedAccountsRequest) ProtoMessage()    {}
+func (*QueryAuthorizedAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{12}
+}
+func (m *QueryAuthorizedAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizedAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizedAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthorizedAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizedAccountsRequest.Merge(m, src)
+}
+func (m *QueryAuthorizedAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizedAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizedAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthorizedAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryAuthorizedAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAuthorizedAccountsResponse struct {
+	Accounts   []AuthorizedAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuthorizedAccountsResponse) Reset()         { *m = QueryAuthorizedAccountsResponse{} }
+func (m *QueryAuthorizedAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizedAccountsResponse) ProtoMessage()    {}
+func (*QueryAuthorizedAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{13}
+}
+func (m *QueryAuthorizedAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizedAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizedAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthorizedAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizedAccountsResponse.Merge(m, src)
+}
+func (m *QueryAuthorizedAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizedAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizedAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthorizedAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAuthorizedAccountsResponse) GetAccounts() []AuthorizedAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAuthorizedAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFeeAllowanceBudgetRequest struct {
+}
+
+func (m *QueryFeeAllowanceBudgetRequest) Reset()         { *m = QueryFeeAllowanceBudgetRequest{} }
+func (m *QueryFeeAllowanceBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAllowanceBudgetRequest) ProtoMessage()    {}
+func (*QueryFeeAllowanceBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{14}
+}
+func (m *QueryFeeAllowanceBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAllowanceBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAllowanceBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAllowanceBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAllowanceBudgetRequest.Merge(m, src)
+}
+func (m *QueryFeeAllowanceBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAllowanceBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAllowanceBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAllowanceBudgetRequest proto.InternalMessageInfo
+
+type QueryFeeAllowanceBudgetResponse struct {
+	Budget    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	Usage     FeeAllowanceUsage                        `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// granter pays for the fee allowances.
+	Granter string `protobuf:"bytes,4,opt,name=granter,proto3" json:"granter,omitempty"`
+	// granter_balance is the spendable balance of the granter, no allowance is
+	// granted once it does not cover the spend limit.
+	GranterBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=granter_balance,json=granterBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"granter_balance"`
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) Reset()         { *m = QueryFeeAllowanceBudgetResponse{} }
+func (m *QueryFeeAllowanceBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAllowanceBudgetResponse) ProtoMessage()    {}
+func (*QueryFeeAllowanceBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{15}
+}
+func (m *QueryFeeAllowanceBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAllowanceBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAllowanceBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAllowanceBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAllowanceBudgetResponse.Merge(m, src)
+}
+func (m *QueryFeeAllowanceBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAllowanceBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAllowanceBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAllowanceBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryFeeAllowanceBudgetResponse) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) GetUsage() FeeAllowanceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return FeeAllowanceUsage{}
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) GetGranterBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GranterBalance
+	}
+	return nil
+}
+
+type QueryGetTransferPacketRequest struct {
+	Channel  string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetTransferPacketRequest) Reset()         { *m = QueryGetTransferPacketRequest{} }
+func (m *QueryGetTransferPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTransferPacketRequest) ProtoMessage()    {}
+func (*QueryGetTransferPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{16}
+}
+func (m *QueryGetTransferPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTransferPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTransferPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTransferPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTransferPacketRequest.Merge(m, src)
+}
+func (m *QueryGetTransferPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTransferPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTransferPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTransferPacketRequest proto.InternalMessageInfo
+
+func (m *QueryGetTransferPacketRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *QueryGetTransferPacketRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetTransferPacketResponse struct {
+	TransferPacket TransferPacket `protobuf:"bytes,1,opt,name=transferPacket,proto3" json:"transferPacket"`
+}
+
+func (m *QueryGetTransferPacketResponse) Reset()         { *m = QueryGetTransferPacketResponse{} }
+func (m *QueryGetTransferPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTransferPacketResponse) ProtoMessage()    {}
+func (*QueryGetTransferPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{17}
+}
+func (m *QueryGetTransferPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTransferPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTransferPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTransferPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTransferPacketResponse.Merge(m, src)
+}
+func (m *QueryGetTransferPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTransferPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTransferPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTransferPacketResponse proto.InternalMessageInfo
+
+func (m *QueryGetTransferPacketResponse) GetTransferPacket() TransferPacket {
+	if m != nil {
+		return m.TransferPacket
+	}
+	return TransferPacket{}
+}
+
+type QueryAllTransferPacketRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTransferPacketRequest) Reset()         { *m = QueryAllTransferPacketRequest{} }
+func (m *QueryAllTransferPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferPacketRequest) ProtoMessage()    {}
+func (*QueryAllTransferPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{18}
+}
+func (m *QueryAllTransferPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTransferPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTransferPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferPacketRequest.Merge(m, src)
+}
+func (m *QueryAllTransferPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTransferPacketRequest proto.InternalMessageInfo
+
+func (m *QueryAllTransferPacketRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTransferPacketResponse struct {
+	TransferPacket []TransferPacket    `protobuf:"bytes,1,rep,name=transferPacket,proto3" json:"transferPacket"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTransferPacketResponse) Reset()         { *m = QueryAllTransferPacketResponse{} }
+func (m *QueryAllTransferPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTransferPacketResponse) ProtoMessage()    {}
+func (*QueryAllTransferPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{19}
+}
+func (m *QueryAllTransferPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTransferPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTransferPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTransferPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTransferPacketResponse.Merge(m, src)
+}
+func (m *QueryAllTransferPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTransferPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTransferPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTransferPacketResponse proto.InternalMessageInfo
+
+func (m *QueryAllTransferPacketResponse) GetTransferPacket() []TransferPacket {
+	if m != nil {
+		return m.TransferPacket
+	}
+	return nil
+}
+
+func (m *QueryAllTransferPacketResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetRateLimitRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryGetRateLimitRequest) Reset()         { *m = QueryGetRateLimitRequest{} }
+func (m *QueryGetRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRateLimitRequest) ProtoMessage()    {}
+func (*QueryGetRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{20}
+}
+func (m *QueryGetRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRateLimitRequest.Merge(m, src)
+}
+func (m *QueryGetRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryGetRateLimitRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetRateLimitResponse struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rateLimit,proto3" json:"rateLimit"`
+}
+
+func (m *QueryGetRateLimitResponse) Reset()         { *m = QueryGetRateLimitResponse{} }
+func (m *QueryGetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRateLimitResponse) ProtoMessage()    {}
+func (*QueryGetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{21}
+}
+func (m *QueryGetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRateLimitResponse.Merge(m, src)
+}
+func (m *QueryGetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetRateLimitResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+type QueryAllRateLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRateLimitRequest) Reset()         { *m = QueryAllRateLimitRequest{} }
+func (m *QueryAllRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitRequest) ProtoMessage()    {}
+func (*QueryAllRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{22}
+}
+func (m *QueryAllRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitRequest.Merge(m, src)
+}
+func (m *QueryAllRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRateLimitResponse struct {
+	RateLimit  []RateLimit         `protobuf:"bytes,1,rep,name=rateLimit,proto3" json:"rateLimit"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRateLimitResponse) Reset()         { *m = QueryAllRateLimitResponse{} }
+func (m *QueryAllRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitResponse) ProtoMessage()    {}
+func (*QueryAllRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{23}
+}
+func (m *QueryAllRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitResponse.Merge(m, src)
+}
+func (m *QueryAllRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAllRateLimitResponse) GetRateLimit() []RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *QueryAllRateLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRemoteTreasuryRequest struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryRemoteTreasuryRequest) Reset()         { *m = QueryRemoteTreasuryRequest{} }
+func (m *QueryRemoteTreasuryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemoteTreasuryRequest) ProtoMessage()    {}
+func (*QueryRemoteTreasuryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{24}
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemoteTreasuryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemoteTreasuryRequest.Merge(m, src)
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemoteTreasuryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemoteTreasuryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemoteTreasuryRequest proto.InternalMessageInfo
+
+func (m *QueryRemoteTreasuryRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+type QueryRemoteTreasuryResponse struct {
+	// address is the address of the interchain account on the host chain.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PortId  string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the active channel of the interchain account.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryRemoteTreasuryResponse) Reset()         { *m = QueryRemoteTreasuryResponse{} }
+func (m *QueryRemoteTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemoteTreasuryResponse) ProtoMessage()    {}
+func (*QueryRemoteTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{25}
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemoteTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemoteTreasuryResponse.Merge(m, src)
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemoteTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemoteTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemoteTreasuryResponse proto.InternalMessageInfo
+
+func (m *QueryRemoteTreasuryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryRemoteTreasuryResponse) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryRemoteTreasuryResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetRemoteDistributionRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetRemoteDistributionRequest) Reset()         { *m = QueryGetRemoteDistributionRequest{} }
+func (m *QueryGetRemoteDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteDistributionRequest) ProtoMessage()    {}
+func (*QueryGetRemoteDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{26}
+}
+func (m *QueryGetRemoteDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemoteDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRemoteDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetRemoteDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRemoteDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryGetRemoteDistributionRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetRemoteDistributionRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetRemoteDistributionResponse struct {
+	RemoteDistribution RemoteDistribution `protobuf:"bytes,1,opt,name=remoteDistribution,proto3" json:"remoteDistribution"`
+}
+
+func (m *QueryGetRemoteDistributionResponse) Reset()         { *m = QueryGetRemoteDistributionResponse{} }
+func (m *QueryGetRemoteDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteDistributionResponse) ProtoMessage()    {}
+func (*QueryGetRemoteDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{27}
+}
+func (m *QueryGetRemoteDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemoteDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRemoteDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetRemoteDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRemoteDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetRemoteDistributionResponse) GetRemoteDistribution() RemoteDistribution {
+	if m != nil {
+		return m.RemoteDistribution
+	}
+	return RemoteDistribution{}
+}
+
+type QueryAllRemoteDistributionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRemoteDistributionRequest) Reset()         { *m = QueryAllRemoteDistributionRequest{} }
+func (m *QueryAllRemoteDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRemoteDistributionRequest) ProtoMessage()    {}
+func (*QueryAllRemoteDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{28}
+}
+func (m *QueryAllRemoteDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRemoteDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRemoteDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRemoteDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRemoteDistributionRequest.Merge(m, src)
+}
+func (m *QueryAllRemoteDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRemoteDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRemoteDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRemoteDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryAllRemoteDistributionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRemoteDistributionResponse struct {
+	RemoteDistribution []RemoteDistribution `protobuf:"bytes,1,rep,name=remoteDistribution,proto3" json:"remoteDistribution"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRemoteDistributionResponse) Reset()         { *m = QueryAllRemoteDistributionResponse{} }
+func (m *QueryAllRemoteDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRemoteDistributionResponse) ProtoMessage()    {}
+func (*QueryAllRemoteDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{29}
+}
+func (m *QueryAllRemoteDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRemoteDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRemoteDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRemoteDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRemoteDistributionResponse.Merge(m, src)
+}
+func (m *QueryAllRemoteDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRemoteDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRemoteDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRemoteDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryAllRemoteDistributionResponse) GetRemoteDistribution() []RemoteDistribution {
+	if m != nil {
+		return m.RemoteDistribution
+	}
+	return nil
+}
+
+func (m *QueryAllRemoteDistributionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPacketOutcomeRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetPacketOutcomeRequest) Reset()         { *m = QueryGetPacketOutcomeRequest{} }
+func (m *QueryGetPacketOutcomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPacketOutcomeRequest) ProtoMessage()    {}
+func (*QueryGetPacketOutcomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{30}
+}
+func (m *QueryGetPacketOutcomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPacketOutcomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPacketOutcomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPacketOutcomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPacketOutcomeRequest.Merge(m, src)
+}
+func (m *QueryGetPacketOutcomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPacketOutcomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPacketOutcomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPacketOutcomeRequest proto.InternalMessageInfo
+
+func (m *QueryGetPacketOutcomeRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryGetPacketOutcomeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetPacketOutcomeRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetPacketOutcomeResponse struct {
+	PacketOutcome PacketOutcome `protobuf:"bytes,1,opt,name=packetOutcome,proto3" json:"packetOutcome"`
+}
+
+func (m *QueryGetPacketOutcomeResponse) Reset()         { *m = QueryGetPacketOutcomeResponse{} }
+func (m *QueryGetPacketOutcomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPacketOutcomeResponse) ProtoMessage()    {}
+func (*QueryGetPacketOutcomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{31}
+}
+func (m *QueryGetPacketOutcomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPacketOutcomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPacketOutcomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPacketOutcomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPacketOutcomeResponse.Merge(m, src)
+}
+func (m *QueryGetPacketOutcomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPacketOutcomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPacketOutcomeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPacketOutcomeResponse proto.InternalMessageInfo
+
+func (m *QueryGetPacketOutcomeResponse) GetPacketOutcome() PacketOutcome {
+	if m != nil {
+		return m.PacketOutcome
+	}
+	return PacketOutcome{}
+}
+
+type QueryAllPacketOutcomeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPacketOutcomeRequest) Reset()         { *m = QueryAllPacketOutcomeRequest{} }
+func (m *QueryAllPacketOutcomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPacketOutcomeRequest) ProtoMessage()    {}
+func (*QueryAllPacketOutcomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{32}
+}
+func (m *QueryAllPacketOutcomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPacketOutcomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPacketOutcomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPacketOutcomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPacketOutcomeRequest.Merge(m, src)
+}
+func (m *QueryAllPacketOutcomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPacketOutcomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPacketOutcomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPacketOutcomeRequest proto.InternalMessageInfo
+
+func (m *QueryAllPacketOutcomeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPacketOutcomeResponse struct {
+	PacketOutcome []PacketOutcome     `protobuf:"bytes,1,rep,name=packetOutcome,proto3" json:"packetOutcome"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPacketOutcomeResponse) Reset()         { *m = QueryAllPacketOutcomeResponse{} }
+func (m *QueryAllPacketOutcomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPacketOutcomeResponse) ProtoMessage()    {}
+func (*QueryAllPacketOutcomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{33}
+}
+func (m *QueryAllPacketOutcomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPacketOutcomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPacketOutcomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPacketOutcomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPacketOutcomeResponse.Merge(m, src)
+}
+func (m *QueryAllPacketOutcomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPacketOutcomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPacketOutcomeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPacketOutcomeResponse proto.InternalMessageInfo
+
+func (m *QueryAllPacketOutcomeResponse) GetPacketOutcome() []PacketOutcome {
+	if m != nil {
+		return m.PacketOutcome
+	}
+	return nil
+}
+
+func (m *QueryAllPacketOutcomeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingPayoutsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPayoutsRequest) Reset()         { *m = QueryPendingPayoutsRequest{} }
+func (m *QueryPendingPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutsRequest) ProtoMessage()    {}
+func (*QueryPendingPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{34}
+}
+func (m *QueryPendingPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutsRequest.Merge(m, src)
+}
+func (m *QueryPendingPayoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPayoutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingPayoutsResponse struct {
+	PacketOutcome []PacketOutcome     `protobuf:"bytes,1,rep,name=packetOutcome,proto3" json:"packetOutcome"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPayoutsResponse) Reset()         { *m = QueryPendingPayoutsResponse{} }
+func (m *QueryPendingPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutsResponse) ProtoMessage()    {}
+func (*QueryPendingPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{35}
+}
+func (m *QueryPendingPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutsResponse.Merge(m, src)
+}
+func (m *QueryPendingPayoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPayoutsResponse) GetPacketOutcome() []PacketOutcome {
+	if m != nil {
+		return m.PacketOutcome
+	}
+	return nil
+}
+
+func (m *QueryPendingPayoutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFeeExemptionRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeeExemptionRequest) Reset()         { *m = QueryFeeExemptionRequest{} }
+func (m *QueryFeeExemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExemptionRequest) ProtoMessage()    {}
+func (*QueryFeeExemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{36}
+}
+func (m *QueryFeeExemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExemptionRequest.Merge(m, src)
+}
+func (m *QueryFeeExemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExemptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExemptionRequest proto.InternalMessageInfo
+
+func (m *QueryFeeExemptionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryFeeExemptionResponse struct {
+	Usage FeeExemptUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+	// remaining is the number of fee exempt txs the account may still send in
+	// the current window.
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryFeeExemptionResponse) Reset()         { *m = QueryFeeExemptionResponse{} }
+func (m *QueryFeeExemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExemptionResponse) ProtoMessage()    {}
+func (*QueryFeeExemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{37}
+}
+func (m *QueryFeeExemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExemptionResponse.Merge(m, src)
+}
+func (m *QueryFeeExemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExemptionResponse proto.InternalMessageInfo
+
+func (m *QueryFeeExemptionResponse) GetUsage() FeeExemptUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return FeeExemptUsage{}
+}
+
+func (m *QueryFeeExemptionResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{38}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+type QueryBaseFeeResponse struct {
+	// base_fee is zero when the base fee is disabled.
+	BaseFee types.DecCoin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b80f00bffd326515, []int{39}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.DecCoin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "optio.optio.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "optio.optio.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDistributionRequest)(nil), "optio.optio.QueryGetDistributionRequest")
+	proto.RegisterType((*QueryGetDistributionResponse)(nil), "optio.optio.QueryGetDistributionResponse")
+	proto.RegisterType((*QueryAllDistributionRequest)(nil), "optio.optio.QueryAllDistributionRequest")
+	proto.RegisterType((*QueryAllDistributionResponse)(nil), "optio.optio.QueryAllDistributionResponse")
+	proto.RegisterType((*QueryGetAddressStatusRequest)(nil), "optio.optio.QueryGetAddressStatusRequest")
+	proto.RegisterType((*QueryGetAddressStatusResponse)(nil), "optio.optio.QueryGetAddressStatusResponse")
+	proto.RegisterType((*QueryAllAddressStatusRequest)(nil), "optio.optio.QueryAllAddressStatusRequest")
+	proto.RegisterType((*QueryAllAddressStatusResponse)(nil), "optio.optio.QueryAllAddressStatusResponse")
+	proto.RegisterType((*QueryDistributeGasRequest)(nil), "optio.optio.QueryDistributeGasRequest")
+	proto.RegisterType((*QueryDistributeGasResponse)(nil), "optio.optio.QueryDistributeGasResponse")
+	proto.RegisterType((*QueryAuthorizedAccountsRequest)(nil), "optio.optio.QueryAuthorizedAccountsRequest")
+	proto.RegisterType((*QueryAuthorizedAccountsResponse)(nil), "optio.optio.QueryAuthorizedAccountsResponse")
+	proto.RegisterType((*QueryFeeAllowanceBudgetRequest)(nil), "optio.optio.QueryFeeAllowanceBudgetRequest")
+	proto.RegisterType((*QueryFeeAllowanceBudgetResponse)(nil), "optio.optio.QueryFeeAllowanceBudgetResponse")
+	proto.RegisterType((*QueryGetTransferPacketRequest)(nil), "optio.optio.QueryGetTransferPacketRequest")
+	proto.RegisterType((*QueryGetTransferPacketResponse)(nil), "optio.optio.QueryGetTransferPacketResponse")
+	proto.RegisterType((*QueryAllTransferPacketRequest)(nil), "optio.optio.QueryAllTransferPacketRequest")
+	proto.RegisterType((*QueryAllTransferPacketResponse)(nil), "optio.optio.QueryAllTransferPacketResponse")
+	proto.RegisterType((*QueryGetRateLimitRequest)(nil), "optio.optio.QueryGetRateLimitRequest")
+	proto.RegisterType((*QueryGetRateLimitResponse)(nil), "optio.optio.QueryGetRateLimitResponse")
+	proto.RegisterType((*QueryAllRateLimitRequest)(nil), "optio.optio.QueryAllRateLimitRequest")
+	proto.RegisterType((*QueryAllRateLimitResponse)(nil), "optio.optio.QueryAllRateLimitResponse")
+	proto.RegisterType((*QueryRemoteTreasuryRequest)(nil), "optio.optio.QueryRemoteTreasuryRequest")
+	proto.RegisterType((*QueryRemoteTreasuryResponse)(nil), "optio.optio.QueryRemoteTreasuryResponse")
+	proto.RegisterType((*QueryGetRemoteDistributionRequest)(nil), "optio.optio.QueryGetRemoteDistributionRequest")
+	proto.RegisterType((*QueryGetRemoteDistributionResponse)(nil), "optio.optio.QueryGetRemoteDistributionResponse")
+	proto.RegisterType((*QueryAllRemoteDistributionRequest)(nil), "optio.optio.QueryAllRemoteDistributionRequest")
+	proto.RegisterType((*QueryAllRemoteDistributionResponse)(nil), "optio.optio.QueryAllRemoteDistributionResponse")
+	proto.RegisterType((*QueryGetPacketOutcomeRequest)(nil), "optio.optio.QueryGetPacketOutcomeRequest")
+	proto.RegisterType((*QueryGetPacketOutcomeResponse)(nil), "optio.optio.QueryGetPacketOutcomeResponse")
+	proto.RegisterType((*QueryAllPacketOutcomeRequest)(nil), "optio.optio.QueryAllPacketOutcomeRequest")
+	proto.RegisterType((*QueryAllPacketOutcomeResponse)(nil), "optio.optio.QueryAllPacketOutcomeResponse")
+	proto.RegisterType((*QueryPendingPayoutsRequest)(nil), "optio.optio.QueryPendingPayoutsRequest")
+	proto.RegisterType((*QueryPendingPayoutsResponse)(nil), "optio.optio.QueryPendingPayoutsResponse")
+	proto.RegisterType((*QueryFeeExemptionRequest)(nil), "optio.optio.QueryFeeExemptionRequest")
+	proto.RegisterType((*QueryFeeExemptionResponse)(nil), "optio.optio.QueryFeeExemptionResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "optio.optio.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "optio.optio.QueryBaseFeeResponse")
+}
+
+func init() { proto.RegisterFile("optio/optio/query.proto", fileDescriptor_b80f00bffd326515) }
+
+var fileDescriptor_b80f00bffd326515 = []byte{
+	// 1988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x7b, 0x1c, 0x27, 0xf3, 0x12, 0x3b, 0x9b, 0x4a, 0x76, 0x77, 0xdc, 0x71, 0xc6, 0x76,
+	0x47, 0x4e, 0x6c, 0xc7, 0x99, 0x26, 0x1f, 0xbb, 0x0b, 0x01, 0x09, 0x66, 0x76, 0x71, 0x88, 0x20,
+	0xac, 0x99, 0x6c, 0x84, 0xb4, 0x07, 0x46, 0x35, 0x3d, 0x95, 0x76, 0x2b, 0x33, 0xdd, 0xb3, 0xdd,
+	0x3d, 0x21, 0x66, 0xe4, 0x03, 0x9c, 0x39, 0x20, 0x21, 0x81, 0x90, 0x38, 0xac, 0x04, 0x12, 0x2b,
+	0x58, 0xa1, 0x68, 0xc5, 0x8d, 0x2b, 0x48, 0x7b, 0xe0, 0x10, 0x89, 0x0b, 0x27, 0x40, 0x09, 0x12,
+	0xff, 0x06, 0xea, 0xea, 0xd7, 0x3d, 0x55, 0x33, 0xd5, 0x3d, 0xed, 0x30, 0x91, 0xf6, 0x62, 0x4f,
+	0x57, 0xbf, 0x8f, 0xdf, 0xfb, 0xa8, 0xaa, 0xf7, 0x5e, 0xc3, 0x9b, 0x5e, 0x3f, 0x74, 0x3c, 0x33,
+	0xfe, 0xfb, 0xd1, 0x80, 0xf9, 0x07, 0xb5, 0xbe, 0xef, 0x85, 0x1e, 0x39, 0xc5, 0x97, 0x6a, 0xfc,
+	0xaf, 0x7e, 0x96, 0xf6, 0x1c, 0xd7, 0x33, 0xf9, 0xdf, 0xf8, 0xbd, 0x7e, 0xde, 0xf6, 0x6c, 0x8f,
+	0xff, 0x34, 0xa3, 0x5f, 0xb8, 0xba, 0x62, 0x7b, 0x9e, 0xdd, 0x65, 0x26, 0xed, 0x3b, 0x26, 0x75,
+	0x5d, 0x2f, 0xa4, 0xa1, 0xe3, 0xb9, 0x01, 0xbe, 0xdd, 0xb6, 0xbc, 0xa0, 0xe7, 0x05, 0x66, 0x9b,
+	0x06, 0x2c, 0x56, 0x66, 0x3e, 0xbe, 0xde, 0x66, 0x21, 0xbd, 0x6e, 0xf6, 0xa9, 0xed, 0xb8, 0x9c,
+	0x18, 0x69, 0xd7, 0x44, 0x60, 0xb4, 0xd3, 0xf1, 0x59, 0x10, 0xb4, 0x82, 0x90, 0x86, 0x83, 0x44,
+	0x5a, 0x55, 0xa4, 0xe8, 0x38, 0x41, 0xe8, 0x3b, 0xed, 0x81, 0x20, 0xe1, 0x92, 0xf2, 0xbd, 0xe7,
+	0x73, 0x29, 0x89, 0x90, 0x55, 0x91, 0xe8, 0x21, 0x63, 0x2d, 0xda, 0xed, 0x7a, 0x3f, 0xa4, 0xae,
+	0xc5, 0xb2, 0x08, 0xd8, 0x13, 0xd6, 0xeb, 0x0b, 0x6a, 0xaa, 0xa2, 0x51, 0x89, 0x39, 0x96, 0xe7,
+	0x24, 0xef, 0x2b, 0xa2, 0x80, 0x3e, 0xf5, 0x69, 0x2f, 0x50, 0x99, 0xd8, 0xa7, 0xd6, 0x23, 0x16,
+	0xb6, 0xbc, 0x41, 0x68, 0x79, 0xbd, 0x44, 0xf9, 0x8a, 0x48, 0xe1, 0xd3, 0x90, 0xb5, 0xba, 0x4e,
+	0xcf, 0x09, 0xf1, 0xed, 0xba, 0xf4, 0x96, 0xf5, 0xbc, 0x90, 0xb5, 0x42, 0x9f, 0xd1, 0x60, 0xe0,
+	0x1f, 0xa8, 0x48, 0x42, 0x9f, 0xba, 0xc1, 0x43, 0xe6, 0xb7, 0x62, 0x5d, 0x31, 0x89, 0x71, 0x1e,
+	0xc8, 0xf7, 0xa2, 0x50, 0xec, 0x71, 0x68, 0x4d, 0xf6, 0xd1, 0x80, 0x05, 0xa1, 0x71, 0x0f, 0xce,
+	0x49, 0xab, 0x41, 0xdf, 0x73, 0x03, 0x46, 0xde, 0x86, 0x85, 0xd8, 0x84, 0x8a, 0xb6, 0xa6, 0x6d,
+	0x9e, 0xba, 0x71, 0xae, 0x26, 0xa4, 0x49, 0x2d, 0x26, 0x6e, 0x94, 0x3f, 0xff, 0xe7, 0xea, 0xb1,
+	0x4f, 0xfe, 0xfb, 0x74, 0x5b, 0x6b, 0x22, 0xb5, 0x71, 0x0d, 0x2e, 0x70, 0x71, 0x77, 0x58, 0xf8,
+	0x9e, 0x10, 0x29, 0xd4, 0x46, 0x96, 0x60, 0xce, 0xe9, 0x70, 0x91, 0xf3, 0xcd, 0x39, 0xa7, 0x63,
+	0x58, 0xb0, 0xa2, 0x26, 0x47, 0x18, 0xef, 0xc2, 0x69, 0x71, 0x1d, 0xc1, 0x2c, 0x4b, 0x60, 0x44,
+	0x82, 0xc6, 0x7c, 0x04, 0xa9, 0x29, 0x31, 0x19, 0x3f, 0xd6, 0x10, 0x54, 0xbd, 0xdb, 0x55, 0x81,
+	0xda, 0x05, 0x18, 0x65, 0x25, 0xaa, 0xb8, 0x5c, 0x8b, 0xa3, 0x5d, 0x8b, 0xa2, 0x5d, 0x8b, 0xf7,
+	0x0b, 0xc6, 0xbc, 0xb6, 0x47, 0x6d, 0x86, 0xbc, 0x4d, 0x81, 0x93, 0xe8, 0x70, 0xd2, 0xa2, 0x21,
+	0xb3, 0x3d, 0xff, 0xa0, 0x32, 0xb7, 0xa6, 0x6d, 0x96, 0x9b, 0xe9, 0xb3, 0xf1, 0xa9, 0x06, 0x2b,
+	0x6a, 0x0c, 0x99, 0x96, 0x96, 0x8e, 0x6c, 0x29, 0xb9, 0x23, 0x59, 0x32, 0xc7, 0x2d, 0xb9, 0x32,
+	0xd5, 0x92, 0x18, 0x81, 0x68, 0x8a, 0xf1, 0xe5, 0x51, 0x5c, 0xea, 0xf1, 0x96, 0xbc, 0xcf, 0x77,
+	0x64, 0xe2, 0xb2, 0x0a, 0x9c, 0xc0, 0xad, 0xca, 0xfd, 0x55, 0x6e, 0x26, 0x8f, 0x86, 0x0d, 0x17,
+	0x33, 0x38, 0xd1, 0xd0, 0x5d, 0x58, 0xa4, 0xe2, 0x0b, 0x74, 0xb8, 0x2e, 0x59, 0x2a, 0xb1, 0xa2,
+	0xa9, 0x32, 0x9b, 0xf1, 0x70, 0xe4, 0x50, 0x25, 0xc4, 0x19, 0x45, 0xd5, 0x78, 0xaa, 0xc1, 0xc5,
+	0x0c, 0x45, 0xd9, 0x16, 0x95, 0x5e, 0xc2, 0xa2, 0xd9, 0x45, 0xaf, 0x01, 0xcb, 0x1c, 0x71, 0x9a,
+	0x1b, 0xec, 0x0e, 0x4d, 0xfd, 0xb2, 0x01, 0x4b, 0xee, 0xa0, 0xd7, 0xf2, 0x99, 0xe5, 0xf4, 0x1d,
+	0xe6, 0x86, 0x01, 0x6e, 0xc7, 0x45, 0x77, 0xd0, 0x6b, 0xa6, 0x8b, 0xc6, 0x67, 0x1a, 0xe8, 0x2a,
+	0x21, 0x68, 0xf3, 0x6b, 0x50, 0xb2, 0x69, 0xc2, 0x1a, 0xfd, 0x24, 0xdb, 0x70, 0xd6, 0xa6, 0x41,
+	0xab, 0xcf, 0xfc, 0x91, 0x6c, 0x6e, 0xc4, 0x7c, 0xf3, 0x8c, 0x4d, 0x83, 0x3d, 0xe6, 0xa7, 0xd2,
+	0xc9, 0x4d, 0x78, 0xa3, 0x47, 0x9f, 0x08, 0x18, 0x38, 0x5b, 0x2f, 0xb0, 0x2b, 0x25, 0xce, 0x70,
+	0xae, 0x47, 0x9f, 0x8c, 0xb0, 0xec, 0x31, 0xff, 0x5e, 0x60, 0x93, 0x75, 0x38, 0x1d, 0x01, 0xef,
+	0xb1, 0x20, 0xa0, 0x36, 0x0b, 0x2a, 0xf3, 0x9c, 0xf4, 0x94, 0x3b, 0xe8, 0xdd, 0xc3, 0x25, 0x63,
+	0x1f, 0xaa, 0x71, 0xa8, 0x06, 0xe1, 0xbe, 0xe7, 0x3b, 0x3f, 0x62, 0x9d, 0xba, 0x65, 0x79, 0x03,
+	0x37, 0x9c, 0x79, 0x56, 0x7c, 0xaa, 0xc1, 0x6a, 0xa6, 0x2a, 0xf4, 0xd1, 0x37, 0xe0, 0x24, 0xc5,
+	0x35, 0x4c, 0x89, 0xaa, 0x9c, 0x12, 0xe3, 0xac, 0x98, 0x16, 0x29, 0xd7, 0xec, 0x32, 0x62, 0x0d,
+	0x1d, 0xb3, 0xcb, 0x58, 0x3d, 0xb9, 0xf7, 0x1a, 0x83, 0x8e, 0xcd, 0xc2, 0xe4, 0x1e, 0xf8, 0x5b,
+	0x09, 0x56, 0x33, 0x49, 0xd0, 0xa0, 0x7d, 0x58, 0x68, 0xf3, 0x95, 0xf4, 0x74, 0x12, 0xa1, 0x24,
+	0x20, 0xde, 0xf5, 0x1c, 0xb7, 0xf1, 0x56, 0x64, 0xc9, 0xef, 0xff, 0xb5, 0xba, 0x69, 0x3b, 0xe1,
+	0xfe, 0xa0, 0x5d, 0xb3, 0xbc, 0x9e, 0x89, 0xf7, 0x67, 0xfc, 0xef, 0x5a, 0xd0, 0x79, 0x64, 0x86,
+	0x07, 0x7d, 0x16, 0x70, 0x86, 0x00, 0xaf, 0x91, 0x58, 0x3e, 0xb9, 0x0d, 0xc7, 0x07, 0x51, 0x48,
+	0xd1, 0x66, 0xd9, 0x6f, 0x22, 0xc2, 0x07, 0x11, 0x15, 0xfa, 0x2d, 0x66, 0x21, 0x2e, 0x94, 0x7d,
+	0xd6, 0xa3, 0x8e, 0xeb, 0xb8, 0x51, 0x3e, 0xbd, 0x1a, 0xa0, 0x23, 0x15, 0xd1, 0x59, 0x68, 0xfb,
+	0xd4, 0x0d, 0x99, 0xcf, 0x53, 0xb2, 0xdc, 0x4c, 0x1e, 0xc9, 0x01, 0x9c, 0xc1, 0x9f, 0xad, 0x36,
+	0xed, 0x46, 0x70, 0x2b, 0xc7, 0x5f, 0x11, 0x9e, 0x25, 0x54, 0xd4, 0x88, 0xf5, 0x18, 0x0f, 0x46,
+	0xc7, 0xf0, 0x07, 0x58, 0x0d, 0xec, 0xf1, 0x62, 0x40, 0x38, 0xc1, 0xad, 0x7d, 0xea, 0xba, 0xac,
+	0x9b, 0x9c, 0xe0, 0xf8, 0x18, 0x5d, 0x63, 0x41, 0x44, 0xe4, 0x5a, 0xb1, 0xfb, 0xe7, 0x9b, 0xe9,
+	0xb3, 0xf1, 0x08, 0xaa, 0x59, 0x62, 0x31, 0x47, 0xee, 0xc2, 0x52, 0x28, 0xbd, 0xc1, 0x4d, 0x76,
+	0x41, 0x0a, 0xa1, 0xcc, 0x8c, 0xf1, 0x1b, 0x63, 0x4c, 0xaf, 0x92, 0x7a, 0xb7, 0xab, 0xb6, 0x61,
+	0x56, 0x9b, 0xf9, 0x4f, 0x1a, 0x54, 0xb3, 0x34, 0xe5, 0x98, 0x55, 0x7a, 0x29, 0xb3, 0x66, 0xb7,
+	0xa9, 0xbf, 0x02, 0x95, 0x24, 0x18, 0x4d, 0x1a, 0xb2, 0xef, 0x44, 0x15, 0x63, 0xe2, 0x9a, 0x8b,
+	0x00, 0x18, 0xcf, 0x16, 0x16, 0x5c, 0xe5, 0x66, 0x19, 0x57, 0xee, 0x76, 0x8c, 0xef, 0xc3, 0xb2,
+	0x82, 0x15, 0x6d, 0xbd, 0x0d, 0x65, 0x3f, 0x59, 0x44, 0xaf, 0xbe, 0x21, 0x99, 0x99, 0xb2, 0xa0,
+	0x85, 0x23, 0x72, 0xa3, 0x8d, 0x98, 0xea, 0xdd, 0xee, 0x04, 0xa6, 0x59, 0x85, 0xeb, 0x63, 0x0d,
+	0x96, 0x15, 0x4a, 0xd4, 0xe8, 0x4b, 0x47, 0x40, 0x3f, 0xbb, 0xd0, 0xd4, 0xf1, 0xf2, 0x6c, 0xf2,
+	0x62, 0xfd, 0x03, 0xac, 0xd5, 0x13, 0x47, 0x5c, 0x82, 0x45, 0xcb, 0x73, 0x5d, 0x66, 0x45, 0xb4,
+	0xa3, 0xf8, 0x9c, 0x1e, 0x2d, 0xde, 0xed, 0x18, 0x1e, 0x5c, 0x50, 0x8a, 0x40, 0x33, 0x33, 0x2b,
+	0x30, 0xf2, 0x26, 0x9c, 0xe8, 0x7b, 0x7e, 0x18, 0xc9, 0x8d, 0xab, 0xd0, 0x85, 0xe8, 0xf1, 0x6e,
+	0x67, 0x2c, 0x27, 0x4a, 0xe3, 0x39, 0xf1, 0x03, 0x58, 0x4f, 0x73, 0x82, 0xeb, 0x54, 0xd5, 0xca,
+	0xf9, 0x79, 0x95, 0x7b, 0x76, 0x0c, 0xc1, 0xc8, 0x93, 0x8f, 0x76, 0x3d, 0x00, 0xe2, 0x4f, 0xbc,
+	0xc5, 0x64, 0x59, 0x95, 0xe3, 0x38, 0x41, 0x86, 0x01, 0x55, 0x08, 0x30, 0x1e, 0xc1, 0x7a, 0x9a,
+	0x32, 0x99, 0xc6, 0xcd, 0x2a, 0x41, 0xff, 0xa2, 0x81, 0x91, 0xa7, 0x6d, 0x8a, 0xa9, 0xa5, 0xff,
+	0xcb, 0xd4, 0xd9, 0x25, 0xb1, 0x3f, 0x6a, 0x02, 0xe2, 0xa3, 0xeb, 0xfd, 0xb8, 0x67, 0x4d, 0xdc,
+	0x25, 0x24, 0x9a, 0x96, 0x93, 0x68, 0x73, 0x79, 0x49, 0x52, 0x1a, 0x4b, 0x12, 0xa1, 0x7d, 0x18,
+	0xd3, 0x39, 0x2a, 0xb6, 0xfb, 0xe2, 0x0b, 0x65, 0xfb, 0x20, 0xb1, 0x26, 0xc5, 0xb6, 0xc4, 0x26,
+	0xb6, 0x0f, 0x4a, 0xe3, 0x5e, 0x45, 0xfb, 0x50, 0xd8, 0xa2, 0xd2, 0x4b, 0x58, 0x34, 0xbb, 0xb8,
+	0x77, 0xf0, 0xf0, 0xda, 0x63, 0x6e, 0xc7, 0x71, 0xed, 0x3d, 0x7a, 0xe0, 0x0d, 0x66, 0x5f, 0x41,
+	0xff, 0x31, 0xe9, 0xca, 0xc7, 0xd5, 0x7c, 0x51, 0xdd, 0x72, 0x0b, 0xaf, 0xb6, 0x5d, 0xc6, 0xbe,
+	0x99, 0x8c, 0x86, 0xa6, 0xf7, 0xc3, 0x3e, 0x2c, 0x2b, 0xb8, 0xd0, 0xc6, 0x77, 0x92, 0x32, 0x57,
+	0x55, 0x23, 0xa5, 0x1c, 0x8a, 0x1a, 0x77, 0x45, 0xac, 0x71, 0xe3, 0x83, 0x76, 0xb4, 0x60, 0xbc,
+	0x8e, 0x33, 0x9d, 0x06, 0x0d, 0xd8, 0x2e, 0x4b, 0xbc, 0x6f, 0x7c, 0x08, 0xe7, 0xe5, 0x65, 0x44,
+	0xd1, 0x80, 0x93, 0x91, 0x1f, 0x5a, 0x0f, 0x59, 0x02, 0x64, 0x45, 0x59, 0x9f, 0xbe, 0xc7, 0x2c,
+	0x5e, 0xa2, 0x0a, 0x63, 0x9f, 0x13, 0xed, 0x58, 0xd6, 0x8d, 0x3f, 0x2c, 0xc3, 0x71, 0x2e, 0x9c,
+	0x3c, 0x86, 0x85, 0x78, 0x3c, 0x44, 0xe4, 0x33, 0x6c, 0x72, 0xf6, 0xa4, 0xaf, 0x65, 0x13, 0xc4,
+	0xd0, 0x8c, 0xad, 0x9f, 0xfc, 0xfd, 0x3f, 0x3f, 0x9f, 0xbb, 0x44, 0xd6, 0xcd, 0xf7, 0x23, 0x9a,
+	0xfb, 0xcc, 0x7f, 0xec, 0x58, 0x2c, 0x30, 0x27, 0x47, 0x6d, 0xe4, 0x57, 0x9a, 0x3c, 0x41, 0x21,
+	0x9b, 0x93, 0xd2, 0xd5, 0x53, 0x29, 0x7d, 0xab, 0x00, 0x25, 0x02, 0xba, 0xc5, 0x01, 0xd5, 0xc8,
+	0x4e, 0x0e, 0x20, 0x71, 0x44, 0x69, 0x0e, 0x9d, 0xce, 0x21, 0xf9, 0xa5, 0x06, 0x67, 0x44, 0x71,
+	0xf5, 0x6e, 0x57, 0x05, 0x4f, 0x3d, 0x9f, 0xd2, 0xb7, 0x0a, 0x50, 0x22, 0x3c, 0x93, 0xc3, 0xdb,
+	0x22, 0x57, 0x0a, 0xc2, 0x23, 0xbf, 0xd1, 0x60, 0x51, 0x1a, 0x4d, 0x10, 0xb5, 0x33, 0x54, 0x23,
+	0x16, 0x7d, 0xbb, 0x08, 0x29, 0x22, 0xfb, 0x2a, 0x47, 0xf6, 0x16, 0xb9, 0x99, 0x83, 0x4c, 0x9e,
+	0xfe, 0x9a, 0x43, 0x7c, 0x3e, 0x24, 0xbf, 0xd6, 0xe0, 0x35, 0x49, 0x6c, 0xe4, 0x40, 0xb5, 0x5b,
+	0x8a, 0x02, 0xcd, 0x9a, 0xe6, 0x18, 0xd7, 0x39, 0xd0, 0xab, 0x64, 0xab, 0x30, 0x50, 0xf2, 0x5b,
+	0x0d, 0x16, 0xa5, 0x31, 0x09, 0xb9, 0x3c, 0xa9, 0x50, 0x35, 0x8c, 0xd1, 0xaf, 0x4c, 0xa5, 0x43,
+	0x54, 0x0d, 0x8e, 0xea, 0x6b, 0xe4, 0x76, 0x91, 0xc0, 0xb2, 0x96, 0x4d, 0x03, 0x73, 0x28, 0x8f,
+	0x79, 0x0e, 0xc9, 0xef, 0x34, 0x20, 0x93, 0xe3, 0x0a, 0x72, 0x55, 0xe1, 0x9c, 0xac, 0xf9, 0x89,
+	0xbe, 0x53, 0x8c, 0x18, 0x51, 0xbf, 0xcd, 0x51, 0x7f, 0x89, 0xd4, 0xf2, 0x7c, 0x99, 0xb2, 0xb7,
+	0xd2, 0xb9, 0xc7, 0x27, 0x1a, 0x90, 0xc9, 0x39, 0x84, 0x0a, 0x69, 0xe6, 0x40, 0x43, 0xdf, 0x29,
+	0x46, 0x8c, 0x48, 0xdf, 0xe1, 0x48, 0xaf, 0x13, 0x33, 0x07, 0xa9, 0xf4, 0xd5, 0xa0, 0x85, 0x93,
+	0x8a, 0xcf, 0x34, 0x58, 0x92, 0xdb, 0x3e, 0xa2, 0xde, 0x16, 0xca, 0x16, 0x56, 0xbf, 0x5a, 0x88,
+	0x16, 0x41, 0xde, 0xe1, 0x20, 0xeb, 0xe4, 0xeb, 0x39, 0x20, 0xc7, 0x66, 0xff, 0xe6, 0x10, 0xeb,
+	0xae, 0x43, 0x73, 0x98, 0x14, 0x59, 0x87, 0xe4, 0x63, 0x0d, 0xce, 0xca, 0x3a, 0xa2, 0x0d, 0xa5,
+	0xde, 0x25, 0x85, 0x71, 0x67, 0x36, 0xcf, 0xc6, 0x0d, 0x8e, 0x7b, 0x87, 0x6c, 0x17, 0xc7, 0x4d,
+	0x7e, 0xa1, 0x41, 0x39, 0xed, 0xd4, 0xc8, 0x86, 0xd2, 0x4d, 0xe3, 0x1d, 0xa6, 0x7e, 0x79, 0x1a,
+	0x19, 0x02, 0xba, 0xcd, 0x01, 0xdd, 0x22, 0x37, 0x72, 0x00, 0x8d, 0xbe, 0xc2, 0xa4, 0x3e, 0x6c,
+	0x45, 0x67, 0xf9, 0x4f, 0x35, 0x38, 0x9d, 0x4a, 0x8c, 0xdc, 0xb6, 0xa1, 0x74, 0x45, 0x11, 0x6c,
+	0xaa, 0xfe, 0xd5, 0xb8, 0xc6, 0xb1, 0x5d, 0x21, 0x1b, 0x85, 0xb0, 0x45, 0x9b, 0x7a, 0x49, 0x6e,
+	0x11, 0x89, 0xe2, 0x50, 0x51, 0xf6, 0xa1, 0xfa, 0xe6, 0x74, 0xc2, 0x23, 0x1c, 0x3f, 0x63, 0x1f,
+	0xa6, 0xcc, 0xa1, 0xd4, 0xe3, 0x1e, 0x92, 0xbf, 0x6a, 0x40, 0x26, 0x1b, 0x19, 0x52, 0x53, 0xc7,
+	0x2c, 0xab, 0x49, 0xd3, 0xcd, 0xc2, 0xf4, 0x88, 0xfd, 0xbb, 0x1c, 0xfb, 0xb7, 0xc8, 0xee, 0x74,
+	0xec, 0xf2, 0xcd, 0x2d, 0x44, 0x5d, 0xdc, 0x3c, 0x4f, 0x35, 0x78, 0x7d, 0x52, 0x5d, 0x94, 0x09,
+	0x35, 0x75, 0x88, 0x8f, 0x62, 0x4a, 0x6e, 0xc7, 0x58, 0xe8, 0x3c, 0x55, 0x98, 0x42, 0xfe, 0xac,
+	0xc1, 0xa2, 0x54, 0x2a, 0x67, 0xdc, 0xf2, 0xaa, 0x4e, 0x48, 0xdf, 0x2e, 0x42, 0x8a, 0x00, 0xef,
+	0x73, 0x80, 0xf7, 0xc8, 0xb7, 0x73, 0xeb, 0x35, 0xf1, 0x03, 0xa8, 0x39, 0xc4, 0x1e, 0xf2, 0x30,
+	0xd3, 0xe1, 0xd1, 0xed, 0x2f, 0xa9, 0xcb, 0xbe, 0xfd, 0x8b, 0x1a, 0x90, 0xd5, 0x8c, 0x15, 0xba,
+	0xfd, 0x65, 0x03, 0xa2, 0xe2, 0x6e, 0x49, 0xee, 0x61, 0x54, 0x3b, 0x50, 0xd9, 0x4c, 0xe9, 0x9b,
+	0xd3, 0x09, 0x8f, 0x70, 0x86, 0xf6, 0x63, 0xd6, 0x56, 0x1f, 0x61, 0x44, 0x25, 0xb1, 0xd8, 0x77,
+	0xa8, 0x8e, 0x2a, 0x45, 0x37, 0xa3, 0x5f, 0x9e, 0x46, 0x76, 0x84, 0x63, 0x54, 0xfa, 0x92, 0x2e,
+	0x94, 0x74, 0x43, 0x38, 0x81, 0x7d, 0x08, 0x51, 0xb4, 0x01, 0x72, 0xe7, 0xa2, 0xaf, 0xe7, 0x50,
+	0x20, 0x96, 0xab, 0x1c, 0xcb, 0x06, 0xb9, 0x94, 0x83, 0x25, 0xe9, 0x72, 0x1a, 0xbb, 0x9f, 0x3f,
+	0xaf, 0x6a, 0xcf, 0x9e, 0x57, 0xb5, 0x7f, 0x3f, 0xaf, 0x6a, 0x3f, 0x7b, 0x51, 0x3d, 0xf6, 0xec,
+	0x45, 0xf5, 0xd8, 0x3f, 0x5e, 0x54, 0x8f, 0x7d, 0xb8, 0x23, 0x8c, 0xdd, 0x55, 0x82, 0x9e, 0xe0,
+	0x7f, 0x3e, 0x80, 0x6f, 0x2f, 0xf0, 0x2f, 0xeb, 0x37, 0xff, 0x37, 0x00, 0x51, 0x6e, 0x05, 0x9d,
+	0x5d, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Distribution by id.
+	Distribution(ctx context.Context, in *QueryGetDistributionRequest, opts ...grpc.CallOption) (*QueryGetDistributionResponse, error)
+	// Queries a list of Distribution items, optionally filtered by category.
+	DistributionAll(ctx context.Context, in *QueryAllDistributionRequest, opts ...grpc.CallOption) (*QueryAllDistributionResponse, error)
+	// Queries the compliance status of an address.
+	AddressStatus(ctx context.Context, in *QueryGetAddressStatusRequest, opts ...grpc.CallOption) (*QueryGetAddressStatusResponse, error)
+	// Queries a list of AddressStatus items.
+	AddressStatusAll(ctx context.Context, in *QueryAllAddressStatusRequest, opts ...grpc.CallOption) (*QueryAllAddressStatusResponse, error)
+	// DistributeGas returns the gas charged for distributing to a number of
+	// recipients and how many messages are needed to stay under the cap.
+	DistributeGas(ctx context.Context, in *QueryDistributeGasRequest, opts ...grpc.CallOption) (*QueryDistributeGasResponse, error)
+	// AuthorizedAccounts lists the accounts holding a role in Params with
+	// their distribution statistics.
+	AuthorizedAccounts(ctx context.Context, in *QueryAuthorizedAccountsRequest, opts ...grpc.CallOption) (*QueryAuthorizedAccountsResponse, error)
+	// FeeAllowanceBudget returns the fee allowance budget and its usage.
+	FeeAllowanceBudget(ctx context.Context, in *QueryFeeAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryFeeAllowanceBudgetResponse, error)
+	// Queries the ICS-20 transfer sent to a cross-chain recipient.
+	TransferPacket(ctx context.Context, in *QueryGetTransferPacketRequest, opts ...grpc.CallOption) (*QueryGetTransferPacketResponse, error)
+	// Queries a list of TransferPacket items.
+	TransferPacketAll(ctx context.Context, in *QueryAllTransferPacketRequest, opts ...grpc.CallOption) (*QueryAllTransferPacketResponse, error)
+	// Queries the rate limit of an ICS-20 channel.
+	RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error)
+	// Queries the remote treasury of the module on the host chain of a
+	// connection.
+	RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error)
+	// Queries a RemoteDistribution by channel and sequence.
+	RemoteDistribution(ctx context.Context, in *QueryGetRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryGetRemoteDistributionResponse, error)
+	// Queries a list of RemoteDistribution items.
+	RemoteDistributionAll(ctx context.Context, in *QueryAllRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryAllRemoteDistributionResponse, error)
+	// Queries the outcome of a packet sent by the module.
+	PacketOutcome(ctx context.Context, in *QueryGetPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryGetPacketOutcomeResponse, error)
+	// Queries a list of PacketOutcome items.
+	PacketOutcomeAll(ctx context.Context, in *QueryAllPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryAllPacketOutcomeResponse, error)
+	// Queries the cross-chain payouts that await their acknowledgement.
+	PendingPayouts(ctx context.Context, in *QueryPendingPayoutsRequest, opts ...grpc.CallOption) (*QueryPendingPayoutsResponse, error)
+	// Queries the fee exempt txs an authorized account sent in the current
+	// window.
+	FeeExemption(ctx context.Context, in *QueryFeeExemptionRequest, opts ...grpc.CallOption) (*QueryFeeExemptionResponse, error)
+	// Queries the base fee per unit of gas of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Distribution(ctx context.Context, in *QueryGetDistributionRequest, opts ...grpc.CallOption) (*QueryGetDistributionResponse, error) {
+	out := new(QueryGetDistributionResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/Distribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionAll(ctx context.Context, in *QueryAllDistributionRequest, opts ...grpc.CallOption) (*QueryAllDistributionResponse, error) {
+	out := new(QueryAllDistributionResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/DistributionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStatus(ctx context.Context, in *QueryGetAddressStatusRequest, opts ...grpc.CallOption) (*QueryGetAddressStatusResponse, error) {
+	out := new(QueryGetAddressStatusResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/AddressStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStatusAll(ctx context.Context, in *QueryAllAddressStatusRequest, opts ...grpc.CallOption) (*QueryAllAddressStatusResponse, error) {
+	out := new(QueryAllAddressStatusResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/AddressStatusAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributeGas(ctx context.Context, in *QueryDistributeGasRequest, opts ...grpc.CallOption) (*QueryDistributeGasResponse, error) {
+	out := new(QueryDistributeGasResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/DistributeGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizedAccounts(ctx context.Context, in *QueryAuthorizedAccountsRequest, opts ...grpc.CallOption) (*QueryAuthorizedAccountsResponse, error) {
+	out := new(QueryAuthorizedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/AuthorizedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAllowanceBudget(ctx context.Context, in *QueryFeeAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryFeeAllowanceBudgetResponse, error) {
+	out := new(QueryFeeAllowanceBudgetResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/FeeAllowanceBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPacket(ctx context.Context, in *QueryGetTransferPacketRequest, opts ...grpc.CallOption) (*QueryGetTransferPacketResponse, error) {
+	out := new(QueryGetTransferPacketResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/TransferPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPacketAll(ctx context.Context, in *QueryAllTransferPacketRequest, opts ...grpc.CallOption) (*QueryAllTransferPacketResponse, error) {
+	out := new(QueryAllTransferPacketResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/TransferPacketAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimit(ctx context.Context, in *QueryGetRateLimitRequest, opts ...grpc.CallOption) (*QueryGetRateLimitResponse, error) {
+	out := new(QueryGetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error) {
+	out := new(QueryAllRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/RateLimitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error) {
+	out := new(QueryRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/RemoteTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteDistribution(ctx context.Context, in *QueryGetRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryGetRemoteDistributionResponse, error) {
+	out := new(QueryGetRemoteDistributionResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/RemoteDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteDistributionAll(ctx context.Context, in *QueryAllRemoteDistributionRequest, opts ...grpc.CallOption) (*QueryAllRemoteDistributionResponse, error) {
+	out := new(QueryAllRemoteDistributionResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/RemoteDistributionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketOutcome(ctx context.Context, in *QueryGetPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryGetPacketOutcomeResponse, error) {
+	out := new(QueryGetPacketOutcomeResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/PacketOutcome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketOutcomeAll(ctx context.Context, in *QueryAllPacketOutcomeRequest, opts ...grpc.CallOption) (*QueryAllPacketOutcomeResponse, error) {
+	out := new(QueryAllPacketOutcomeResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/PacketOutcomeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPayouts(ctx context.Context, in *QueryPendingPayoutsRequest, opts ...grpc.CallOption) (*QueryPendingPayoutsResponse, error) {
+	out := new(QueryPendingPayoutsResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/PendingPayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeExemption(ctx context.Context, in *QueryFeeExemptionRequest, opts ...grpc.CallOption) (*QueryFeeExemptionResponse, error) {
+	out := new(QueryFeeExemptionResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/FeeExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/optio.optio.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Distribution by id.
+	Distribution(context.Context, *QueryGetDistributionRequest) (*QueryGetDistributionResponse, error)
+	// Queries a list of Distribution items, optionally filtered by category.
+	DistributionAll(context.Context, *QueryAllDistributionRequest) (*QueryAllDistributionResponse, error)
+	// Queries the compliance status of an address.
+	AddressStatus(context.Context, *QueryGetAddressStatusRequest) (*QueryGetAddressStatusResponse, error)
+	// Queries a list of AddressStatus items.
+	AddressStatusAll(context.Context, *QueryAllAddressStatusRequest) (*QueryAllAddressStatusResponse, error)
+	// DistributeGas returns the gas charged for distributing to a number of
+	// recipients and how many messages are needed to stay under the cap.
+	DistributeGas(context.Context, *QueryDistributeGasRequest) (*QueryDistributeGasResponse, error)
+	// AuthorizedAccounts lists the accounts holding a role in Params with
+	// their distribution statistics.
+	AuthorizedAccounts(context.Context, *QueryAuthorizedAccountsRequest) (*QueryAuthorizedAccountsResponse, error)
+	// FeeAllowanceBudget returns the fee allowance budget and its usage.
+	FeeAllowanceBudget(context.Context, *QueryFeeAllowanceBudgetRequest) (*QueryFeeAllowanceBudgetResponse, error)
+	// Queries the ICS-20 transfer sent to a cross-chain recipient.
+	TransferPacket(context.Context, *QueryGetTransferPacketRequest) (*QueryGetTransferPacketResponse, error)
+	// Queries a list of TransferPacket items.
+	TransferPacketAll(context.Context, *QueryAllTransferPacketRequest) (*QueryAllTransferPacketResponse, error)
+	// Queries the rate limit of an ICS-20 channel.
+	RateLimit(context.Context, *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error)
+	// Queries a list of RateLimit items.
+	RateLimitAll(context.Context, *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error)
+	// Queries the remote treasury of the module on the host chain of a
+	// connection.
+	RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error)
+	// Queries a RemoteDistribution by channel and sequence.
+	RemoteDistribution(context.Context, *QueryGetRemoteDistributionRequest) (*QueryGetRemoteDistributionResponse, error)
+	// Queries a list of RemoteDistribution items.
+	RemoteDistributionAll(context.Context, *QueryAllRemoteDistributionRequest) (*QueryAllRemoteDistributionResponse, error)
+	// Queries the outcome of a packet sent by the module.
+	PacketOutcome(context.Context, *QueryGetPacketOutcomeRequest) (*QueryGetPacketOutcomeResponse, error)
+	// Queries a list of PacketOutcome items.
+	PacketOutcomeAll(context.Context, *QueryAllPacketOutcomeRequest) (*QueryAllPacketOutcomeResponse, error)
+	// Queries the cross-chain payouts that await their acknowledgement.
+	PendingPayouts(context.Context, *QueryPendingPayoutsRequest) (*QueryPendingPayoutsResponse, error)
+	// Queries the fee exempt txs an authorized account sent in the current
+	// window.
+	FeeExemption(context.Context, *QueryFeeExemptionRequest) (*QueryFeeExemptionResponse, error)
+	// Queries the base fee per unit of gas of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Distribution(ctx context.Context, req *QueryGetDistributionRequest) (*QueryGetDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (*UnimplementedQueryServer) DistributionAll(ctx context.Context, req *QueryAllDistributionRequest) (*QueryAllDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionAll not implemented")
+}
+func (*UnimplementedQueryServer) AddressStatus(ctx context.Context, req *QueryGetAddressStatusRequest) (*QueryGetAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStatus not implemented")
+}
+func (*UnimplementedQueryServer) AddressStatusAll(ctx context.Context, req *QueryAllAddressStatusRequest) (*QueryAllAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStatusAll not implemented")
+}
+func (*UnimplementedQueryServer) DistributeGas(ctx context.Context, req *QueryDistributeGasRequest) (*QueryDistributeGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeGas not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedAccounts(ctx context.Context, req *QueryAuthorizedAccountsRequest) (*QueryAuthorizedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) FeeAllowanceBudget(ctx context.Context, req *QueryFeeAllowanceBudgetRequest) (*QueryFeeAllowanceBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAllowanceBudget not implemented")
+}
+func (*UnimplementedQueryServer) TransferPacket(ctx context.Context, req *QueryGetTransferPacketRequest) (*QueryGetTransferPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPacket not implemented")
+}
+func (*UnimplementedQueryServer) TransferPacketAll(ctx context.Context, req *QueryAllTransferPacketRequest) (*QueryAllTransferPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPacketAll not implemented")
+}
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *QueryGetRateLimitRequest) (*QueryGetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitAll(ctx context.Context, req *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) RemoteTreasury(ctx context.Context, req *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteTreasury not implemented")
+}
+func (*UnimplementedQueryServer) RemoteDistribution(ctx context.Context, req *QueryGetRemoteDistributionRequest) (*QueryGetRemoteDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteDistribution not implemented")
+}
+func (*UnimplementedQueryServer) RemoteDistributionAll(ctx context.Context, req *QueryAllRemoteDistributionRequest) (*QueryAllRemoteDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteDistributionAll not implemented")
+}
+func (*UnimplementedQueryServer) PacketOutcome(ctx context.Context, req *QueryGetPacketOutcomeRequest) (*QueryGetPacketOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketOutcome not implemented")
+}
+func (*UnimplementedQueryServer) PacketOutcomeAll(ctx context.Context, req *QueryAllPacketOutcomeRequest) (*QueryAllPacketOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketOutcomeAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingPayouts(ctx context.Context, req *QueryPendingPayoutsRequest) (*QueryPendingPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPayouts not implemented")
+}
+func (*UnimplementedQueryServer) FeeExemption(ctx context.Context, req *QueryFeeExemptionRequest) (*QueryFeeExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeExemption not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/Distribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distribution(ctx, req.(*QueryGetDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/DistributionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionAll(ctx, req.(*QueryAllDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/AddressStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressStatus(ctx, req.(*QueryGetAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressStatusAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressStatusAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/AddressStatusAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressStatusAll(ctx, req.(*QueryAllAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributeGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributeGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributeGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/DistributeGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributeGas(ctx, req.(*QueryDistributeGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/AuthorizedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAccounts(ctx, req.(*QueryAuthorizedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAllowanceBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAllowanceBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAllowanceBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/FeeAllowanceBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAllowanceBudget(ctx, req.(*QueryFeeAllowanceBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransferPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/TransferPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPacket(ctx, req.(*QueryGetTransferPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPacketAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransferPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPacketAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/TransferPacketAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPacketAll(ctx, req.(*QueryAllTransferPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*QueryGetRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/RateLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitAll(ctx, req.(*QueryAllRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemoteTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/RemoteTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteTreasury(ctx, req.(*QueryRemoteTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemoteDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/RemoteDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteDistribution(ctx, req.(*QueryGetRemoteDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteDistributionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRemoteDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteDistributionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/RemoteDistributionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteDistributionAll(ctx, req.(*QueryAllRemoteDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPacketOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/PacketOutcome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketOutcome(ctx, req.(*QueryGetPacketOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketOutcomeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPacketOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketOutcomeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/PacketOutcomeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketOutcomeAll(ctx, req.(*QueryAllPacketOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/PendingPayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPayouts(ctx, req.(*QueryPendingPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/FeeExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeExemption(ctx, req.(*QueryFeeExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.optio.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "optio.optio.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Distribution",
+			Handler:    _Query_Distribution_Handler,
+		},
+		{
+			MethodName: "DistributionAll",
+			Handler:    _Query_DistributionAll_Handler,
+		},
+		{
+			MethodName: "AddressStatus",
+			Handler:    _Query_AddressStatus_Handler,
+		},
+		{
+			MethodName: "AddressStatusAll",
+			Handler:    _Query_AddressStatusAll_Handler,
+		},
+		{
+			MethodName: "DistributeGas",
+			Handler:    _Query_DistributeGas_Handler,
+		},
+		{
+			MethodName: "AuthorizedAccounts",
+			Handler:    _Query_AuthorizedAccounts_Handler,
+		},
+		{
+			MethodName: "FeeAllowanceBudget",
+			Handler:    _Query_FeeAllowanceBudget_Handler,
+		},
+		{
+			MethodName: "TransferPacket",
+			Handler:    _Query_TransferPacket_Handler,
+		},
+		{
+			MethodName: "TransferPacketAll",
+			Handler:    _Query_TransferPacketAll_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "RateLimitAll",
+			Handler:    _Query_RateLimitAll_Handler,
+		},
+		{
+			MethodName: "RemoteTreasury",
+			Handler:    _Query_RemoteTreasury_Handler,
+		},
+		{
+			MethodName: "RemoteDistribution",
+			Handler:    _Query_RemoteDistribution_Handler,
+		},
+		{
+			MethodName: "RemoteDistributionAll",
+			Handler:    _Query_RemoteDistributionAll_Handler,
+		},
+		{
+			MethodName: "PacketOutcome",
+			Handler:    _Query_PacketOutcome_Handler,
+		},
+		{
+			MethodName: "PacketOutcomeAll",
+			Handler:    _Query_PacketOutcomeAll_Handler,
+		},
+		{
+			MethodName: "PendingPayouts",
+			Handler:    _Query_PendingPayouts_Handler,
+		},
+		{
+			MethodName: "FeeExemption",
+			Handler:    _Query_FeeExemption_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "optio/optio/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAddressStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAddressStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAddressStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAddressStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAddressStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAddressStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AddressStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAddressStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAddressStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAddressStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAddressStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAddressStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAddressStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddressStatus) > 0 {
+		for iNdEx := len(m.AddressStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributeGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributeGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributeGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumRecipients != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumRecipients))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributeGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributeGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributeGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumMessages != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumMessages))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxRecipientsPerMsg != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxRecipientsPerMsg))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPerRecipient != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasPerRecipient))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAllowanceBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAllowanceBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAllowanceBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GranterBalance) > 0 {
+		for iNdEx := len(m.GranterBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GranterBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTransferPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTransferPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTransferPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTransferPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTransferPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTransferPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTransferPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTransferPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTransferPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferPacket) > 0 {
+		for iNdEx := len(m.TransferPacket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPacket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimit) > 0 {
+		for iNdEx := len(m.RateLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteTreasuryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemoteDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRemoteDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRemoteDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRemoteDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRemoteDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRemoteDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRemoteDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoteDistribution) > 0 {
+		for iNdEx := len(m.RemoteDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPacketOutcomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPacketOutcomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPacketOutcomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPacketOutcomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPacketOutcomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPacketOutcomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketOutcome.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPacketOutcomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPacketOutcomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPacketOutcomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPacketOutcomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPacketOutcomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPacketOutcomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketOutcome) > 0 {
+		for iNdEx := len(m.PacketOutcome) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketOutcome[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketOutcome) > 0 {
+		for iNdEx := len(m.PacketOutcome) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketOutcome[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeExemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeExemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeExemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeExemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeExemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeExemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAddressStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAddressStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AddressStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAddressStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAddressStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressStatus) > 0 {
+		for _, e := range m.AddressStatus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributeGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumRecipients != 0 {
+		n += 1 + sovQuery(uint64(m.NumRecipients))
+	}
+	return n
+}
+
+func (m *QueryDistributeGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.GasPerRecipient != 0 {
+		n += 1 + sovQuery(uint64(m.GasPerRecipient))
+	}
+	if m.MaxRecipientsPerMsg != 0 {
+		n += 1 + sovQuery(uint64(m.MaxRecipientsPerMsg))
+	}
+	if m.NumMessages != 0 {
+		n += 1 + sovQuery(uint64(m.NumMessages))
+	}
+	return n
+}
+
+func (m *QueryAuthorizedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthorizedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeAllowanceBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeAllowanceBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GranterBalance) > 0 {
+		for _, e := range m.GranterBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetTransferPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetTransferPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTransferPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTransferPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferPacket) > 0 {
+		for _, e := range m.TransferPacket {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimit) > 0 {
+		for _, e := range m.RateLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemoteTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemoteTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemoteDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRemoteDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRemoteDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteDistribution) > 0 {
+		for _, e := range m.RemoteDistribution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPacketOutcomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetPacketOutcomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketOutcome.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPacketOutcomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPacketOutcomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketOutcome) > 0 {
+		for _, e := range m.PacketOutcome {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPayoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPayoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketOutcome) > 0 {
+		for _, e := range m.PacketOutcome {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeExemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeExemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, Distribution{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAddressStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAddressStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAddressStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAddressStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAddressStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAddressStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAddressStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAddressStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAddressStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAddressStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAddressStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAddressStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressStatus = append(m.AddressStatus, AddressStatus{})
+			if err := m.AddressStatus[len(m.AddressStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributeGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributeGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributeGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRecipients", wireType)
+			}
+			m.NumRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRecipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributeGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributeGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributeGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerRecipient", wireType)
+			}
+			m.GasPerRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerRecipient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecipientsPerMsg", wireType)
+			}
+			m.MaxRecipientsPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecipientsPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMessages", wireType)
+			}
+			m.NumMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizedAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizedAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizedAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizedAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AuthorizedAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAllowanceBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAllowanceBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAllowanceBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAllowanceBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAllowanceBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAllowanceBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GranterBalance = append(m.GranterBalance, types.Coin{})
+			if err := m.GranterBalance[len(m.GranterBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTransferPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTransferPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTransferPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTransferPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTransferPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTransferPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransferPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransferPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransferPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTransferPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTransferPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTransferPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPacket = append(m.TransferPacket, TransferPacket{})
+			if err := m.TransferPacket[len(m.TransferPacket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimit = append(m.RateLimit, RateLimit{})
+			if err := m.RateLimit[len(m.RateLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemoteTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemoteTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRemoteDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRemoteDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRemoteDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRemoteDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRemoteDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRemoteDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoteDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRemoteDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRemoteDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRemoteDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRemoteDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRemoteDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRemoteDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteDistribution = append(m.RemoteDistribution, RemoteDistribution{})
+			if err := m.RemoteDistribution[len(m.RemoteDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPacketOutcomeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPacketOutcomeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPacketOutcomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPacketOutcomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPacketOutcomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPacketOutcomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketOutcome", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketOutcome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPacketOutcomeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPacketOutcomeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPacketOutcomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPacketOutcomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPacketOutcomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPacketOutcomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketOutcome", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketOutcome = append(m.PacketOutcome, PacketOutcome{})
+			if err := m.PacketOutcome[len(m.PacketOutcome)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPayoutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPayoutsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketOutcome", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketOutcome = append(m.PacketOutcome, PacketOutcome{})
+			if err := m.PacketOutcome[len(m.PacketOutcome)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeExemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeExemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeExemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeExemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeExemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeExemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// RateLimitBuckets is the number of buckets a rate limit window is divided
+// into.
+const RateLimitBuckets = 10
+
+// NewRateLimit returns the rate limit of a channel with an empty flow.
+func NewRateLimit(channelID string, quota RateLimitQuota) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Quota:     quota,
+		Flow:      newRateLimitFlow(nil),
+	}
+}
+
+// Validate checks that the caps are non-negative, that at least one of them
+// is enabled and that the window is positive.
+func (q RateLimitQuota) Validate() error {
+	if q.MaxPercent.IsNil() || q.MaxPercent.IsNegative() || q.MaxPercent.GT(math.LegacyNewDec(100)) {
+		return fmt.Errorf("max percent must be between 0 and 100: %s", q.MaxPercent)
+	}
+	if q.MaxAmount.IsNil() || q.MaxAmount.IsNegative() {
+		return fmt.Errorf("max amount cannot be negative: %s", q.MaxAmount)
+	}
+	if q.MaxPercent.IsZero() && q.MaxAmount.IsZero() {
+		return fmt.Errorf("max percent or max amount must be set")
+	}
+	if q.Window <= 0 {
+		return fmt.Errorf("window must be positive: %s", q.Window)
+	}
+	return nil
+}
+
+// Threshold returns the cap on the net flow for the given supply.
+func (q RateLimitQuota) Threshold(supply math.Int) math.Int {
+	threshold := q.MaxAmount
+	if q.MaxPercent.IsPositive() {
+		fromSupply := q.MaxPercent.MulInt(supply).QuoInt64(100).TruncateInt()
+		if threshold.IsZero() || fromSupply.LT(threshold) {
+			threshold = fromSupply
+		}
+	}
+	return threshold
+}
+
+// BucketDuration returns the duration of the buckets the window is divided
+// into.
+func (q RateLimitQuota) BucketDuration() time.Duration {
+	if bucket := q.Window / RateLimitBuckets; bucket > 0 {
+		return bucket
+	}
+	return q.Window
+}
+
+// Advance rolls the window forward to blockTime. The buckets that ended
+// before the window are dropped and a bucket is opened for blockTime with the
+// given supply. A bucket is only dropped once it ended a full window ago, so
+// the flow covers at least the last window.
+func (r RateLimit) Advance(blockTime time.Time, supply math.Int) RateLimit {
+	bucket := r.Quota.BucketDuration()
+	windowStart := blockTime.Add(-r.Quota.Window)
+
+	buckets := make([]RateLimitBucket, 0, len(r.Flow.Buckets)+1)
+	for _, b := range r.Flow.Buckets {
+		if b.Start.Add(bucket).After(windowStart) {
+			buckets = append(buckets, b)
+		}
+	}
+	if start := blockTime.Truncate(bucket); len(buckets) == 0 || buckets[len(buckets)-1].Start.Before(start) {
+		buckets = append(buckets, RateLimitBucket{
+			Start:   start,
+			Inflow:  math.ZeroInt(),
+			Outflow: math.ZeroInt(),
+			Supply:  supply,
+		})
+	}
+	r.Flow = newRateLimitFlow(buckets)
+	return r
+}
+
+// AddOutflow counts amount in the outflow of the current bucket, failing if
+// the net outflow over the window then exceeds the threshold. The window must
+// have been advanced to the block time.
+func (r RateLimit) AddOutflow(amount math.Int) (RateLimit, error) {
+	r.Flow = r.Flow.updateBucket(len(r.Flow.Buckets)-1, func(b *RateLimitBucket) { b.Outflow = b.Outflow.Add(amount) })
+	if net, threshold := r.Flow.Outflow.Sub(r.Flow.Inflow), r.Quota.Threshold(r.Flow.Supply); net.GT(threshold) {
+		return r, fmt.Errorf("net outflow %s over %s exceeds %s", net, r.ChannelId, threshold)
+	}
+	return r, nil
+}
+
+// AddInflow counts amount in the inflow of the current bucket, failing if the
+// net inflow over the window then exceeds the threshold, see AddOutflow.
+func (r RateLimit) AddInflow(amount math.Int) (RateLimit, error) {
+	r.Flow = r.Flow.updateBucket(len(r.Flow.Buckets)-1, func(b *RateLimitBucket) { b.Inflow = b.Inflow.Add(amount) })
+	if net, threshold := r.Flow.Inflow.Sub(r.Flow.Outflow), r.Quota.Threshold(r.Flow.Supply); net.GT(threshold) {
+		return r, fmt.Errorf("net inflow %s over %s exceeds %s", net, r.ChannelId, threshold)
+	}
+	return r, nil
+}
+
+// RevertOutflow removes amount from the outflow of the bucket started at
+// bucketStart. Nothing is reverted once the bucket left the window.
+func (r RateLimit) RevertOutflow(bucketStart time.Time, amount math.Int) RateLimit {
+	for i, b := range r.Flow.Buckets {
+		if b.Start.Equal(bucketStart) {
+			r.Flow = r.Flow.updateBucket(i, func(b *RateLimitBucket) {
+				b.Outflow = math.MaxInt(b.Outflow.Sub(amount), math.ZeroInt())
+			})
+			break
+		}
+	}
+	return r
+}
+
+// CurrentBucketStart returns the start of the bucket flows are counted in.
+func (r RateLimit) CurrentBucketStart() time.Time {
+	if len(r.Flow.Buckets) == 0 {
+		return time.Time{}
+	}
+	return r.Flow.Buckets[len(r.Flow.Buckets)-1].Start
+}
+
+// updateBucket returns the flow with the i-th bucket updated by fn and the
+// totals recomputed. The buckets are copied so that the receiver is left
+// unchanged.
+func (f RateLimitFlow) updateBucket(i int, fn func(*RateLimitBucket)) RateLimitFlow {
+	buckets := append([]RateLimitBucket(nil), f.Buckets...)
+	fn(&buckets[i])
+	return newRateLimitFlow(buckets)
+}
+
+// newRateLimitFlow returns the flow summed over the buckets of a window.
+func newRateLimitFlow(buckets []RateLimitBucket) RateLimitFlow {
+	flow := RateLimitFlow{
+		Inflow:  math.ZeroInt(),
+		Outflow: math.ZeroInt(),
+		Supply:  math.ZeroInt(),
+		Buckets: buckets,
+	}
+	for _, b := range buckets {
+		flow.Inflow = flow.Inflow.Add(b.Inflow)
+		flow.Outflow = flow.Outflow.Add(b.Outflow)
+	}
+	if len(buckets) > 0 {
+		flow.Supply = buckets[0].Supply
+		flow.WindowStart = buckets[0].Start
+	}
+	return flow
+}
+
+// Validate checks that a rate limit is well formed.
+func (r RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid rate limit channel %s: %w", r.ChannelId, err)
+	}
+	if err := r.Quota.Validate(); err != nil {
+		return fmt.Errorf("invalid rate limit quota for %s: %w", r.ChannelId, err)
+	}
+	for i, b := range r.Flow.Buckets {
+		for _, amount := range []math.Int{b.Inflow, b.Outflow, b.Supply} {
+			if amount.IsNil() || amount.IsNegative() {
+				return fmt.Errorf("invalid rate limit bucket for %s: %v", r.ChannelId, b)
+			}
+		}
+		if i > 0 && !b.Start.After(r.Flow.Buckets[i-1].Start) {
+			return fmt.Errorf("rate limit buckets for %s are not ordered", r.ChannelId)
+		}
+	}
+	expected := newRateLimitFlow(r.Flow.Buckets)
+	if !equalInt(r.Flow.Inflow, expected.Inflow) || !equalInt(r.Flow.Outflow, expected.Outflow) ||
+		!equalInt(r.Flow.Supply, expected.Supply) || !r.Flow.WindowStart.Equal(expected.WindowStart) {
+		return fmt.Errorf("invalid rate limit flow for %s: %v does not match its buckets", r.ChannelId, r.Flow)
+	}
+	return nil
+}
+
+// Validate checks that a pending packet is well formed.
+func (p RateLimitPendingPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel %s: %w", p.ChannelId, err)
+	}
+	if p.Sequence == 0 {
+		return fmt.Errorf("rate limit pending packet on %s has no sequence", p.ChannelId)
+	}
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s for rate limit pending packet %s/%d", p.Amount, p.ChannelId, p.Sequence)
+	}
+	return nil
+}
+
+// equalInt compares two amounts that may be nil.
+func equalInt(a, b math.Int) bool {
+	return !a.IsNil() && !b.IsNil() && a.Equal(b)
+}
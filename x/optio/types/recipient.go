@@ -0,0 +1,109 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// IsCrossChain reports whether the recipient is paid with an ICS-20 transfer
+// over SourceChannel instead of a local bank send.
+func (r Recipient) IsCrossChain() bool {
+	return r.SourceChannel != ""
+}
+
+// Account returns the account credited by the distribution: the local
+// address, or the receiver on the counterparty chain.
+func (r Recipient) Account() string {
+	if r.IsCrossChain() {
+		return r.Receiver
+	}
+	return r.Address
+}
+
+// ValidateAccount checks an account that distributions can credit, see
+// Recipient.Account, as named by compliance statuses and distribute
+// authorizations. Accounts with the local address prefix have to be valid
+// local addresses; any other account is the receiver of cross-chain
+// recipients, whose format is up to the counterparty chain, and is only
+// bounded like an ICS-20 receiver.
+func ValidateAccount(account string) error {
+	if _, err := sdk.AccAddressFromBech32(account); err == nil {
+		return nil
+	} else if strings.HasPrefix(account, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s (%s)", account, err)
+	}
+
+	if account == "" || strings.TrimSpace(account) != account {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver %q", account)
+	}
+	if len(account) > transfertypes.MaximumReceiverLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "receiver must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+	}
+	return nil
+}
+
+// Validate checks the destination and amount of a recipient. Local
+// recipients need a valid address; cross-chain recipients a channel and a
+// receiver instead.
+func (r Recipient) Validate() error {
+	if r.IsCrossChain() {
+		if r.Address != "" {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "cross-chain recipient %s cannot have a local address", r.Receiver)
+		}
+		if err := host.ChannelIdentifierValidator(r.SourceChannel); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "invalid source channel %s (%s)", r.SourceChannel, err)
+		}
+		if r.Receiver == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "missing receiver for channel %s", r.SourceChannel)
+		}
+		if len(r.Receiver) > transfertypes.MaximumReceiverLength {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "receiver must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+		}
+	} else {
+		if r.Receiver != "" {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "receiver %s requires a source channel", r.Receiver)
+		}
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s (%s)", r.Address, err)
+		}
+	}
+
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount for recipient %s must be positive", r.Account())
+	}
+	return nil
+}
+
+// RecipientValues dereferences a recipient list for storage.
+func RecipientValues(recipients []*Recipient) []Recipient {
+	values := make([]Recipient, 0, len(recipients))
+	for _, recipient := range recipients {
+		values = append(values, *recipient)
+	}
+	return values
+}
+
+// RecipientAddresses returns the accounts of all recipients of the message,
+// see Recipient.Account.
+func (msg *MsgDistribute) RecipientAddresses() []string {
+	addresses := make([]string, 0, len(msg.Recipients))
+	for _, recipient := range msg.Recipients {
+		addresses = append(addresses, recipient.Account())
+	}
+	return addresses
+}
+
+// HasCrossChainRecipients reports whether any recipient is paid over IBC.
+func (msg *MsgDistribute) HasCrossChainRecipients() bool {
+	for _, recipient := range msg.Recipients {
+		if recipient.IsCrossChain() {
+			return true
+		}
+	}
+	return false
+}
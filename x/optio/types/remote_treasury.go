@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// RemoteTreasuryOwner owns the interchain accounts of the module. There is a
+// single remote treasury per connection.
+const RemoteTreasuryOwner = ModuleName
+
+// RemoteTreasuryPortID returns the interchain accounts controller port of the
+// remote treasuries.
+func RemoteTreasuryPortID() string {
+	portID, err := icatypes.NewControllerPortID(RemoteTreasuryOwner)
+	if err != nil {
+		panic(err)
+	}
+	return portID
+}
+
+// ValidateOutputs checks the outputs of a remote multi-send. The addresses
+// belong to the host chain and are not decoded.
+func ValidateOutputs(outputs []banktypes.Output) error {
+	if len(outputs) == 0 {
+		return fmt.Errorf("no outputs")
+	}
+	for _, output := range outputs {
+		if output.Address == "" {
+			return fmt.Errorf("output without address")
+		}
+		if !output.Coins.IsValid() || output.Coins.IsZero() {
+			return fmt.Errorf("invalid coins %s for %s", output.Coins, output.Address)
+		}
+	}
+	return nil
+}
+
+// TotalOutputs returns the coins sent to all outputs.
+func TotalOutputs(outputs []banktypes.Output) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, output := range outputs {
+		total = total.Add(output.Coins...)
+	}
+	return total
+}
+
+// IsPending reports whether the distribution still awaits its
+// acknowledgement.
+func (d RemoteDistribution) IsPending() bool {
+	return d.Status == REMOTE_DISTRIBUTION_STATUS_PENDING
+}
+
+// Validate checks that a tracked remote distribution is well formed.
+func (d RemoteDistribution) Validate() error {
+	if err := host.ChannelIdentifierValidator(d.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel %s: %w", d.ChannelId, err)
+	}
+	if d.Sequence == 0 {
+		return fmt.Errorf("remote distribution on %s has no sequence", d.ChannelId)
+	}
+	if err := host.ConnectionIdentifierValidator(d.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection %s for remote distribution %s/%d: %w", d.ConnectionId, d.ChannelId, d.Sequence, err)
+	}
+	if d.Treasury == "" {
+		return fmt.Errorf("remote distribution %s/%d has no treasury", d.ChannelId, d.Sequence)
+	}
+	if err := ValidateOutputs(d.Outputs); err != nil {
+		return fmt.Errorf("remote distribution %s/%d: %w", d.ChannelId, d.Sequence, err)
+	}
+	if _, ok := RemoteDistributionStatus_name[int32(d.Status)]; !ok || d.Status == REMOTE_DISTRIBUTION_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %s for remote distribution %s/%d", d.Status, d.ChannelId, d.Sequence)
+	}
+	return nil
+}
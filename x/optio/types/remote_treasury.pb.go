@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/remote_treasury.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemoteDistributionStatus is the lifecycle state of a multi-send executed by
+// the remote treasury of the module on a host chain.
+type RemoteDistributionStatus int32
+
+const (
+	REMOTE_DISTRIBUTION_STATUS_UNSPECIFIED RemoteDistributionStatus = 0
+	// REMOTE_DISTRIBUTION_STATUS_PENDING means the packet has not been
+	// acknowledged yet.
+	REMOTE_DISTRIBUTION_STATUS_PENDING RemoteDistributionStatus = 1
+	// REMOTE_DISTRIBUTION_STATUS_COMPLETED means the host chain executed the
+	// multi-send.
+	REMOTE_DISTRIBUTION_STATUS_COMPLETED RemoteDistributionStatus = 2
+	// REMOTE_DISTRIBUTION_STATUS_FAILED means the host chain returned an error
+	// acknowledgement, nothing was sent.
+	REMOTE_DISTRIBUTION_STATUS_FAILED RemoteDistributionStatus = 3
+	// REMOTE_DISTRIBUTION_STATUS_TIMEOUT means the packet timed out, nothing
+	// was sent.
+	REMOTE_DISTRIBUTION_STATUS_TIMEOUT RemoteDistributionStatus = 4
+)
+
+var RemoteDistributionStatus_name = map[int32]string{
+	0: "REMOTE_DISTRIBUTION_STATUS_UNSPECIFIED",
+	1: "REMOTE_DISTRIBUTION_STATUS_PENDING",
+	2: "REMOTE_DISTRIBUTION_STATUS_COMPLETED",
+	3: "REMOTE_DISTRIBUTION_STATUS_FAILED",
+	4: "REMOTE_DISTRIBUTION_STATUS_TIMEOUT",
+}
+
+var RemoteDistributionStatus_value = map[string]int32{
+	"REMOTE_DISTRIBUTION_STATUS_UNSPECIFIED": 0,
+	"REMOTE_DISTRIBUTION_STATUS_PENDING":     1,
+	"REMOTE_DISTRIBUTION_STATUS_COMPLETED":   2,
+	"REMOTE_DISTRIBUTION_STATUS_FAILED":      3,
+	"REMOTE_DISTRIBUTION_STATUS_TIMEOUT":     4,
+}
+
+func (x RemoteDistributionStatus) String() string {
+	return proto.EnumName(RemoteDistributionStatus_name, int32(x))
+}
+
+func (RemoteDistributionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00eeb7c1c6059931, []int{0}
+}
+
+// RemoteDistribution tracks a multi-send executed by the remote treasury of
+// the module, the interchain account it owns on a host chain.
+type RemoteDistribution struct {
+	ChannelId    string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// from_address is the account that requested the distribution.
+	FromAddress string `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// treasury is the address of the interchain account on the host chain.
+	Treasury string                   `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	Outputs  []types.Output           `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs"`
+	Memo     string                   `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	Status   RemoteDistributionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=optio.optio.RemoteDistributionStatus" json:"status,omitempty"`
+	// error is the error acknowledgement of a failed distribution.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the block height of the latest status change.
+	Height int64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RemoteDistribution) Reset()         { *m = RemoteDistribution{} }
+func (m *RemoteDistribution) String() string { return proto.CompactTextString(m) }
+func (*RemoteDistribution) ProtoMessage()    {}
+func (*RemoteDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00eeb7c1c6059931, []int{0}
+}
+func (m *RemoteDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteDistribution.Merge(m, src)
+}
+func (m *RemoteDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteDistribution proto.InternalMessageInfo
+
+func (m *RemoteDistribution) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *RemoteDistribution) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetOutputs() []types.Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *RemoteDistribution) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetStatus() RemoteDistributionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REMOTE_DISTRIBUTION_STATUS_UNSPECIFIED
+}
+
+func (m *RemoteDistribution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RemoteDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("optio.optio.RemoteDistributionStatus", RemoteDistributionStatus_name, RemoteDistributionStatus_value)
+	proto.RegisterType((*RemoteDistribution)(nil), "optio.optio.RemoteDistribution")
+}
+
+func init() { proto.RegisterFile("optio/optio/remote_treasury.proto", fileDescriptor_00eeb7c1c6059931) }
+
+var fileDescriptor_00eeb7c1c6059931 = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xf5, 0x26, 0x69, 0xda, 0x6c, 0xfa, 0x7d, 0x0a, 0xab, 0x0a, 0x59, 0x41, 0x98, 0xa4, 0xd0,
+	0xca, 0x8a, 0x90, 0xad, 0x96, 0x33, 0x12, 0x49, 0xed, 0xa0, 0x95, 0x9a, 0x38, 0xb2, 0x9d, 0x0b,
+	0x17, 0xcb, 0x71, 0x96, 0xc4, 0x02, 0x7b, 0xc3, 0xee, 0xba, 0xa2, 0xff, 0x80, 0x23, 0xff, 0x81,
+	0x0b, 0x47, 0x7e, 0x46, 0x8f, 0x3d, 0x72, 0x42, 0x28, 0x39, 0x70, 0xe2, 0xca, 0x19, 0x79, 0xed,
+	0x16, 0x24, 0x44, 0xb8, 0x8c, 0xe7, 0xbd, 0x79, 0x33, 0xa3, 0x79, 0xf2, 0xc2, 0x2e, 0x5d, 0x89,
+	0x98, 0x9a, 0x45, 0x64, 0x24, 0xa1, 0x82, 0x04, 0x82, 0x91, 0x90, 0x67, 0xec, 0xd2, 0x58, 0x31,
+	0x2a, 0x28, 0x6a, 0xca, 0xa2, 0x21, 0x63, 0xfb, 0x4e, 0x98, 0xc4, 0x29, 0x35, 0x65, 0x2c, 0xea,
+	0x6d, 0x2d, 0xa2, 0x3c, 0xa1, 0xdc, 0x9c, 0x85, 0xe9, 0x2b, 0xf3, 0xe2, 0x64, 0x46, 0x44, 0x78,
+	0x22, 0x41, 0x59, 0x3f, 0x58, 0xd0, 0x05, 0x95, 0xa9, 0x99, 0x67, 0x05, 0x7b, 0xf8, 0xa3, 0x02,
+	0x91, 0x2b, 0xf7, 0x59, 0x31, 0x17, 0x2c, 0x9e, 0x65, 0x22, 0xa6, 0x29, 0xba, 0x0f, 0x61, 0xb4,
+	0x0c, 0xd3, 0x94, 0xbc, 0x0e, 0xe2, 0xb9, 0x0a, 0x3a, 0x40, 0x6f, 0xb8, 0x8d, 0x92, 0xc1, 0x73,
+	0xd4, 0x86, 0x7b, 0x9c, 0xbc, 0xc9, 0x48, 0x1a, 0x11, 0xb5, 0xd2, 0x01, 0x7a, 0xcd, 0xbd, 0xc5,
+	0xe8, 0x21, 0xfc, 0x2f, 0xa2, 0x69, 0x4a, 0xa2, 0x7c, 0x50, 0xde, 0x5d, 0x95, 0xdd, 0xfb, 0xbf,
+	0x48, 0x3c, 0x47, 0x5d, 0xb8, 0xff, 0x92, 0xd1, 0x24, 0x08, 0xe7, 0x73, 0x46, 0x38, 0x57, 0x6b,
+	0x52, 0xd3, 0xcc, 0xb9, 0x7e, 0x41, 0xe5, 0x3b, 0x6e, 0x1c, 0x50, 0x77, 0x64, 0xf9, 0x16, 0xa3,
+	0x67, 0x70, 0x97, 0x66, 0x62, 0x95, 0x09, 0xae, 0xd6, 0x3b, 0x55, 0xbd, 0x79, 0x7a, 0xcf, 0x28,
+	0xae, 0x37, 0xe4, 0xc1, 0xe5, 0xf5, 0x86, 0x23, 0x35, 0x83, 0xc6, 0xd5, 0x97, 0x07, 0xca, 0xc7,
+	0x6f, 0x9f, 0x7a, 0xc0, 0xbd, 0x69, 0x43, 0x08, 0xd6, 0x12, 0x92, 0x50, 0x75, 0x57, 0x4e, 0x96,
+	0x39, 0x7a, 0x0a, 0xeb, 0x5c, 0x84, 0x22, 0xe3, 0xea, 0x5e, 0x07, 0xe8, 0xff, 0x9f, 0x1e, 0x19,
+	0xbf, 0x59, 0x6e, 0xfc, 0xe9, 0x92, 0x27, 0xc5, 0x6e, 0xd9, 0x84, 0x0e, 0xe0, 0x0e, 0x61, 0x8c,
+	0x32, 0xb5, 0x21, 0x67, 0x16, 0x00, 0xdd, 0x85, 0xf5, 0x25, 0x89, 0x17, 0x4b, 0xa1, 0xc2, 0x0e,
+	0xd0, 0xab, 0x6e, 0x89, 0x7a, 0xdf, 0x01, 0x54, 0xff, 0x36, 0x12, 0xf5, 0xe0, 0xb1, 0x6b, 0x8f,
+	0x1c, 0xdf, 0x0e, 0x2c, 0xec, 0xf9, 0x2e, 0x1e, 0x4c, 0x7d, 0xec, 0x8c, 0x03, 0xcf, 0xef, 0xfb,
+	0x53, 0x2f, 0x98, 0x8e, 0xbd, 0x89, 0x7d, 0x86, 0x87, 0xd8, 0xb6, 0x5a, 0x0a, 0x3a, 0x86, 0x87,
+	0x5b, 0xb4, 0x13, 0x7b, 0x6c, 0xe1, 0xf1, 0xf3, 0x16, 0x40, 0x3a, 0x7c, 0xb4, 0x45, 0x77, 0xe6,
+	0x8c, 0x26, 0xe7, 0xb6, 0x6f, 0x5b, 0xad, 0x0a, 0x3a, 0x82, 0xdd, 0x2d, 0xca, 0x61, 0x1f, 0x9f,
+	0xdb, 0x56, 0xab, 0xfa, 0x8f, 0xc5, 0x3e, 0x1e, 0xd9, 0xce, 0xd4, 0x6f, 0xd5, 0xda, 0xb5, 0x77,
+	0x1f, 0x34, 0x65, 0x30, 0xbc, 0x5a, 0x6b, 0xe0, 0x7a, 0xad, 0x81, 0xaf, 0x6b, 0x0d, 0xbc, 0xdf,
+	0x68, 0xca, 0xf5, 0x46, 0x53, 0x3e, 0x6f, 0x34, 0xe5, 0xc5, 0xe3, 0x45, 0x2c, 0x96, 0xd9, 0xcc,
+	0x88, 0x68, 0x62, 0x3a, 0xb9, 0xd5, 0x1e, 0x61, 0x17, 0x71, 0x44, 0x78, 0xf9, 0x1c, 0xde, 0x96,
+	0x5f, 0x71, 0xb9, 0x22, 0x7c, 0x56, 0x97, 0xff, 0xed, 0x93, 0x9f, 0x03, 0x00, 0x2e, 0xbf, 0xcb,
+	0x4e, 0x32, 0x03, 0x00, 0x00,
+}
+
+func (m *RemoteDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRemoteTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemoteTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemoteTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemoteDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRemoteTreasury(uint64(m.Sequence))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovRemoteTreasury(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRemoteTreasury(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRemoteTreasury(uint64(m.Height))
+	}
+	return n
+}
+
+func sovRemoteTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemoteTreasury(x uint64) (n int) {
+	return sovRemoteTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemoteDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types.Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RemoteDistributionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemoteTreasury(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemoteTreasury
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemoteTreasury
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemoteTreasury
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemoteTreasury
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemoteTreasury        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemoteTreasury          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemoteTreasury = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewSupplyRecord returns an empty supply record.
+func NewSupplyRecord() SupplyRecord {
+	return SupplyRecord{Minted: math.ZeroInt(), Burned: math.ZeroInt(), BaseFeeBurned: math.ZeroInt()}
+}
+
+// WithDefaults returns the record with unset amounts replaced by zero, as
+// decoded from a genesis file without a supply record.
+func (r SupplyRecord) WithDefaults() SupplyRecord {
+	if r.Minted.IsNil() {
+		r.Minted = math.ZeroInt()
+	}
+	if r.Burned.IsNil() {
+		r.Burned = math.ZeroInt()
+	}
+	if r.BaseFeeBurned.IsNil() {
+		r.BaseFeeBurned = math.ZeroInt()
+	}
+	return r
+}
+
+// Supply returns the amount in circulation according to the record.
+func (r SupplyRecord) Supply() math.Int {
+	r = r.WithDefaults()
+	return r.Minted.Sub(r.Burned).Sub(r.BaseFeeBurned)
+}
+
+// IsZero reports whether nothing was minted or burned yet.
+func (r SupplyRecord) IsZero() bool {
+	r = r.WithDefaults()
+	return r.Minted.IsZero() && r.Burned.IsZero() && r.BaseFeeBurned.IsZero()
+}
+
+// Validate checks that the amounts are non-negative and that the module
+// burned no more than it minted. The burned base fee is not bounded by the
+// minted amount.
+func (r SupplyRecord) Validate() error {
+	r = r.WithDefaults()
+	if r.Minted.IsNegative() || r.Burned.IsNegative() || r.BaseFeeBurned.IsNegative() {
+		return fmt.Errorf("invalid supply record: minted %s, burned %s, base fee burned %s", r.Minted, r.Burned, r.BaseFeeBurned)
+	}
+	if r.Burned.GT(r.Minted) {
+		return fmt.Errorf("burned %s exceeds minted %s", r.Burned, r.Minted)
+	}
+	return nil
+}
+
+// ModuleFlows sums the mints and burns of the module: every mint pays out a
+// distribution of the history and every burn is the refund of a tracked
+// transfer. The burned base fee has no history to sum.
+func ModuleFlows(distributions []Distribution, transfers []TransferPacket) (minted, burned math.Int) {
+	minted, burned = math.ZeroInt(), math.ZeroInt()
+	for _, distribution := range distributions {
+		minted = minted.Add(distribution.Amount)
+	}
+	for _, transfer := range transfers {
+		if transfer.IsRefunded() {
+			burned = burned.Add(transfer.Token.Amount)
+		}
+	}
+	return minted, burned
+}
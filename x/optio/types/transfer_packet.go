@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// TransferSenderAddress sends the ICS-20 transfers to cross-chain recipients.
+// The module account itself is a blocked address and may not send transfers,
+// so the minted tokens are moved to this derived account first.
+var TransferSenderAddress = sdk.AccAddress(address.Module(ModuleName, []byte("ics20")))
+
+// IsPending reports whether the transfer still awaits its acknowledgement.
+func (p TransferPacket) IsPending() bool {
+	return p.Status == TRANSFER_STATUS_PENDING
+}
+
+// IsRefunded reports whether the transfer failed and its refund was burned.
+func (p TransferPacket) IsRefunded() bool {
+	return p.Status == TRANSFER_STATUS_REFUNDED_TIMEOUT || p.Status == TRANSFER_STATUS_REFUNDED_ERROR
+}
+
+// Validate checks that a tracked transfer is well formed.
+func (p TransferPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.Channel); err != nil {
+		return fmt.Errorf("invalid channel %s: %w", p.Channel, err)
+	}
+	if p.Sequence == 0 {
+		return fmt.Errorf("transfer packet on %s has no sequence", p.Channel)
+	}
+	if p.Receiver == "" {
+		return fmt.Errorf("transfer packet %s/%d has no receiver", p.Channel, p.Sequence)
+	}
+	if err := p.Token.Validate(); err != nil || !p.Token.IsPositive() {
+		return fmt.Errorf("invalid token %s for transfer packet %s/%d", p.Token, p.Channel, p.Sequence)
+	}
+	if _, ok := TransferStatus_name[int32(p.Status)]; !ok || p.Status == TRANSFER_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %s for transfer packet %s/%d", p.Status, p.Channel, p.Sequence)
+	}
+	return nil
+}
@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/optio/transfer_packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferStatus is the lifecycle state of an ICS-20 transfer sent to a
+// cross-chain recipient.
+type TransferStatus int32
+
+const (
+	TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	// TRANSFER_STATUS_PENDING means the packet has not been acknowledged yet.
+	TRANSFER_STATUS_PENDING TransferStatus = 1
+	// TRANSFER_STATUS_COMPLETED means the counterparty acknowledged the
+	// transfer successfully.
+	TRANSFER_STATUS_COMPLETED TransferStatus = 2
+	// TRANSFER_STATUS_REFUNDED_TIMEOUT means the packet timed out and the
+	// amount was refunded and burned.
+	TRANSFER_STATUS_REFUNDED_TIMEOUT TransferStatus = 3
+	// TRANSFER_STATUS_REFUNDED_ERROR means the counterparty returned an error
+	// acknowledgement and the amount was refunded and burned.
+	TRANSFER_STATUS_REFUNDED_ERROR TransferStatus = 4
+)
+
+var TransferStatus_name = map[int32]string{
+	0: "TRANSFER_STATUS_UNSPECIFIED",
+	1: "TRANSFER_STATUS_PENDING",
+	2: "TRANSFER_STATUS_COMPLETED",
+	3: "TRANSFER_STATUS_REFUNDED_TIMEOUT",
+	4: "TRANSFER_STATUS_REFUNDED_ERROR",
+}
+
+var TransferStatus_value = map[string]int32{
+	"TRANSFER_STATUS_UNSPECIFIED":      0,
+	"TRANSFER_STATUS_PENDING":          1,
+	"TRANSFER_STATUS_COMPLETED":        2,
+	"TRANSFER_STATUS_REFUNDED_TIMEOUT": 3,
+	"TRANSFER_STATUS_REFUNDED_ERROR":   4,
+}
+
+func (x TransferStatus) String() string {
+	return proto.EnumName(TransferStatus_name, int32(x))
+}
+
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d6ba55b457e27b55, []int{0}
+}
+
+// TransferPacket tracks the ICS-20 transfer sent to a cross-chain recipient
+// of a distribution.
+type TransferPacket struct {
+	Channel        string         `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence       uint64         `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DistributionId uint64         `protobuf:"varint,3,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Receiver       string         `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Token          types.Coin     `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+	Status         TransferStatus `protobuf:"varint,6,opt,name=status,proto3,enum=optio.optio.TransferStatus" json:"status,omitempty"`
+	// height is the block height of the latest status change.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransferPacket) Reset()         { *m = TransferPacket{} }
+func (m *TransferPacket) String() string { return proto.CompactTextString(m) }
+func (*TransferPacket) ProtoMessage()    {}
+func (*TransferPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6ba55b457e27b55, []int{0}
+}
+func (m *TransferPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPacket.Merge(m, src)
+}
+func (m *TransferPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPacket proto.InternalMessageInfo
+
+func (m *TransferPacket) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *TransferPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TransferPacket) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *TransferPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TransferPacket) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *TransferPacket) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (m *TransferPacket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("optio.optio.TransferStatus", TransferStatus_name, TransferStatus_value)
+	proto.RegisterType((*TransferPacket)(nil), "optio.optio.TransferPacket")
+}
+
+func init() { proto.RegisterFile("optio/optio/transfer_packet.proto", fileDescriptor_d6ba55b457e27b55) }
+
+var fileDescriptor_d6ba55b457e27b55 = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0x26, 0x69, 0x4a, 0xb7, 0x52, 0x30, 0x2b, 0x04, 0x6e, 0x22, 0x5c, 0x53, 0x21, 0x61,
+	0x55, 0xc8, 0x56, 0xdb, 0x1b, 0xb7, 0x36, 0xde, 0x20, 0x4b, 0xd4, 0x89, 0xd6, 0xce, 0x85, 0x8b,
+	0x65, 0x3b, 0x4b, 0xb2, 0x2a, 0xf1, 0x06, 0xef, 0x26, 0x82, 0x3f, 0xe0, 0xc8, 0x81, 0x3f, 0xe0,
+	0xc2, 0x91, 0x1f, 0xe0, 0xde, 0x63, 0x8f, 0x9c, 0x10, 0x4a, 0x0e, 0xfc, 0x06, 0xca, 0xda, 0xad,
+	0x42, 0x24, 0x2e, 0xb3, 0xfb, 0xe6, 0xbd, 0x99, 0xd1, 0x1b, 0x0d, 0x7c, 0xca, 0x67, 0x92, 0x71,
+	0xb7, 0x8c, 0xb2, 0x48, 0x72, 0xf1, 0x96, 0x16, 0xf1, 0x2c, 0xc9, 0xae, 0xa8, 0x74, 0x66, 0x05,
+	0x97, 0x1c, 0xed, 0x2b, 0xd2, 0x51, 0xb1, 0xfd, 0x20, 0x99, 0xb2, 0x9c, 0xbb, 0x2a, 0x96, 0x7c,
+	0xdb, 0xcc, 0xb8, 0x98, 0x72, 0xe1, 0xa6, 0x89, 0xa0, 0xee, 0xe2, 0x24, 0xa5, 0x32, 0x39, 0x71,
+	0x33, 0xce, 0xf2, 0x8a, 0x7f, 0x38, 0xe6, 0x63, 0xae, 0xbe, 0xee, 0xfa, 0x57, 0x66, 0x8f, 0xbe,
+	0xd4, 0x60, 0x2b, 0xaa, 0xe6, 0x0d, 0xd4, 0x38, 0x64, 0xc0, 0xdd, 0x6c, 0x92, 0xe4, 0x39, 0x7d,
+	0x67, 0x00, 0x0b, 0xd8, 0x7b, 0xe4, 0x16, 0xa2, 0x36, 0xbc, 0x27, 0xe8, 0xfb, 0x39, 0xcd, 0x33,
+	0x6a, 0xd4, 0x2c, 0x60, 0x37, 0xc8, 0x1d, 0x46, 0xcf, 0xe1, 0xfd, 0x11, 0x13, 0xb2, 0x60, 0xe9,
+	0x5c, 0x32, 0x9e, 0xc7, 0x6c, 0x64, 0xd4, 0x95, 0xa4, 0xb5, 0x99, 0xf6, 0x47, 0xeb, 0x26, 0x05,
+	0xcd, 0x28, 0x5b, 0xd0, 0xc2, 0x68, 0xa8, 0xfe, 0x77, 0x18, 0xbd, 0x84, 0x3b, 0x92, 0x5f, 0xd1,
+	0xdc, 0xd8, 0xb1, 0x80, 0xbd, 0x7f, 0x7a, 0xe0, 0x94, 0x9e, 0x9c, 0xb5, 0x27, 0xa7, 0xf2, 0xe4,
+	0x74, 0x39, 0xcb, 0x2f, 0xf6, 0xae, 0x7f, 0x1d, 0x6a, 0xdf, 0xfe, 0x7c, 0x3f, 0x06, 0xa4, 0x2c,
+	0x41, 0x67, 0xb0, 0x29, 0x64, 0x22, 0xe7, 0xc2, 0x68, 0x5a, 0xc0, 0x6e, 0x9d, 0x76, 0x9c, 0x8d,
+	0x85, 0x39, 0xb7, 0x1e, 0x43, 0x25, 0x21, 0x95, 0x14, 0x3d, 0x82, 0xcd, 0x09, 0x65, 0xe3, 0x89,
+	0x34, 0x76, 0x2d, 0x60, 0xd7, 0x49, 0x85, 0x8e, 0x7f, 0x00, 0xd8, 0xfa, 0xb7, 0x04, 0x1d, 0xc2,
+	0x4e, 0x44, 0xce, 0x83, 0xb0, 0x87, 0x49, 0x1c, 0x46, 0xe7, 0xd1, 0x30, 0x8c, 0x87, 0x41, 0x38,
+	0xc0, 0x5d, 0xbf, 0xe7, 0x63, 0x4f, 0xd7, 0x50, 0x07, 0x3e, 0xde, 0x16, 0x0c, 0x70, 0xe0, 0xf9,
+	0xc1, 0x2b, 0x1d, 0xa0, 0x27, 0xf0, 0x60, 0x9b, 0xec, 0xf6, 0x2f, 0x07, 0xaf, 0x71, 0x84, 0x3d,
+	0xbd, 0x86, 0x9e, 0x41, 0x6b, 0x9b, 0x26, 0xb8, 0x37, 0x0c, 0x3c, 0xec, 0xc5, 0x91, 0x7f, 0x89,
+	0xfb, 0xc3, 0x48, 0xaf, 0xa3, 0x23, 0x68, 0xfe, 0x57, 0x85, 0x09, 0xe9, 0x13, 0xbd, 0xd1, 0x6e,
+	0x7c, 0xfa, 0x6a, 0x6a, 0x17, 0xbd, 0xeb, 0xa5, 0x09, 0x6e, 0x96, 0x26, 0xf8, 0xbd, 0x34, 0xc1,
+	0xe7, 0x95, 0xa9, 0xdd, 0xac, 0x4c, 0xed, 0xe7, 0xca, 0xd4, 0xde, 0xbc, 0x18, 0x33, 0x39, 0x99,
+	0xa7, 0x4e, 0xc6, 0xa7, 0x6e, 0x7f, 0xbd, 0x9a, 0x90, 0x16, 0x0b, 0x96, 0x51, 0x51, 0x1d, 0xdf,
+	0x87, 0xea, 0x95, 0x1f, 0x67, 0x54, 0xa4, 0x4d, 0x75, 0x25, 0x67, 0x7f, 0x07, 0x00, 0x92, 0x5d,
+	0x69, 0x70, 0xa0, 0x02, 0x00, 0x00,
+}
+
+func (m *TransferPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTransferPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTransferPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransferPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTransferPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintTransferPacket(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransferPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTransferPacket(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTransferPacket(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransferPacket(uint64(m.Sequence))
+	}
+	if m.DistributionId != 0 {
+		n += 1 + sovTransferPacket(uint64(m.DistributionId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTransferPacket(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTransferPacket(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTransferPacket(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransferPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTransferPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferPacket(x uint64) (n int) {
+	return sovTransferPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferPacket = fmt.Errorf("proto: unexpected end of group")
+)